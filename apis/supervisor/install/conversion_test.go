@@ -0,0 +1,218 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"kubeops.dev/supervisor/apis/supervisor/fuzzer"
+	"kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/apis/supervisor/v1beta1"
+
+	"gomodules.xyz/pointer"
+	apifuzzer "k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+func TestConversionRoundTrip(t *testing.T) {
+	scheme := runtime.NewScheme()
+	Install(scheme)
+	f := apifuzzer.FuzzerFor(apifuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, fuzzer.Funcs), rand.NewSource(rand.Int63()), serializer.NewCodecFactory(scheme))
+
+	cases := []struct {
+		name  string
+		spoke func() conversion.Convertible
+		hub   func() conversion.Hub
+	}{
+		{
+			name:  "MaintenanceWindow",
+			spoke: func() conversion.Convertible { return &v1beta1.MaintenanceWindow{} },
+			hub:   func() conversion.Hub { return &v1alpha1.MaintenanceWindow{} },
+		},
+		{
+			name:  "Recommendation",
+			spoke: func() conversion.Convertible { return &v1beta1.Recommendation{} },
+			hub:   func() conversion.Hub { return &v1alpha1.Recommendation{} },
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				in := tc.spoke()
+				f.Fuzz(in)
+				in.GetObjectKind().SetGroupVersionKind(v1beta1.GroupVersion.WithKind(tc.name))
+
+				hub := tc.hub()
+				if err := in.ConvertTo(hub); err != nil {
+					t.Fatalf("failed to convert to hub: %v", err)
+				}
+				out := tc.spoke()
+				if err := out.ConvertFrom(hub); err != nil {
+					t.Fatalf("failed to convert from hub: %v", err)
+				}
+				out.GetObjectKind().SetGroupVersionKind(v1beta1.GroupVersion.WithKind(tc.name))
+
+				want, err := json.Marshal(in)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got, err := json.Marshal(out)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(want, got) {
+					t.Fatalf("round trip failed:\nwant: %s\ngot:  %s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestHubConversionRoundTrip(t *testing.T) {
+	scheme := runtime.NewScheme()
+	Install(scheme)
+	f := apifuzzer.FuzzerFor(apifuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, fuzzer.Funcs), rand.NewSource(rand.Int63()), serializer.NewCodecFactory(scheme))
+
+	cases := []struct {
+		name  string
+		spoke func() conversion.Convertible
+		hub   func() conversion.Hub
+		// normalize applies the lossless normalizations of the round trip to the fuzzed hub object.
+		normalize func(conversion.Hub)
+	}{
+		{
+			name:  "MaintenanceWindow",
+			spoke: func() conversion.Convertible { return &v1beta1.MaintenanceWindow{} },
+			hub:   func() conversion.Hub { return &v1alpha1.MaintenanceWindow{} },
+			normalize: func(obj conversion.Hub) {
+				// v1alpha1 considers the unset and "" timezones as UTC, which are converted back as the explicit UTC.
+				mw := obj.(*v1alpha1.MaintenanceWindow)
+				if pointer.String(mw.Spec.Timezone) == "" {
+					mw.Spec.Timezone = pointer.StringP("UTC")
+				}
+			},
+		},
+		{
+			name:  "Recommendation",
+			spoke: func() conversion.Convertible { return &v1beta1.Recommendation{} },
+			hub:   func() conversion.Hub { return &v1alpha1.Recommendation{} },
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				in := tc.hub()
+				f.Fuzz(in)
+				in.GetObjectKind().SetGroupVersionKind(v1alpha1.GroupVersion.WithKind(tc.name))
+
+				spoke := tc.spoke()
+				if err := spoke.ConvertFrom(in); err != nil {
+					t.Fatalf("failed to convert from hub: %v", err)
+				}
+				out := tc.hub()
+				if err := spoke.ConvertTo(out); err != nil {
+					t.Fatalf("failed to convert to hub: %v", err)
+				}
+				out.GetObjectKind().SetGroupVersionKind(v1alpha1.GroupVersion.WithKind(tc.name))
+
+				if tc.normalize != nil {
+					tc.normalize(in)
+				}
+				want, err := json.Marshal(in)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got, err := json.Marshal(out)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(want, got) {
+					t.Fatalf("round trip failed:\nwant: %s\ngot:  %s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowTimezoneConversion(t *testing.T) {
+	cases := []struct {
+		name  string
+		hub   *string
+		spoke string
+		want  *string
+	}{
+		{name: "unset", hub: nil, spoke: "UTC", want: pointer.StringP("UTC")},
+		{name: "empty", hub: pointer.StringP(""), spoke: "UTC", want: pointer.StringP("UTC")},
+		{name: "UTC", hub: pointer.StringP("UTC"), spoke: "UTC", want: pointer.StringP("UTC")},
+		{name: "Local", hub: pointer.StringP("Local"), spoke: "Local", want: pointer.StringP("Local")},
+		{name: "location", hub: pointer.StringP("Asia/Dhaka"), spoke: "Asia/Dhaka", want: pointer.StringP("Asia/Dhaka")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := &v1alpha1.MaintenanceWindow{Spec: v1alpha1.MaintenanceWindowSpec{Timezone: tc.hub}}
+
+			spoke := &v1beta1.MaintenanceWindow{}
+			if err := spoke.ConvertFrom(in); err != nil {
+				t.Fatalf("failed to convert from hub: %v", err)
+			}
+			if spoke.Spec.Timezone != tc.spoke {
+				t.Fatalf("unexpected v1beta1 timezone: want %q, got %q", tc.spoke, spoke.Spec.Timezone)
+			}
+
+			out := &v1alpha1.MaintenanceWindow{}
+			if err := spoke.ConvertTo(out); err != nil {
+				t.Fatalf("failed to convert to hub: %v", err)
+			}
+			if !reflect.DeepEqual(out.Spec.Timezone, tc.want) {
+				t.Fatalf("unexpected v1alpha1 timezone: want %v, got %v", pointer.String(tc.want), pointer.String(out.Spec.Timezone))
+			}
+		})
+	}
+}
+
+// TestMaintenanceWindowTimezoneConvertTo checks that the explicit UTC timezone of v1beta1, which is also its default,
+// isn't unset in v1alpha1, where the unset timezone is defaulted to the default timezone of the operator.
+func TestMaintenanceWindowTimezoneConvertTo(t *testing.T) {
+	cases := []struct {
+		name  string
+		spoke string
+		want  *string
+	}{
+		{name: "empty", spoke: "", want: nil},
+		{name: "UTC", spoke: "UTC", want: pointer.StringP("UTC")},
+		{name: "location", spoke: "Asia/Dhaka", want: pointer.StringP("Asia/Dhaka")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spoke := &v1beta1.MaintenanceWindow{Spec: v1beta1.MaintenanceWindowSpec{Timezone: tc.spoke}}
+			out := &v1alpha1.MaintenanceWindow{}
+			if err := spoke.ConvertTo(out); err != nil {
+				t.Fatalf("failed to convert to hub: %v", err)
+			}
+			if !reflect.DeepEqual(out.Spec.Timezone, tc.want) {
+				t.Fatalf("unexpected v1alpha1 timezone: want %v, got %v", pointer.String(tc.want), pointer.String(out.Spec.Timezone))
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "time"
+
+// IsQuorumRequired returns true if the ApprovalPolicy requires multiple users to approve the Recommendations.
+func (p *ApprovalPolicy) IsQuorumRequired() bool {
+	return p.RequiredApprovals != nil
+}
+
+// IsSeverityAllowed returns true if the Recommendations of the given severity can be auto-approved by the ApprovalPolicy.
+func (p *ApprovalPolicy) IsSeverityAllowed(s Severity) bool {
+	return p.MaxSeverity == "" || s.Level() <= p.MaxSeverity.Level()
+}
+
+// IsImpactAllowed returns true if the Recommendations of the given impact can be auto-approved by the ApprovalPolicy.
+func (p *ApprovalPolicy) IsImpactAllowed(impact *Impact) bool {
+	if p.MaxImpact == nil || impact == nil {
+		return true
+	}
+	if p.MaxImpact.Downtime != nil && impact.ExpectedDowntime != nil && impact.ExpectedDowntime.Duration > p.MaxImpact.Downtime.Duration {
+		return false
+	}
+	if p.MaxImpact.DataMigrationSize != nil && impact.DataMigrationSize != nil && impact.DataMigrationSize.Cmp(*p.MaxImpact.DataMigrationSize) > 0 {
+		return false
+	}
+	return true
+}
+
+// IsValidAt returns true if the given time is within the validity period of the ApprovalPolicy.
+func (p *ApprovalPolicy) IsValidAt(t time.Time) bool {
+	if p.ValidFrom != nil && t.Before(p.ValidFrom.Time) {
+		return false
+	}
+	if p.ValidUntil != nil && !t.Before(p.ValidUntil.Time) {
+		return false
+	}
+	return true
+}
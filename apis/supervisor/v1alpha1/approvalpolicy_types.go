@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"kubeops.dev/supervisor/crds"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kmapi "kmodules.xyz/client-go/api/v1"
 	"kmodules.xyz/client-go/apiextensions"
@@ -33,6 +34,12 @@ const (
 //+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Window Kind",type="string",JSONPath=".maintenanceWindowRef.kind"
+// +kubebuilder:printcolumn:name="Window",type="string",JSONPath=".maintenanceWindowRef.name"
+// +kubebuilder:printcolumn:name="Concurrency",type="string",JSONPath=".concurrencyPolicy"
+// +kubebuilder:printcolumn:name="Effect",type="string",JSONPath=".effect"
+// +kubebuilder:printcolumn:name="Valid Until",type="date",JSONPath=".validUntil"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // ApprovalPolicy is the Schema for the approvalpolicies API
 type ApprovalPolicy struct {
@@ -41,21 +48,170 @@ type ApprovalPolicy struct {
 
 	// Specifies MaintenanceWindow reference for ApprovalPolicy.
 	// Recommendation will be executed in this MaintenanceWindow without manual approval.
+	// The kind can be either `MaintenanceWindow` or `ClusterMaintenanceWindow`. If the kind is not set,
+	// MaintenanceWindow is used and the namespace of the ApprovalPolicy is used if its namespace is not set.
+	// It is ignored for the `Deny` effect.
 	MaintenanceWindowRef kmapi.TypedObjectReference `json:"maintenanceWindowRef"`
 
+	// Except specifies the target objects which are excluded from this ApprovalPolicy,
+	// e.g. the critical databases which always need manual review.
+	// +optional
+	Except *PolicyException `json:"except,omitempty"`
+
+	// Effect specifies what happens to the Recommendations which are matched with this ApprovalPolicy.
+	// Possible values are:
+	// Allow: The Recommendations are approved to execute in the MaintenanceWindow.
+	// Deny: The Recommendations are rejected regardless of the MaintenanceWindows, even if they require explicit approval.
+	// Deny takes precedence over Allow when multiple ApprovalPolicies are matched.
+	// +optional
+	// +kubebuilder:default=Allow
+	Effect PolicyEffect `json:"effect,omitempty"`
+
+	// DenyReason holds the reason to record in the Recommendations which are rejected by this ApprovalPolicy.
+	// +optional
+	DenyReason string `json:"denyReason,omitempty"`
+
+	// MaxSeverity specifies the highest severity of the Recommendations which are auto-approved by this ApprovalPolicy.
+	// The Recommendations of a higher severity require manual approval.
+	// If it is not set, the Recommendations of any severity are auto-approved.
+	// +optional
+	MaxSeverity Severity `json:"maxSeverity,omitempty"`
+
+	// MaxImpact specifies the highest impact of the Recommendations which are auto-approved by this ApprovalPolicy.
+	// The Recommendations of a higher impact require manual approval. The Recommendations without
+	// any impact are considered as of no impact.
+	// +optional
+	MaxImpact *ImpactLimit `json:"maxImpact,omitempty"`
+
+	// RequiredApprovals specifies the number of distinct users who must approve the matched Recommendations.
+	// If it is set, the matched Recommendations are not auto-approved. Instead, they are Approved
+	// once the required number of users have approved them.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RequiredApprovals *int32 `json:"requiredApprovals,omitempty"`
+
+	// AutoRejectAfter specifies the duration after which the matched Recommendations, which are still
+	// pending for approval, are rejected automatically with the `Stale` reason.
+	// +optional
+	AutoRejectAfter *metav1.Duration `json:"autoRejectAfter,omitempty"`
+
+	// MaxQueueDuration specifies the SLA of the matched Recommendations. If a Recommendation is pending or
+	// waiting for longer than this duration after its creation, the `SLABreached` condition is set.
+	// It overrides the operator wide `--max-queue-duration` flag.
+	// +optional
+	MaxQueueDuration *metav1.Duration `json:"maxQueueDuration,omitempty"`
+
+	// ValidFrom specifies the time from which the ApprovalPolicy is effective.
+	// If it is not set, the ApprovalPolicy is effective since its creation.
+	// +optional
+	ValidFrom *metav1.Time `json:"validFrom,omitempty"`
+
+	// ValidUntil specifies the time until which the ApprovalPolicy is effective.
+	// If it is not set, the ApprovalPolicy never expires.
+	// The Recommendations approved before the expiry are not affected.
+	// +optional
+	ValidUntil *metav1.Time `json:"validUntil,omitempty"`
+
 	// Specifies the list of TargetRef for which the ApprovalPolicy will be effective for.
 	// +optional
 	Targets []TargetRef `json:"targets"`
+
+	// ConcurrencyPolicy specifies how to treat a Recommendation when another Recommendation
+	// of the same target object is already in progress.
+	// Possible values are:
+	// Queue: The Recommendation waits until the other Recommendation is completed.
+	// Forbid: The Recommendation is skipped.
+	// Replace: The operation of the other Recommendation is deleted and the Recommendation is executed.
+	// It can be overridden for a target object by the `supervisor.appscode.com/concurrency-policy` annotation.
+	// +optional
+	// +kubebuilder:default=Queue
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// Backup specifies the backup to trigger and wait for before executing the operations
+	// of the Recommendations which are matched with this ApprovalPolicy.
+	// +optional
+	Backup *BackupTrigger `json:"backup,omitempty"`
+
+	// ServiceNow specifies the ServiceNow change request to create for the Recommendations which are matched
+	// with this ApprovalPolicy and require approval. The Recommendations are Approved only once the change request
+	// is approved in ServiceNow.
+	// +optional
+	ServiceNow *ServiceNowChangeRequest `json:"serviceNow,omitempty"`
+
+	// PrometheusGates specifies the PromQL thresholds which must be satisfied before and during the executions
+	// of the Recommendations which are matched with this ApprovalPolicy, in addition to their own gates.
+	// +optional
+	PrometheusGates []PrometheusGate `json:"prometheusGates,omitempty"`
+}
+
+// ImpactLimit specifies the limits of the Impact of the Recommendations.
+type ImpactLimit struct {
+	// Downtime specifies the highest expected downtime.
+	// +optional
+	Downtime *metav1.Duration `json:"downtime,omitempty"`
+
+	// DataMigrationSize specifies the largest size of the migrated data.
+	// +optional
+	DataMigrationSize *resource.Quantity `json:"dataMigrationSize,omitempty"`
+}
+
+// PolicyException specifies the target objects to exclude from an ApprovalPolicy.
+// A target object is excluded if it matches any of the given fields.
+type PolicyException struct {
+	// Namespaces specifies the namespaces of the target objects to exclude.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Names specifies the names of the target objects to exclude.
+	// +optional
+	Names []string `json:"names,omitempty"`
+
+	// Selector selects the target objects to exclude by their labels.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=Allow;Deny
+type PolicyEffect string
+
+const (
+	AllowEffect PolicyEffect = "Allow"
+	DenyEffect  PolicyEffect = "Deny"
+)
+
+// +kubebuilder:validation:Enum=Forbid;Queue;Replace
+type ConcurrencyPolicy string
+
+const (
+	ForbidConcurrent  ConcurrencyPolicy = "Forbid"
+	QueueConcurrent   ConcurrencyPolicy = "Queue"
+	ReplaceConcurrent ConcurrencyPolicy = "Replace"
+)
+
 type Operation struct {
 	metav1.GroupKind `json:",inline"`
+
+	// Types specifies the types (`.spec.type`) of the operations for which the ApprovalPolicy is effective,
+	// e.g. `UpdateVersion`, `HorizontalScaling`, `VerticalScaling`, `Restart`, `Reconfigure` or `ReconfigureTLS`.
+	// If it is empty, the ApprovalPolicy is effective for every type of the operation.
+	// +optional
+	Types []string `json:"types,omitempty"`
 }
 
 type TargetRef struct {
 	metav1.GroupKind `json:",inline"`
 	// +optional
 	Operations []Operation `json:"operations,omitempty"`
+
+	// Selector selects the target objects by their labels.
+	// If it is not set, the target objects of any labels are selected.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// FieldSelector selects the target objects by their fields. It supports the `=`, `==` and `!=` operators.
+	// Example: `spec.version=16.1,metadata.name!=prod-pg`
+	// +optional
+	FieldSelector string `json:"fieldSelector,omitempty"`
 }
 
 //+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
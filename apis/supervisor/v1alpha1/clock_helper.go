@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"os"
+	"sync"
 
 	"github.com/jonboulle/clockwork"
 )
@@ -27,9 +28,31 @@ const (
 	TestEnvVal = "TRUE"
 )
 
+var (
+	clockMu sync.Mutex
+	clock   clockwork.Clock
+)
+
+// GetClock returns the clock shared by the controllers. A fake clock is used in the test env,
+// so that the tests can shift the time of every controller at once.
 func GetClock() clockwork.Clock {
-	if os.Getenv(TestEnvKey) == TestEnvVal {
-		return clockwork.NewFakeClock()
+	clockMu.Lock()
+	defer clockMu.Unlock()
+
+	if clock == nil {
+		if os.Getenv(TestEnvKey) == TestEnvVal {
+			clock = clockwork.NewFakeClock()
+		} else {
+			clock = clockwork.NewRealClock()
+		}
 	}
-	return clockwork.NewRealClock()
+	return clock
+}
+
+// SetClock replaces the clock shared by the controllers. It must be called before the controllers are set up.
+func SetClock(c clockwork.Clock) {
+	clockMu.Lock()
+	defer clockMu.Unlock()
+
+	clock = c
 }
@@ -0,0 +1,147 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"kubeops.dev/supervisor/crds"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kmapi "kmodules.xyz/client-go/api/v1"
+	"kmodules.xyz/client-go/apiextensions"
+)
+
+const (
+	ResourceKindClusterApprovalPolicy = "ClusterApprovalPolicy"
+	ResourceClusterApprovalPolicy     = "clusterapprovalpolicy"
+	ResourceClusterApprovalPolicies   = "clusterapprovalpolicies"
+)
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Window Kind",type="string",JSONPath=".maintenanceWindowRef.kind"
+// +kubebuilder:printcolumn:name="Window",type="string",JSONPath=".maintenanceWindowRef.name"
+// +kubebuilder:printcolumn:name="Effect",type="string",JSONPath=".effect"
+// +kubebuilder:printcolumn:name="Valid Until",type="date",JSONPath=".validUntil"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ClusterApprovalPolicy is the Schema for the clusterapprovalpolicies API.
+// It works like an ApprovalPolicy for the Recommendations of every namespace selected by the NamespaceSelector.
+// The ApprovalPolicies of the namespace are preferred over the ClusterApprovalPolicies of the same effect.
+type ClusterApprovalPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// NamespaceSelector selects the namespaces for which the ClusterApprovalPolicy will be effective for.
+	// If it is not set, the ClusterApprovalPolicy is effective for all the namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Specifies MaintenanceWindow reference for ClusterApprovalPolicy.
+	// The kind can be either `MaintenanceWindow` or `ClusterMaintenanceWindow`. If the kind is not set,
+	// MaintenanceWindow of the namespace of the Recommendation is used.
+	// It is ignored for the `Deny` effect.
+	MaintenanceWindowRef kmapi.TypedObjectReference `json:"maintenanceWindowRef"`
+
+	// Specifies the list of TargetRef for which the ClusterApprovalPolicy will be effective for.
+	// +optional
+	Targets []TargetRef `json:"targets"`
+
+	// Except specifies the target objects which are excluded from this ClusterApprovalPolicy.
+	// +optional
+	Except *PolicyException `json:"except,omitempty"`
+
+	// Effect specifies whether the matched Recommendations are approved or rejected.
+	// +optional
+	// +kubebuilder:default=Allow
+	Effect PolicyEffect `json:"effect,omitempty"`
+
+	// DenyReason holds the reason to record in the Recommendations which are rejected by this ClusterApprovalPolicy.
+	// +optional
+	DenyReason string `json:"denyReason,omitempty"`
+
+	// MaxSeverity specifies the highest severity of the Recommendations which are auto-approved.
+	// +optional
+	MaxSeverity Severity `json:"maxSeverity,omitempty"`
+
+	// MaxImpact specifies the highest impact of the Recommendations which are auto-approved.
+	// The Recommendations of a higher impact require manual approval. The Recommendations without
+	// any impact are considered as of no impact.
+	// +optional
+	MaxImpact *ImpactLimit `json:"maxImpact,omitempty"`
+
+	// RequiredApprovals specifies the number of distinct users who must approve the matched Recommendations.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RequiredApprovals *int32 `json:"requiredApprovals,omitempty"`
+
+	// AutoRejectAfter specifies the duration after which the pending Recommendations are rejected automatically.
+	// +optional
+	AutoRejectAfter *metav1.Duration `json:"autoRejectAfter,omitempty"`
+
+	// MaxQueueDuration specifies the SLA of the matched Recommendations.
+	// +optional
+	MaxQueueDuration *metav1.Duration `json:"maxQueueDuration,omitempty"`
+
+	// ValidFrom specifies the time from which the ClusterApprovalPolicy is effective.
+	// +optional
+	ValidFrom *metav1.Time `json:"validFrom,omitempty"`
+
+	// ValidUntil specifies the time until which the ClusterApprovalPolicy is effective.
+	// +optional
+	ValidUntil *metav1.Time `json:"validUntil,omitempty"`
+
+	// ConcurrencyPolicy specifies how to treat a Recommendation when another Recommendation
+	// of the same target object is already in progress.
+	// +optional
+	// +kubebuilder:default=Queue
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// Backup specifies the backup to trigger and wait for before executing the operations
+	// of the Recommendations which are matched with this ClusterApprovalPolicy.
+	// +optional
+	Backup *BackupTrigger `json:"backup,omitempty"`
+
+	// ServiceNow specifies the ServiceNow change request to create for the Recommendations which are matched
+	// with this ClusterApprovalPolicy and require approval. The Recommendations are Approved only once the change request
+	// is approved in ServiceNow.
+	// +optional
+	ServiceNow *ServiceNowChangeRequest `json:"serviceNow,omitempty"`
+
+	// PrometheusGates specifies the PromQL thresholds which must be satisfied before and during the executions
+	// of the Recommendations which are matched with this ClusterApprovalPolicy, in addition to their own gates.
+	// +optional
+	PrometheusGates []PrometheusGate `json:"prometheusGates,omitempty"`
+}
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+//+kubebuilder:object:root=true
+
+// ClusterApprovalPolicyList contains a list of ClusterApprovalPolicy
+type ClusterApprovalPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterApprovalPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterApprovalPolicy{}, &ClusterApprovalPolicyList{})
+}
+
+func (_ ClusterApprovalPolicy) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(GroupVersion.WithResource(ResourceClusterApprovalPolicies))
+}
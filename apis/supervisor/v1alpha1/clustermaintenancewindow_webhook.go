@@ -42,11 +42,10 @@ var _ webhook.Defaulter = &ClusterMaintenanceWindow{}
 func (r *ClusterMaintenanceWindow) Default() {
 	clustermaintenancewindowlog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	defaultWindowTimezone(&r.Spec)
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-//+kubebuilder:webhook:path=/validate-supervisor-appscode-com-v1alpha1-clustermaintenancewindow,mutating=false,failurePolicy=fail,sideEffects=None,groups=supervisor.appscode.com,resources=clustermaintenancewindows,verbs=create;update,versions=v1alpha1,name=vclustermaintenancewindow.kb.io,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/validate-supervisor-appscode-com-v1alpha1-clustermaintenancewindow,mutating=false,failurePolicy=fail,sideEffects=None,groups=supervisor.appscode.com,resources=clustermaintenancewindows,verbs=create;update;delete,versions=v1alpha1,name=vclustermaintenancewindow.kb.io,admissionReviewVersions={v1,v1beta1}
 
 var _ webhook.Validator = &ClusterMaintenanceWindow{}
 
@@ -68,7 +67,7 @@ func (r *ClusterMaintenanceWindow) ValidateUpdate(_ runtime.Object) (admission.W
 func (r *ClusterMaintenanceWindow) ValidateDelete() (admission.Warnings, error) {
 	clustermaintenancewindowlog.Info("validate delete", "name", r.Name)
 
-	return nil, nil
+	return nil, validateWindowNotInUse(context.TODO(), ResourceKindClusterMaintenanceWindow, "", r.Name)
 }
 
 func (r *ClusterMaintenanceWindow) validateClusterMaintenanceWindow(ctx context.Context) error {
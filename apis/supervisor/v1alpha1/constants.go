@@ -20,6 +20,76 @@ const (
 	DefaultMaintenanceWindowKey        = "supervisor.appscode.com/is-default-maintenance-window"
 	DefaultClusterMaintenanceWindowKey = "supervisor.appscode.com/is-default-cluster-maintenance-window"
 	DefaultBackoffLimit                = 5
+	// DefaultTargetReadinessRule considers the target healthy if it is in `Ready` phase or has no phase at all.
+	DefaultTargetReadinessRule = "!has(self.status) || !has(self.status.phase) || self.status.phase == 'Ready'"
+
+	// ExecuteNowKey is the Recommendation annotation which bypasses the MaintenanceWindow
+	// for an emergency execution of an Approved Recommendation if its value is `true`.
+	ExecuteNowKey = "supervisor.appscode.com/execute-now"
+	// ExecuteNowRequestedByKey holds the username who set the ExecuteNowKey annotation.
+	// It is populated by the mutating webhook from the authenticated user of the admission request.
+	ExecuteNowRequestedByKey = "supervisor.appscode.com/execute-now-requested-by"
+	// CreatedByKey holds the username who created the Recommendation.
+	// It is populated by the mutating webhook from the authenticated user of the admission request.
+	CreatedByKey = "supervisor.appscode.com/created-by"
+	// ConcurrencyPolicyKey is the target object annotation which overrides the ConcurrencyPolicy of the ApprovalPolicy.
+	ConcurrencyPolicyKey = "supervisor.appscode.com/concurrency-policy"
+	// MaintenanceWindowKey is the target object annotation which overrides the default MaintenanceWindow and
+	// ClusterMaintenanceWindow of the Recommendations of the target object. It holds the name of a MaintenanceWindow
+	// of the namespace of the target object or `ClusterMaintenanceWindow/<name>`.
+	MaintenanceWindowKey = "supervisor.appscode.com/maintenance-window"
+	// ApproveAllKey is the target object annotation which approves the Recommendations of the target object
+	// for the given comma separated operation types, e.g. `Restart,VerticalScaling`. `*` approves every operation type.
+	// It is only honored if the operator is started with the `--enable-target-approval-annotation` flag.
+	ApproveAllKey = "supervisor.appscode.com/approve-all"
+	// TraceParentKey holds the W3C traceparent of the lifecycle trace of a Recommendation.
+	// It is set on the Recommendation and propagated to the created operation object.
+	TraceParentKey = "supervisor.appscode.com/traceparent"
+	// TraceIDKey holds the trace id of the Recommendation on the created operation object.
+	TraceIDKey = "supervisor.appscode.com/trace-id"
+	// SuspendedByKey is set on the Flux Kustomizations and HelmReleases suspended during the execution of a Recommendation.
+	// It holds the `namespace/name` of the Recommendation.
+	SuspendedByKey = "supervisor.appscode.com/suspended-by"
+	// UnderMaintenanceKey is set on the Services and Ingresses of the target during the execution of a Recommendation,
+	// so that the front doors can show a maintenance page. It holds the `namespace/name` of the Recommendation.
+	UnderMaintenanceKey = "supervisor.appscode.com/under-maintenance"
+	// HubApprovalKey is set by the Open Cluster Management hub on the distributed Recommendations.
+	// It holds the ApprovalStatus of the Recommendation in the hub cluster.
+	HubApprovalKey = "supervisor.appscode.com/hub-approval"
+	// EndOfLifeKey is the KubeDB catalog version annotation holding the end-of-life date of the version,
+	// either in RFC3339 or in `2006-01-02` format. The version is considered EOL after the date.
+	EndOfLifeKey = "supervisor.appscode.com/end-of-life"
+	// CVEDrivenKey is the label of the Recommendations generated to fix the critical vulnerabilities of the target image.
+	CVEDrivenKey = "supervisor.appscode.com/cve-driven"
+	// RecommendationKey is the label of the created operation objects holding the name of their Recommendation.
+	RecommendationKey = "supervisor.appscode.com/recommendation"
+	// ApproverKey is the created operation object annotation holding the username who approved the Recommendation.
+	ApproverKey = "supervisor.appscode.com/approver"
+	// ApprovedWindowKey is the created operation object annotation holding the approved window of the Recommendation,
+	// either the `Kind/[namespace/]name` of its MaintenanceWindow or the approved window type.
+	ApprovedWindowKey = "supervisor.appscode.com/approved-window"
+	// OperationCleanupFinalizer is added to the Recommendation when its operation is created.
+	// It holds the deletion of the Recommendation until the operation is handled according to its PropagationPolicy.
+	OperationCleanupFinalizer = "supervisor.appscode.com/operation-cleanup"
+	// ResetCircuitKey is the Recommendation annotation which closes the open circuit of its target or namespace
+	// when it is set to `true`. The annotation is removed by the operator after the reset.
+	ResetCircuitKey = "supervisor.appscode.com/reset-circuit"
+	// CircuitResetKey is the MaintenanceExecution annotation marking the last failure counted before the circuit is reset.
+	// The failures completed until then are not counted by the circuit breaker anymore.
+	CircuitResetKey = "supervisor.appscode.com/circuit-reset"
+)
+
+// List of field indexes of the Recommendations in the cache of the operator
+const (
+	// RecommendationTargetIndex indexes the Recommendations by the `group/kind/name` of their target.
+	RecommendationTargetIndex = "spec.target"
+	// RecommendationPhaseIndex indexes the Recommendations by their phase.
+	RecommendationPhaseIndex = "status.phase"
+	// RecommendationWindowIndex indexes the Recommendations by the `Kind/namespace/name` of their approved
+	// MaintenanceWindow or ClusterMaintenanceWindow. The namespace is empty for the ClusterMaintenanceWindows.
+	RecommendationWindowIndex = "status.approvedWindow.maintenanceWindow"
+	// RecommendationDependencyIndex indexes the Recommendations by the `namespace/name` of the Recommendations they depend on.
+	RecommendationDependencyIndex = "spec.dependsOn"
 )
 
 // List of Condition and Phase reasons
@@ -32,6 +102,58 @@ const (
 	WaitingForExecution           = "WaitingForExecution"
 	WaitingForMaintenanceWindow   = "WaitingForMaintenanceWindow"
 	StartedExecutingOperation     = "StartedExecutingOperation"
+	CreatingOperation             = "CreatingOperation"
 	RecommendationRejected        = "RecommendationRejected"
 	RecommendationOutdated        = "RecommendationOutdated"
+	WaitingForDependency          = "WaitingForDependency"
+	DependencyNotSucceeded        = "DependencyNotSucceeded"
+	DependencyCycleDetected       = "DependencyCycleDetected"
+	WaitingForGroupApproval       = "WaitingForGroupApproval"
+	GroupMemberRejected           = "GroupMemberRejected"
+	ForcedExecutionStarted        = "ForcedExecutionStarted"
+	WaitingForCanaryVerification  = "WaitingForCanaryVerification"
+	CanaryFailed                  = "CanaryFailed"
+	TargetChanged                 = "TargetChanged"
+	ConcurrentExecutionForbidden  = "ConcurrentExecutionForbidden"
+	ReplacedByRecommendation      = "ReplacedByRecommendation"
+	WaitingForExecutionLimit      = "WaitingForExecutionLimit"
+	TargetNotHealthy              = "TargetNotHealthy"
+	VerifyingOperation            = "VerifyingOperation"
+	VerificationFailed            = "VerificationFailed"
+	RunningPreExecutionHook       = "RunningPreExecutionHook"
+	WaitingForBackup              = "WaitingForBackup"
+	WaitingForApprovals           = "WaitingForApprovals"
+	ActiveDeadlineExceeded        = "ActiveDeadlineExceeded"
+	OperationValidationFailed     = "OperationValidationFailed"
+	SLABreached                   = "SLABreached"
+	WaitingForSyncWindow          = "WaitingForSyncWindow"
+	WaitingForRolloutWave         = "WaitingForRolloutWave"
+	RolloutHalted                 = "RolloutHalted"
+	CircuitOpen                   = "CircuitOpen"
+	MaintenanceFrozen             = "MaintenanceFrozen"
+	ValidationFailed              = "ValidationFailed"
+	TargetDrifted                 = "TargetDrifted"
+	InsufficientWindowTime        = "InsufficientWindowTime"
+	UnderMaintenance              = "UnderMaintenance"
+	BlockedByPDB                  = "BlockedByPDB"
+	ClusterPreconditionFailed     = "ClusterPreconditionFailed"
+	PrometheusGateBreached        = "PrometheusGateBreached"
+	DowntimeBudgetExceeded        = "DowntimeBudgetExceeded"
+)
+
+// List of Event reasons emitted on the Recommendations and their target objects
+const (
+	EventReasonAwaitingApproval     = "AwaitingApproval"
+	EventReasonApproved             = "Approved"
+	EventReasonRejected             = "Rejected"
+	EventReasonExpired              = "Expired"
+	EventReasonSkipped              = "Skipped"
+	EventReasonWindowOpened         = "WindowOpened"
+	EventReasonExecutionStarted     = "ExecutionStarted"
+	EventReasonExecutionSucceeded   = "ExecutionSucceeded"
+	EventReasonExecutionFailed      = "ExecutionFailed"
+	EventReasonChangeRequestCreated = "ChangeRequestCreated"
+	EventReasonOperationDeleted     = "OperationDeleted"
+	EventReasonCircuitOpened        = "CircuitOpened"
+	EventReasonRollbackRecommended  = "RollbackRecommended"
 )
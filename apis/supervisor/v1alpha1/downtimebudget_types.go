@@ -0,0 +1,108 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"kubeops.dev/supervisor/crds"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"kmodules.xyz/client-go/apiextensions"
+)
+
+const (
+	ResourceKindDowntimeBudget = "DowntimeBudget"
+	ResourceDowntimeBudget     = "downtimebudget"
+	ResourceDowntimeBudgets    = "downtimebudgets"
+)
+
+// DowntimeBudgetSpec defines the allowed disruption of the targets of a namespace
+type DowntimeBudgetSpec struct {
+	// Target specifies the APIGroup, Kind & Name of the target resource whose disruption is limited.
+	// If it is not set, the total disruption of every target of the namespace is limited.
+	// +optional
+	Target *core.TypedLocalObjectReference `json:"target,omitempty"`
+
+	// MonthlyMinutes specifies the allowed disruption in minutes per calendar month, in UTC.
+	// +kubebuilder:validation:Minimum=0
+	MonthlyMinutes int32 `json:"monthlyMinutes"`
+}
+
+// DowntimeBudgetStatus defines the observed state of DowntimeBudget
+type DowntimeBudgetStatus struct {
+	// PeriodStart specifies the start of the current budget period.
+	// +optional
+	PeriodStart *metav1.Time `json:"periodStart,omitempty"`
+
+	// PeriodEnd specifies the end of the current budget period, when the budget is reset.
+	// +optional
+	PeriodEnd *metav1.Time `json:"periodEnd,omitempty"`
+
+	// Executions holds the number of executions counted against the budget in the current period.
+	// +optional
+	Executions int32 `json:"executions,omitempty"`
+
+	// UsedMinutes holds the disruption in minutes consumed in the current period.
+	// +optional
+	UsedMinutes int32 `json:"usedMinutes,omitempty"`
+
+	// RemainingMinutes holds the disruption in minutes left in the current period.
+	// +optional
+	RemainingMinutes int32 `json:"remainingMinutes,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed for this resource.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.target.name"
+// +kubebuilder:printcolumn:name="Budget",type="integer",JSONPath=".spec.monthlyMinutes"
+// +kubebuilder:printcolumn:name="Used",type="integer",JSONPath=".status.usedMinutes"
+// +kubebuilder:printcolumn:name="Remaining",type="integer",JSONPath=".status.remainingMinutes"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// DowntimeBudget is the Schema for the downtimebudgets API.
+// It limits the monthly disruption caused by the executions of the Recommendations, like an SLO error budget.
+// The executions that would exceed the remaining budget are deferred until the next period.
+type DowntimeBudget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DowntimeBudgetSpec   `json:"spec,omitempty"`
+	Status DowntimeBudgetStatus `json:"status,omitempty"`
+}
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+//+kubebuilder:object:root=true
+
+// DowntimeBudgetList contains a list of DowntimeBudget
+type DowntimeBudgetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DowntimeBudget `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DowntimeBudget{}, &DowntimeBudgetList{})
+}
+
+func (_ DowntimeBudget) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(GroupVersion.WithResource(ResourceDowntimeBudgets))
+}
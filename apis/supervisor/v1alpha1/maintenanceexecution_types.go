@@ -0,0 +1,133 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"kubeops.dev/supervisor/crds"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kmapi "kmodules.xyz/client-go/api/v1"
+	"kmodules.xyz/client-go/apiextensions"
+)
+
+const (
+	ResourceKindMaintenanceExecution = "MaintenanceExecution"
+	ResourceMaintenanceExecution     = "maintenanceexecution"
+	ResourceMaintenanceExecutions    = "maintenanceexecutions"
+)
+
+// MaintenanceExecutionSpec holds the immutable record of an executed Recommendation
+type MaintenanceExecutionSpec struct {
+	// Recommendation holds the name of the executed Recommendation.
+	Recommendation core.LocalObjectReference `json:"recommendation"`
+
+	// RecommendationUID holds the uid of the executed Recommendation.
+	// +optional
+	RecommendationUID types.UID `json:"recommendationUID,omitempty"`
+
+	// Target specifies the APIGroup, Kind & Name of the target resource of the Recommendation.
+	Target core.TypedLocalObjectReference `json:"target"`
+
+	// OperationRef refers to the operation object created for the Recommendation.
+	// +optional
+	OperationRef *kmapi.TypedObjectReference `json:"operationRef,omitempty"`
+
+	// OperationType holds the type of the operation, e.g. the `spec.type` of a KubeDB OpsRequest, if any.
+	// +optional
+	OperationType string `json:"operationType,omitempty"`
+
+	// TargetReplicas holds the number of replicas of the target when the operation is created.
+	// +optional
+	TargetReplicas int32 `json:"targetReplicas,omitempty"`
+
+	// Downtime holds the expected downtime of the executed Recommendation, if it is specified.
+	// Otherwise, the target is considered disrupted for the whole execution.
+	// +optional
+	Downtime *metav1.Duration `json:"downtime,omitempty"`
+
+	// ApprovedWindow specifies the time window configuration used for the execution.
+	// +optional
+	ApprovedWindow *ApprovedWindow `json:"approvedWindow,omitempty"`
+
+	// MaintenanceWindow holds the reference of the MaintenanceWindow or ClusterMaintenanceWindow
+	// in which the operation is created, if any.
+	// +optional
+	MaintenanceWindow *kmapi.TypedObjectReference `json:"maintenanceWindow,omitempty"`
+
+	// Approver holds the details of the reviewer who approved the Recommendation.
+	// +optional
+	Approver *Subject `json:"approver,omitempty"`
+
+	// ApprovedBy holds the identity of the authenticated user who approved the Recommendation.
+	// +optional
+	ApprovedBy *Approval `json:"approvedBy,omitempty"`
+
+	// StartTime specifies when the operation is created.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime specifies when the execution is completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Result specifies the final phase of the Recommendation.
+	Result RecommendationPhase `json:"result"`
+
+	// Reason holds a message indicating details about the result.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// FailedAttempt holds the number of times the operation is failed.
+	// +optional
+	FailedAttempt int32 `json:"failedAttempt,omitempty"`
+}
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Recommendation",type="string",JSONPath=".spec.recommendation.name"
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.target.name"
+// +kubebuilder:printcolumn:name="Result",type="string",JSONPath=".spec.result"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// MaintenanceExecution is the Schema for the maintenanceexecutions API.
+// It is a durable audit record of an executed Recommendation, independent of the Recommendation lifetime.
+type MaintenanceExecution struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MaintenanceExecutionSpec `json:"spec,omitempty"`
+}
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+//+kubebuilder:object:root=true
+
+// MaintenanceExecutionList contains a list of MaintenanceExecution
+type MaintenanceExecutionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MaintenanceExecution `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MaintenanceExecution{}, &MaintenanceExecutionList{})
+}
+
+func (_ MaintenanceExecution) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(GroupVersion.WithResource(ResourceMaintenanceExecutions))
+}
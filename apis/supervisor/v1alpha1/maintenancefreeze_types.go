@@ -0,0 +1,89 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"kubeops.dev/supervisor/crds"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"kmodules.xyz/client-go/apiextensions"
+)
+
+const (
+	ResourceKindMaintenanceFreeze = "MaintenanceFreeze"
+	ResourceMaintenanceFreeze     = "maintenancefreeze"
+	ResourceMaintenanceFreezes    = "maintenancefreezes"
+)
+
+// MaintenanceFreezeSpec defines the time range and the scope of a MaintenanceFreeze
+type MaintenanceFreezeSpec struct {
+	// Start specifies the time from which the executions are held.
+	Start metav1.Time `json:"start"`
+
+	// End specifies the time until which the executions are held.
+	End metav1.Time `json:"end"`
+
+	// NamespaceSelector selects the namespaces whose executions are held.
+	// If it is not set, the executions of all the namespaces are held.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Targets specifies the group and kind of the target objects whose executions are held.
+	// If it is empty, the executions of every target object are held.
+	// +optional
+	Targets []metav1.GroupKind `json:"targets,omitempty"`
+
+	// Reason holds the reason of the freeze, e.g. the id of the change-management freeze.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Start",type="date",JSONPath=".spec.start"
+// +kubebuilder:printcolumn:name="End",type="date",JSONPath=".spec.end"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".spec.reason"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// MaintenanceFreeze is the Schema for the maintenancefreezes API.
+// While it is active, the executions of the Recommendations in its scope are held, regardless of
+// their MaintenanceWindows and the forced executions.
+type MaintenanceFreeze struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MaintenanceFreezeSpec `json:"spec,omitempty"`
+}
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+//+kubebuilder:object:root=true
+
+// MaintenanceFreezeList contains a list of MaintenanceFreeze
+type MaintenanceFreezeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MaintenanceFreeze `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MaintenanceFreeze{}, &MaintenanceFreezeList{})
+}
+
+func (_ MaintenanceFreeze) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(GroupVersion.WithResource(ResourceMaintenanceFreezes))
+}
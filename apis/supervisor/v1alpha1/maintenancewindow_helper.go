@@ -0,0 +1,92 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	kmapi "kmodules.xyz/client-go/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxInUseReferences is the maximum number of the referring objects listed in the deletion error.
+const maxInUseReferences = 5
+
+// validateWindowNotInUse returns an error if the MaintenanceWindow or ClusterMaintenanceWindow is referred
+// by the approved Recommendations which are not executed yet, or by the ApprovalPolicies or ClusterApprovalPolicies,
+// so that the queued work doesn't get stranded by deleting its window. The namespace is empty for ClusterMaintenanceWindows.
+func validateWindowNotInUse(ctx context.Context, kind, namespace, name string) error {
+	if webhookClient == nil {
+		return errors.New("webhook client is not set")
+	}
+	refersTo := func(ref *kmapi.TypedObjectReference, defaultNamespace string) bool {
+		if ref == nil || ref.Name != name {
+			return false
+		}
+		if kind == ResourceKindClusterMaintenanceWindow {
+			return ref.Kind == ResourceKindClusterMaintenanceWindow
+		}
+		ns := ref.Namespace
+		if ns == "" {
+			ns = defaultNamespace
+		}
+		return ref.Kind != ResourceKindClusterMaintenanceWindow && ns == namespace
+	}
+
+	var users []string
+	rcmdList := &RecommendationList{}
+	if err := webhookClient.List(ctx, rcmdList, client.MatchingFields{RecommendationWindowIndex: WindowIndexKey(kind, namespace, name)}); err != nil {
+		return err
+	}
+	for _, rcmd := range rcmdList.Items {
+		if rcmd.Status.ApprovalStatus == ApprovalApproved && rcmd.IsAwaitingOrProgressingRecommendation() {
+			users = append(users, fmt.Sprintf("%s %s/%s", ResourceKindRecommendation, rcmd.Namespace, rcmd.Name))
+		}
+	}
+
+	apList := &ApprovalPolicyList{}
+	if err := webhookClient.List(ctx, apList); err != nil {
+		return err
+	}
+	for _, ap := range apList.Items {
+		if refersTo(&ap.MaintenanceWindowRef, ap.Namespace) {
+			users = append(users, fmt.Sprintf("%s %s/%s", ResourceKindApprovalPolicy, ap.Namespace, ap.Name))
+		}
+	}
+	capList := &ClusterApprovalPolicyList{}
+	if err := webhookClient.List(ctx, capList); err != nil {
+		return err
+	}
+	for _, cp := range capList.Items {
+		if refersTo(&cp.MaintenanceWindowRef, "") {
+			users = append(users, fmt.Sprintf("%s %s", ResourceKindClusterApprovalPolicy, cp.Name))
+		}
+	}
+
+	if len(users) == 0 {
+		return nil
+	}
+	more := ""
+	if len(users) > maxInUseReferences {
+		more = fmt.Sprintf(" and %d more", len(users)-maxInUseReferences)
+		users = users[:maxInUseReferences]
+	}
+	return fmt.Errorf("%s %q is in use by %s%s", kind, name, strings.Join(users, ", "), more)
+}
@@ -61,6 +61,11 @@ type MaintenanceWindowSpec struct {
 	//     end: 2022-01-24T23:41:18Z
 	// +optional
 	Dates []DateWindow `json:"dates,omitempty"`
+
+	// Distribution specifies the managed clusters to distribute the MaintenanceWindow to, when the operator
+	// is running in the Open Cluster Management hub mode.
+	// +optional
+	Distribution *Distribution `json:"distribution,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=Sunday;Monday;Tuesday;Wednesday;Thursday;Friday;Saturday
@@ -99,12 +104,41 @@ type MaintenanceWindowStatus struct {
 	// Conditions applied to the database, such as approval or denial.
 	// +optional
 	Conditions []kmapi.Condition `json:"conditions,omitempty"`
+	// Clusters holds the status of the distributed MaintenanceWindow in each of the managed clusters.
+	// +optional
+	Clusters []ClusterStatus `json:"clusters,omitempty"`
+	// Utilization holds the utilization of the current or the last occurrence of the window.
+	// +optional
+	Utilization *WindowUtilization `json:"utilization,omitempty"`
+}
+
+// WindowUtilization specifies how much an occurrence of a MaintenanceWindow is used by the executions.
+type WindowUtilization struct {
+	// Start specifies when the occurrence opens.
+	Start metav1.Time `json:"start"`
+	// End specifies when the occurrence is closed.
+	End metav1.Time `json:"end"`
+	// Operations is the number of the executions completed in the occurrence.
+	// +optional
+	Operations int32 `json:"operations,omitempty"`
+	// BusyMinutes is the total duration of the executions in the occurrence in minutes.
+	// The executions running in parallel are counted separately.
+	// +optional
+	BusyMinutes int64 `json:"busyMinutes,omitempty"`
+	// QueueOverflow is the number of the Recommendations approved before the occurrence is closed
+	// which are still waiting for the window. While the window is open, it is the number of the
+	// Recommendations queued for the window.
+	// +optional
+	QueueOverflow int32 `json:"queueOverflow,omitempty"`
 }
 
 //+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Default",type="boolean",JSONPath=".spec.isDefault"
+// +kubebuilder:printcolumn:name="Timezone",type="string",JSONPath=".spec.timezone"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.status"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // MaintenanceWindow is the Schema for the maintenancewindows API
@@ -130,6 +164,9 @@ func init() {
 	SchemeBuilder.Register(&MaintenanceWindow{}, &MaintenanceWindowList{})
 }
 
+// Hub marks the v1alpha1 MaintenanceWindow as the conversion hub.
+func (*MaintenanceWindow) Hub() {}
+
 func (_ MaintenanceWindow) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
 	return crds.MustCustomResourceDefinition(GroupVersion.WithResource(ResourceMaintenanceWindows))
 }
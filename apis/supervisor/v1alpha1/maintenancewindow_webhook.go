@@ -44,11 +44,10 @@ var _ webhook.Defaulter = &MaintenanceWindow{}
 func (r *MaintenanceWindow) Default() {
 	maintenancewindowlog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	defaultWindowTimezone(&r.Spec)
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-//+kubebuilder:webhook:path=/validate-supervisor-appscode-com-v1alpha1-maintenancewindow,mutating=false,failurePolicy=fail,sideEffects=None,groups=supervisor.appscode.com,resources=maintenancewindows,verbs=create;update,versions=v1alpha1,name=vmaintenancewindow.kb.io,admissionReviewVersions={v1,v1beta1}
+//+kubebuilder:webhook:path=/validate-supervisor-appscode-com-v1alpha1-maintenancewindow,mutating=false,failurePolicy=fail,sideEffects=None,groups=supervisor.appscode.com,resources=maintenancewindows,verbs=create;update;delete,versions=v1alpha1,name=vmaintenancewindow.kb.io,admissionReviewVersions={v1,v1beta1}
 
 var _ webhook.Validator = &MaintenanceWindow{}
 
@@ -70,7 +69,7 @@ func (r *MaintenanceWindow) ValidateUpdate(old runtime.Object) (admission.Warnin
 func (r *MaintenanceWindow) ValidateDelete() (admission.Warnings, error) {
 	maintenancewindowlog.Info("validate delete", "name", r.Name)
 
-	return nil, nil
+	return nil, validateWindowNotInUse(context.TODO(), ResourceKindMaintenanceWindow, r.Namespace, r.Name)
 }
 
 func (r *MaintenanceWindow) validateMaintenanceWindow(ctx context.Context) error {
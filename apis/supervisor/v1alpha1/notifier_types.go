@@ -0,0 +1,167 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"kubeops.dev/supervisor/crds"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"kmodules.xyz/client-go/apiextensions"
+)
+
+const (
+	ResourceKindNotifier = "Notifier"
+	ResourceNotifier     = "notifier"
+	ResourceNotifiers    = "notifiers"
+)
+
+// +kubebuilder:validation:Enum=Slack;MSTeams;Email;Webhook;PagerDuty;Opsgenie;Jira
+type NotifierType string
+
+const (
+	NotifierTypeSlack   NotifierType = "Slack"
+	NotifierTypeMSTeams NotifierType = "MSTeams"
+	NotifierTypeEmail   NotifierType = "Email"
+	NotifierTypeWebhook NotifierType = "Webhook"
+	// NotifierTypePagerDuty and NotifierTypeOpsgenie open incidents for the escalated Events.
+	NotifierTypePagerDuty NotifierType = "PagerDuty"
+	NotifierTypeOpsgenie  NotifierType = "Opsgenie"
+	// NotifierTypeJira keeps a Jira issue per Recommendation in sync with its transitions.
+	NotifierTypeJira NotifierType = "Jira"
+)
+
+// List of keys of the Notifier Secret
+const (
+	// NotifierURLKey holds the incoming webhook url of the Slack, MSTeams and Webhook Notifiers
+	// and the base url of the Jira site of the Jira Notifiers.
+	NotifierURLKey = "url"
+	// NotifierUsernameKey and NotifierPasswordKey hold the SMTP credentials of the Email Notifiers
+	// and the user email & api token of the Jira Notifiers.
+	NotifierUsernameKey = "username"
+	NotifierPasswordKey = "password"
+	// NotifierIntegrationKey holds the routing key of the PagerDuty and the api key of the Opsgenie Notifiers.
+	NotifierIntegrationKey = "integrationKey"
+)
+
+// NotifierSpec defines where and for which transitions of the Recommendations the notifications are sent
+type NotifierSpec struct {
+	// Type specifies the backend of the Notifier.
+	Type NotifierType `json:"type"`
+
+	// SecretRef refers to the Secret of the same namespace holding the url
+	// or the credentials of the backend.
+	SecretRef core.LocalObjectReference `json:"secretRef"`
+
+	// Email specifies the SMTP configuration of the Email Notifier.
+	// +optional
+	Email *EmailNotifier `json:"email,omitempty"`
+
+	// Jira specifies the project and the workflow of the issues of the Jira Notifier.
+	// +optional
+	Jira *JiraNotifier `json:"jira,omitempty"`
+
+	// Events specifies the Event reasons of the Recommendations to notify, e.g. `AwaitingApproval`, `Approved`,
+	// `ExecutionSucceeded`, `ExecutionFailed`, and `Digest` for the periodic summary of the namespace.
+	// Every Event reason is notified if it is empty.
+	// The PagerDuty and Opsgenie Notifiers escalate the failed executions and the expired Critical Recommendations
+	// if it is empty.
+	// +optional
+	Events []string `json:"events,omitempty"`
+
+	// Template is the Go template of the payload sent by the Slack, MSTeams and Webhook Notifiers,
+	// or of the body of the Email Notifier. The template is executed with the `.Event`, `.Text`, `.Recommendation`,
+	// `.Target`, `.TargetObject` and `.Window` fields and the sprig functions, e.g. `{"summary": {{ .Text | toJson }}}`.
+	// The default payload of the Notifier type is sent if it is empty.
+	// +optional
+	Template string `json:"template,omitempty"`
+
+	// Interactive adds the Approve and Reject buttons to the AwaitingApproval messages of the Slack Notifier.
+	// The interactivity request url of the Slack app must point to the Slack callback server of the operator.
+	// +optional
+	Interactive bool `json:"interactive,omitempty"`
+
+	// Paused stops sending the notifications.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// EmailNotifier specifies the SMTP server and the recipients of the notifications
+type EmailNotifier struct {
+	Host string `json:"host"`
+	// +kubebuilder:default=587
+	Port int32    `json:"port,omitempty"`
+	From string   `json:"from"`
+	To   []string `json:"to"`
+}
+
+// JiraNotifier specifies the Jira issues created for the Recommendations. An issue is created for the first
+// notified Event of a Recommendation, every following Event is added as a comment, and the issue is
+// transitioned as the Recommendation progresses.
+type JiraNotifier struct {
+	// Project specifies the key of the Jira project to create the issues in.
+	Project string `json:"project"`
+
+	// IssueType specifies the type of the created issues.
+	// +optional
+	// +kubebuilder:default=Task
+	IssueType string `json:"issueType,omitempty"`
+
+	// InProgressTransition specifies the name of the transition applied once the execution is started.
+	// +optional
+	// +kubebuilder:default="In Progress"
+	InProgressTransition string `json:"inProgressTransition,omitempty"`
+
+	// DoneTransition specifies the name of the transition applied once the Recommendation is
+	// Succeeded, Failed, Stalled, Rejected or Skipped.
+	// +optional
+	// +kubebuilder:default=Done
+	DoneTransition string `json:"doneTransition,omitempty"`
+}
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".spec.paused"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// Notifier is the Schema for the notifiers API.
+// It sends notifications for the Recommendations of its namespace.
+type Notifier struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NotifierSpec `json:"spec,omitempty"`
+}
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+//+kubebuilder:object:root=true
+
+// NotifierList contains a list of Notifier
+type NotifierList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Notifier `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Notifier{}, &NotifierList{})
+}
+
+func (_ Notifier) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(GroupVersion.WithResource(ResourceNotifiers))
+}
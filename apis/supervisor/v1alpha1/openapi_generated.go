@@ -371,28 +371,80 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kmodules.xyz/client-go/api/v1.TypedObjectReference":                           schema_kmodulesxyz_client_go_api_v1_TypedObjectReference(ref),
 		"kmodules.xyz/client-go/api/v1.X509Subject":                                    schema_kmodulesxyz_client_go_api_v1_X509Subject(ref),
 		"kmodules.xyz/client-go/api/v1.stringSetMerger":                                schema_kmodulesxyz_client_go_api_v1_stringSetMerger(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.Approval":                     schema_supervisor_apis_supervisor_v1alpha1_Approval(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.ApprovalPolicy":               schema_supervisor_apis_supervisor_v1alpha1_ApprovalPolicy(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.ApprovalPolicyList":           schema_supervisor_apis_supervisor_v1alpha1_ApprovalPolicyList(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.ApprovedWindow":               schema_supervisor_apis_supervisor_v1alpha1_ApprovedWindow(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.BackupTrigger":                schema_supervisor_apis_supervisor_v1alpha1_BackupTrigger(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.CVEReport":                    schema_supervisor_apis_supervisor_v1alpha1_CVEReport(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.CanaryStrategy":               schema_supervisor_apis_supervisor_v1alpha1_CanaryStrategy(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.ChangeRequest":                schema_supervisor_apis_supervisor_v1alpha1_ChangeRequest(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.ClusterApprovalPolicy":        schema_supervisor_apis_supervisor_v1alpha1_ClusterApprovalPolicy(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.ClusterApprovalPolicyList":    schema_supervisor_apis_supervisor_v1alpha1_ClusterApprovalPolicyList(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.ClusterMaintenanceWindow":     schema_supervisor_apis_supervisor_v1alpha1_ClusterMaintenanceWindow(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.ClusterMaintenanceWindowList": schema_supervisor_apis_supervisor_v1alpha1_ClusterMaintenanceWindowList(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.ClusterStatus":                schema_supervisor_apis_supervisor_v1alpha1_ClusterStatus(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.DateWindow":                   schema_supervisor_apis_supervisor_v1alpha1_DateWindow(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.Distribution":                 schema_supervisor_apis_supervisor_v1alpha1_Distribution(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.DowntimeBudget":               schema_supervisor_apis_supervisor_v1alpha1_DowntimeBudget(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.DowntimeBudgetList":           schema_supervisor_apis_supervisor_v1alpha1_DowntimeBudgetList(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.DowntimeBudgetSpec":           schema_supervisor_apis_supervisor_v1alpha1_DowntimeBudgetSpec(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.DowntimeBudgetStatus":         schema_supervisor_apis_supervisor_v1alpha1_DowntimeBudgetStatus(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.DurationEstimate":             schema_supervisor_apis_supervisor_v1alpha1_DurationEstimate(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.EmailNotifier":                schema_supervisor_apis_supervisor_v1alpha1_EmailNotifier(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.ExecutionFailure":             schema_supervisor_apis_supervisor_v1alpha1_ExecutionFailure(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.ExecutionHook":                schema_supervisor_apis_supervisor_v1alpha1_ExecutionHook(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.ForcedExecution":              schema_supervisor_apis_supervisor_v1alpha1_ForcedExecution(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.Impact":                       schema_supervisor_apis_supervisor_v1alpha1_Impact(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.ImpactLimit":                  schema_supervisor_apis_supervisor_v1alpha1_ImpactLimit(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.JiraNotifier":                 schema_supervisor_apis_supervisor_v1alpha1_JiraNotifier(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceExecution":         schema_supervisor_apis_supervisor_v1alpha1_MaintenanceExecution(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceExecutionList":     schema_supervisor_apis_supervisor_v1alpha1_MaintenanceExecutionList(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceExecutionSpec":     schema_supervisor_apis_supervisor_v1alpha1_MaintenanceExecutionSpec(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceFreeze":            schema_supervisor_apis_supervisor_v1alpha1_MaintenanceFreeze(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceFreezeList":        schema_supervisor_apis_supervisor_v1alpha1_MaintenanceFreezeList(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceFreezeSpec":        schema_supervisor_apis_supervisor_v1alpha1_MaintenanceFreezeSpec(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindow":            schema_supervisor_apis_supervisor_v1alpha1_MaintenanceWindow(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowList":        schema_supervisor_apis_supervisor_v1alpha1_MaintenanceWindowList(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowSpec":        schema_supervisor_apis_supervisor_v1alpha1_MaintenanceWindowSpec(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowStatus":      schema_supervisor_apis_supervisor_v1alpha1_MaintenanceWindowStatus(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.NodeMaintenance":              schema_supervisor_apis_supervisor_v1alpha1_NodeMaintenance(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.NodeMaintenanceSpec":          schema_supervisor_apis_supervisor_v1alpha1_NodeMaintenanceSpec(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.Notifier":                     schema_supervisor_apis_supervisor_v1alpha1_Notifier(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.NotifierList":                 schema_supervisor_apis_supervisor_v1alpha1_NotifierList(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.NotifierSpec":                 schema_supervisor_apis_supervisor_v1alpha1_NotifierSpec(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.Operation":                    schema_supervisor_apis_supervisor_v1alpha1_Operation(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.OperationPhaseRules":          schema_supervisor_apis_supervisor_v1alpha1_OperationPhaseRules(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.Patch":                        schema_supervisor_apis_supervisor_v1alpha1_Patch(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.PatchSpec":                    schema_supervisor_apis_supervisor_v1alpha1_PatchSpec(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.PhaseCount":                   schema_supervisor_apis_supervisor_v1alpha1_PhaseCount(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.PolicyException":              schema_supervisor_apis_supervisor_v1alpha1_PolicyException(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.PrometheusGate":               schema_supervisor_apis_supervisor_v1alpha1_PrometheusGate(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.Recommendation":               schema_supervisor_apis_supervisor_v1alpha1_Recommendation(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationGroup":          schema_supervisor_apis_supervisor_v1alpha1_RecommendationGroup(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationGroupList":      schema_supervisor_apis_supervisor_v1alpha1_RecommendationGroupList(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationGroupSpec":      schema_supervisor_apis_supervisor_v1alpha1_RecommendationGroupSpec(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationGroupStatus":    schema_supervisor_apis_supervisor_v1alpha1_RecommendationGroupStatus(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationList":           schema_supervisor_apis_supervisor_v1alpha1_RecommendationList(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationSpec":           schema_supervisor_apis_supervisor_v1alpha1_RecommendationSpec(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationStatus":         schema_supervisor_apis_supervisor_v1alpha1_RecommendationStatus(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.RollingRestart":               schema_supervisor_apis_supervisor_v1alpha1_RollingRestart(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.RolloutStrategy":              schema_supervisor_apis_supervisor_v1alpha1_RolloutStrategy(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.ScheduledWindow":              schema_supervisor_apis_supervisor_v1alpha1_ScheduledWindow(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.ServiceNowChangeRequest":      schema_supervisor_apis_supervisor_v1alpha1_ServiceNowChangeRequest(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.Subject":                      schema_supervisor_apis_supervisor_v1alpha1_Subject(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.SupervisorReport":             schema_supervisor_apis_supervisor_v1alpha1_SupervisorReport(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.SupervisorReportList":         schema_supervisor_apis_supervisor_v1alpha1_SupervisorReportList(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.SupervisorReportSpec":         schema_supervisor_apis_supervisor_v1alpha1_SupervisorReportSpec(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.SupervisorReportStatus":       schema_supervisor_apis_supervisor_v1alpha1_SupervisorReportStatus(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.TargetRef":                    schema_supervisor_apis_supervisor_v1alpha1_TargetRef(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.TargetSnapshot":               schema_supervisor_apis_supervisor_v1alpha1_TargetSnapshot(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.TimeWindow":                   schema_supervisor_apis_supervisor_v1alpha1_TimeWindow(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.UpcomingExecution":            schema_supervisor_apis_supervisor_v1alpha1_UpcomingExecution(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.Verification":                 schema_supervisor_apis_supervisor_v1alpha1_Verification(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.Vulnerability":                schema_supervisor_apis_supervisor_v1alpha1_Vulnerability(ref),
 		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.VulnerabilityReport":          schema_supervisor_apis_supervisor_v1alpha1_VulnerabilityReport(ref),
+		"kubeops.dev/supervisor/apis/supervisor/v1alpha1.WindowUtilization":            schema_supervisor_apis_supervisor_v1alpha1_WindowUtilization(ref),
 	}
 }
 
@@ -18384,6 +18436,51 @@ func schema_kmodulesxyz_client_go_api_v1_stringSetMerger(ref common.ReferenceCal
 	}
 }
 
+func schema_supervisor_apis_supervisor_v1alpha1_Approval(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Approval specifies who approved the Recommendation and when it is approved.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username is the name of the user who approved the Recommendation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"groups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Groups holds the groups of the user who approved the Recommendation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timestamp specifies when the Recommendation is approved.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"username", "timestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_supervisor_apis_supervisor_v1alpha1_ApprovalPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18413,11 +18510,75 @@ func schema_supervisor_apis_supervisor_v1alpha1_ApprovalPolicy(ref common.Refere
 					},
 					"maintenanceWindowRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies MaintenanceWindow reference for ApprovalPolicy. Recommendation will be executed in this MaintenanceWindow without manual approval.",
+							Description: "Specifies MaintenanceWindow reference for ApprovalPolicy. Recommendation will be executed in this MaintenanceWindow without manual approval. The kind can be either `MaintenanceWindow` or `ClusterMaintenanceWindow`. If the kind is not set, MaintenanceWindow is used and the namespace of the ApprovalPolicy is used if its namespace is not set. It is ignored for the `Deny` effect.",
 							Default:     map[string]interface{}{},
 							Ref:         ref("kmodules.xyz/client-go/api/v1.TypedObjectReference"),
 						},
 					},
+					"except": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Except specifies the target objects which are excluded from this ApprovalPolicy, e.g. the critical databases which always need manual review.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.PolicyException"),
+						},
+					},
+					"effect": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Effect specifies what happens to the Recommendations which are matched with this ApprovalPolicy. Possible values are: Allow: The Recommendations are approved to execute in the MaintenanceWindow. Deny: The Recommendations are rejected regardless of the MaintenanceWindows, even if they require explicit approval. Deny takes precedence over Allow when multiple ApprovalPolicies are matched.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"denyReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DenyReason holds the reason to record in the Recommendations which are rejected by this ApprovalPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxSeverity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSeverity specifies the highest severity of the Recommendations which are auto-approved by this ApprovalPolicy. The Recommendations of a higher severity require manual approval. If it is not set, the Recommendations of any severity are auto-approved.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxImpact": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxImpact specifies the highest impact of the Recommendations which are auto-approved by this ApprovalPolicy. The Recommendations of a higher impact require manual approval. The Recommendations without any impact are considered as of no impact.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ImpactLimit"),
+						},
+					},
+					"requiredApprovals": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiredApprovals specifies the number of distinct users who must approve the matched Recommendations. If it is set, the matched Recommendations are not auto-approved. Instead, they are Approved once the required number of users have approved them.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"autoRejectAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoRejectAfter specifies the duration after which the matched Recommendations, which are still pending for approval, are rejected automatically with the `Stale` reason.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxQueueDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxQueueDuration specifies the SLA of the matched Recommendations. If a Recommendation is pending or waiting for longer than this duration after its creation, the `SLABreached` condition is set. It overrides the operator wide `--max-queue-duration` flag.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"validFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValidFrom specifies the time from which the ApprovalPolicy is effective. If it is not set, the ApprovalPolicy is effective since its creation.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"validUntil": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValidUntil specifies the time until which the ApprovalPolicy is effective. If it is not set, the ApprovalPolicy never expires. The Recommendations approved before the expiry are not affected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 					"targets": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Specifies the list of TargetRef for which the ApprovalPolicy will be effective for.",
@@ -18432,12 +18593,45 @@ func schema_supervisor_apis_supervisor_v1alpha1_ApprovalPolicy(ref common.Refere
 							},
 						},
 					},
+					"concurrencyPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConcurrencyPolicy specifies how to treat a Recommendation when another Recommendation of the same target object is already in progress. Possible values are: Queue: The Recommendation waits until the other Recommendation is completed. Forbid: The Recommendation is skipped. Replace: The operation of the other Recommendation is deleted and the Recommendation is executed. It can be overridden for a target object by the `supervisor.appscode.com/concurrency-policy` annotation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"backup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backup specifies the backup to trigger and wait for before executing the operations of the Recommendations which are matched with this ApprovalPolicy.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.BackupTrigger"),
+						},
+					},
+					"serviceNow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceNow specifies the ServiceNow change request to create for the Recommendations which are matched with this ApprovalPolicy and require approval. The Recommendations are Approved only once the change request is approved in ServiceNow.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ServiceNowChangeRequest"),
+						},
+					},
+					"prometheusGates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrometheusGates specifies the PromQL thresholds which must be satisfied before and during the executions of the Recommendations which are matched with this ApprovalPolicy, in addition to their own gates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.PrometheusGate"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"maintenanceWindowRef"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kmodules.xyz/client-go/api/v1.TypedObjectReference", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.TargetRef"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kmodules.xyz/client-go/api/v1.TypedObjectReference", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.BackupTrigger", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ImpactLimit", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.PolicyException", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.PrometheusGate", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ServiceNowChangeRequest", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.TargetRef"},
 	}
 }
 
@@ -18532,6 +18726,57 @@ func schema_supervisor_apis_supervisor_v1alpha1_ApprovedWindow(ref common.Refere
 	}
 }
 
+func schema_supervisor_apis_supervisor_v1alpha1_BackupTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupTrigger specifies the BackupConfiguration to trigger a BackupSession from.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider specifies the backup solution. Possible values are `Stash` and `KubeStash`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"backupConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupConfiguration specifies the name of the BackupConfiguration of the target in the namespace of the Recommendation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"session": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Session specifies the session name of the KubeStash BackupConfiguration to trigger.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operationTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperationTypes specifies the types (`.spec.type`) of the operations, e.g. `UpdateVersion` or `Reconfigure`, for which the backup is triggered. If it is empty, the backup is triggered for every operation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"backupConfiguration"},
+			},
+		},
+	}
+}
+
 func schema_supervisor_apis_supervisor_v1alpha1_CVEReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18574,120 +18819,80 @@ func schema_supervisor_apis_supervisor_v1alpha1_CVEReport(ref common.ReferenceCa
 	}
 }
 
-func schema_supervisor_apis_supervisor_v1alpha1_ClusterMaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_supervisor_apis_supervisor_v1alpha1_CanaryStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterMaintenanceWindow is the Schema for the clustermaintenancewindows API",
+				Description: "CanaryStrategy specifies how the canary members of a RecommendationGroup are executed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
+					"replicas": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Replicas specifies the number of member Recommendations to execute first. Members are sorted by name to select the canary members.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"spec": {
+					"verificationPeriod": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowSpec"),
+							Description: "VerificationPeriod specifies how long to wait after the canary members are succeeded before executing the rest of the members.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"status": {
+					"haltOnFailure": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowStatus"),
+							Description: "HaltOnFailure specifies whether the rest of the members are skipped if any of the canary members is failed. If it is false, the rest of the members are executed once the canary members are completed.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"replicas"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowSpec", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_supervisor_apis_supervisor_v1alpha1_ClusterMaintenanceWindowList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_supervisor_apis_supervisor_v1alpha1_ChangeRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterMaintenanceWindowList contains a list of ClusterMaintenanceWindow",
+				Description: "ChangeRequest specifies the change request created for a Recommendation in ServiceNow.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "ID holds the `sys_id` of the change request.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"number": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Number holds the human readable number of the change request, e.g. `CHG0030001`.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ClusterMaintenanceWindow"),
-									},
-								},
-							},
-						},
-					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ClusterMaintenanceWindow"},
-	}
-}
-
-func schema_supervisor_apis_supervisor_v1alpha1_DateWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"start": {
+					"approval": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Approval holds the last observed approval state of the change request, e.g. `requested`, `approved` or `rejected`.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"end": {
+					"lastSyncTime": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "LastSyncTime specifies when the approval state is last observed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"start", "end"},
+				Required: []string{"id"},
 			},
 		},
 		Dependencies: []string{
@@ -18695,11 +18900,11 @@ func schema_supervisor_apis_supervisor_v1alpha1_DateWindow(ref common.ReferenceC
 	}
 }
 
-func schema_supervisor_apis_supervisor_v1alpha1_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_supervisor_apis_supervisor_v1alpha1_ClusterApprovalPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MaintenanceWindow is the Schema for the maintenancewindows API",
+				Description: "ClusterApprovalPolicy is the Schema for the clusterapprovalpolicies API. It works like an ApprovalPolicy for the Recommendations of every namespace selected by the NamespaceSelector. The ApprovalPolicies of the namespace are preferred over the ClusterApprovalPolicies of the same effect.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -18722,252 +18927,193 @@ func schema_supervisor_apis_supervisor_v1alpha1_MaintenanceWindow(ref common.Ref
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"spec": {
+					"namespaceSelector": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowSpec"),
+							Description: "NamespaceSelector selects the namespaces for which the ClusterApprovalPolicy will be effective for. If it is not set, the ClusterApprovalPolicy is effective for all the namespaces.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"status": {
+					"maintenanceWindowRef": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowStatus"),
+							Description: "Specifies MaintenanceWindow reference for ClusterApprovalPolicy. The kind can be either `MaintenanceWindow` or `ClusterMaintenanceWindow`. If the kind is not set, MaintenanceWindow of the namespace of the Recommendation is used. It is ignored for the `Deny` effect.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kmodules.xyz/client-go/api/v1.TypedObjectReference"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowSpec", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowStatus"},
-	}
-}
-
-func schema_supervisor_apis_supervisor_v1alpha1_MaintenanceWindowList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MaintenanceWindowList contains a list of MaintenanceWindow",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Specifies the list of TargetRef for which the ClusterApprovalPolicy will be effective for.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.TargetRef"),
+									},
+								},
+							},
+						},
+					},
+					"except": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Except specifies the target objects which are excluded from this ClusterApprovalPolicy.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.PolicyException"),
+						},
+					},
+					"effect": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Effect specifies whether the matched Recommendations are approved or rejected.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"denyReason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "DenyReason holds the reason to record in the Recommendations which are rejected by this ClusterApprovalPolicy.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"maxSeverity": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "MaxSeverity specifies the highest severity of the Recommendations which are auto-approved.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"maxImpact": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindow"),
-									},
-								},
-							},
+							Description: "MaxImpact specifies the highest impact of the Recommendations which are auto-approved. The Recommendations of a higher impact require manual approval. The Recommendations without any impact are considered as of no impact.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ImpactLimit"),
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindow"},
-	}
-}
-
-func schema_supervisor_apis_supervisor_v1alpha1_MaintenanceWindowSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MaintenanceWindowSpec defines the desired state of MaintenanceWindow",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"isDefault": {
+					"requiredApprovals": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "RequiredApprovals specifies the number of distinct users who must approve the matched Recommendations.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"timezone": {
+					"autoRejectAfter": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If the Timezone is not set or \"\" or \"UTC\", the given times and dates are considered as UTC. If the name is \"Local\", the given times and dates are considered as server local timezone.\n\nOtherwise, the Timezone should specify a location name corresponding to a file in the IANA Time Zone database, such as \"Asia/Dhaka\", \"America/New_York\", . Ref: https://www.iana.org/time-zones\n     https://en.wikipedia.org/wiki/List_of_tz_database_time_zones",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "AutoRejectAfter specifies the duration after which the pending Recommendations are rejected automatically.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"days": {
+					"maxQueueDuration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Days consists of a map of DayOfWeek and corresponding list of TimeWindow. There is `Logical OR` relationship between Days and Dates. Example:\n days:\n   Monday:\n    - start: 10:40AM\n      end: 7:00PM",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type: []string{"array"},
-										Items: &spec.SchemaOrArray{
-											Schema: &spec.Schema{
-												SchemaProps: spec.SchemaProps{
-													Default: map[string]interface{}{},
-													Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.TimeWindow"),
-												},
-											},
-										},
-									},
-								},
-							},
+							Description: "MaxQueueDuration specifies the SLA of the matched Recommendations.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"dates": {
+					"validFrom": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Dates consists of a list of Dates as Maintenance time. Dates are always needed to be given in UTC format. Format: yyyy-mm-ddThh.mm.ssZ [Here Z stands for Zero time zone / UTC time zone / GMT (+0000)] Example:\n dates:\n  - start: 2022-01-24T00:00:18Z\n    end: 2022-01-24T23:41:18Z",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.DateWindow"),
-									},
-								},
-							},
+							Description: "ValidFrom specifies the time from which the ClusterApprovalPolicy is effective.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"kubeops.dev/supervisor/apis/supervisor/v1alpha1.DateWindow", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.TimeWindow"},
-	}
-}
-
-func schema_supervisor_apis_supervisor_v1alpha1_MaintenanceWindowStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MaintenanceWindowStatus defines the observed state of MaintenanceWindow",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"status": {
+					"validUntil": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the current phase of the database",
+							Description: "ValidUntil specifies the time until which the ClusterApprovalPolicy is effective.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"concurrencyPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConcurrencyPolicy specifies how to treat a Recommendation when another Recommendation of the same target object is already in progress.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"observedGeneration": {
+					"backup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "observedGeneration is the most recent generation observed for this resource. It corresponds to the resource's generation, which is updated on mutation by the API Server.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Backup specifies the backup to trigger and wait for before executing the operations of the Recommendations which are matched with this ClusterApprovalPolicy.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.BackupTrigger"),
 						},
 					},
-					"conditions": {
+					"serviceNow": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions applied to the database, such as approval or denial.",
+							Description: "ServiceNow specifies the ServiceNow change request to create for the Recommendations which are matched with this ClusterApprovalPolicy and require approval. The Recommendations are Approved only once the change request is approved in ServiceNow.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ServiceNowChangeRequest"),
+						},
+					},
+					"prometheusGates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrometheusGates specifies the PromQL thresholds which must be satisfied before and during the executions of the Recommendations which are matched with this ClusterApprovalPolicy, in addition to their own gates.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kmodules.xyz/client-go/api/v1.Condition"),
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.PrometheusGate"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"maintenanceWindowRef"},
 			},
 		},
 		Dependencies: []string{
-			"kmodules.xyz/client-go/api/v1.Condition"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kmodules.xyz/client-go/api/v1.TypedObjectReference", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.BackupTrigger", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ImpactLimit", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.PolicyException", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.PrometheusGate", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ServiceNowChangeRequest", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.TargetRef"},
 	}
 }
 
-func schema_supervisor_apis_supervisor_v1alpha1_Operation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_supervisor_apis_supervisor_v1alpha1_ClusterApprovalPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ClusterApprovalPolicyList contains a list of ClusterApprovalPolicy",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"group": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
 					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"group", "kind"},
-			},
-		},
-	}
-}
-
-func schema_supervisor_apis_supervisor_v1alpha1_OperationPhaseRules(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "OperationPhaseRules defines three identification rules of successful execution of the operation, progressing execution of the operation & failed execution of the operation. To specifies any field of the Operation object, the rule must start with the word `self`. Example:\n\n\t.status.phase -> self.status.phase\n\t.status.observedGeneration -> self.status.observedGeneration\n\nThe rules can be any valid expression supported by CEL(Common Expression Language). Ref: https://github.com/google/cel-spec",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"success": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Success defines a rule to identify the successful execution of the operation. Example:\n  success: `has(self.status.phase) && self.status.phase == 'Successful'`\nHere self.status.phase is pointing to .status.phase field of the Operation object. When .status.phase field presents and becomes `Successful`, the Success rule will satisfy.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"inProgress": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InProgress defines a rule to identify that applied operation is progressing. Example:\n  inProgress: `has(self.status.phase) && self.status.phase == 'Progressing'`\nHere self.status.phase is pointing to .status.phase field of the Operation object. When .status.phase field presents and becomes `Progressing`, the InProgress rule will satisfy.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"failed": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Failed defines a rule to identify that applied operation is failed. Example:\n  inProgress: `has(self.status.phase) && self.status.phase == 'Failed'`\nHere self.status.phase is pointing to .status.phase field of the Operation object. When .status.phase field presents and becomes `Failed`, the Failed rule will satisfy.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ClusterApprovalPolicy"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"success", "inProgress", "failed"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ClusterApprovalPolicy"},
 	}
 }
 
-func schema_supervisor_apis_supervisor_v1alpha1_Recommendation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_supervisor_apis_supervisor_v1alpha1_ClusterMaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Recommendation is the Schema for the recommendations API",
+				Description: "ClusterMaintenanceWindow is the Schema for the clustermaintenancewindows API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -18993,28 +19139,28 @@ func schema_supervisor_apis_supervisor_v1alpha1_Recommendation(ref common.Refere
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationSpec"),
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationStatus"),
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationSpec", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowSpec", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowStatus"},
 	}
 }
 
-func schema_supervisor_apis_supervisor_v1alpha1_RecommendationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_supervisor_apis_supervisor_v1alpha1_ClusterMaintenanceWindowList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RecommendationList contains a list of Recommendation",
+				Description: "ClusterMaintenanceWindowList contains a list of ClusterMaintenanceWindow",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -19044,7 +19190,7 @@ func schema_supervisor_apis_supervisor_v1alpha1_RecommendationList(ref common.Re
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.Recommendation"),
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ClusterMaintenanceWindow"),
 									},
 								},
 							},
@@ -19055,83 +19201,2193 @@ func schema_supervisor_apis_supervisor_v1alpha1_RecommendationList(ref common.Re
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.Recommendation"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ClusterMaintenanceWindow"},
 	}
 }
 
-func schema_supervisor_apis_supervisor_v1alpha1_RecommendationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_supervisor_apis_supervisor_v1alpha1_ClusterStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RecommendationSpec defines the desired state of Recommendation",
+				Description: "ClusterStatus specifies the status of a distributed object in a managed cluster, as reported back by its ManifestWork.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"description": {
+					"cluster": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description specifies the reason why this recommendation is generated.",
+							Description: "Cluster specifies the name of the ManagedCluster.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"vulnerabilityReport": {
-						SchemaProps: spec.SchemaProps{
-							Description: "VulnerabilityReport specifies any kind vulnerability report like cve fixed information",
-							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.VulnerabilityReport"),
-						},
-					},
-					"target": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Target specifies the APIGroup, Kind & Name of the target resource for which the recommendation is generated",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
-						},
-					},
-					"operation": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Operation holds a kubernetes object yaml which will be applied when this recommendation will be executed. It should be a valid kubernetes resource yaml containing apiVersion, kind and metadata fields.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
-						},
-					},
-					"recommender": {
+					"applied": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Recommender holds the name and namespace of the component which generate this recommendation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kmodules.xyz/client-go/api/v1.ObjectReference"),
+							Description: "Applied is true once the object is applied in the managed cluster.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"deadline": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The recommendation will be executed within the given Deadline. To maintain deadline, Parallelism can be compromised.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Phase holds the phase of the distributed Recommendation in the managed cluster.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"requireExplicitApproval": {
+					"approvalStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If RequireExplicitApproval is set to `true` then the Recommendation must be Approved manually. Recommendation won't be executed without manual approval and any kind of ApprovalPolicy will be ignored.",
-							Type:        []string{"boolean"},
+							Description: "ApprovalStatus holds the approval status of the distributed object in the managed cluster.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"rules": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Rules defines OperationPhaseRules. It contains three identification rules of successful execution of the operation, progressing execution of the operation & failed execution of the operation. Example: rules:\n  success:    `has(self.status.phase) && self.status.phase == 'Successful'`\n  inProgress: `has(self.status.phase) && self.status.phase == 'Progressing'`\n  failed:     `has(self.status.phase) && self.status.phase == 'Failed'`",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.OperationPhaseRules"),
+							Description: "Reason holds the reason of the phase of the distributed Recommendation in the managed cluster.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"backoffLimit": {
+					"completionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BackoffLimit specifies the number of retries before marking this recommendation failed. By default set as five(5). If BackoffLimit is zero(0), the operation will be tried to executed only once.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "CompletionTime specifies when the distributed Recommendation is first observed as completed in the managed cluster.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"target", "operation", "recommender", "rules"},
+				Required: []string{"cluster", "applied"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.TypedLocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension", "kmodules.xyz/client-go/api/v1.ObjectReference", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.OperationPhaseRules", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.VulnerabilityReport"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_DateWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_Distribution(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Distribution specifies the Open Cluster Management ManagedClusters to distribute an object to through ManifestWorks. The object is distributed to the union of the listed and the selected clusters.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Clusters specifies the names of the ManagedClusters.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"clusterSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterSelector selects the ManagedClusters by their labels.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout specifies the staggered rollout strategy of a distributed Recommendation. If it is specified, the Recommendation is distributed to the clusters in waves. It is ignored for the MaintenanceWindows.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.RolloutStrategy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.RolloutStrategy"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_DowntimeBudget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DowntimeBudget is the Schema for the downtimebudgets API. It limits the monthly disruption caused by the executions of the Recommendations, like an SLO error budget. The executions that would exceed the remaining budget are deferred until the next period.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.DowntimeBudgetSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.DowntimeBudgetStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.DowntimeBudgetSpec", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.DowntimeBudgetStatus"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_DowntimeBudgetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DowntimeBudgetList contains a list of DowntimeBudget",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.DowntimeBudget"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.DowntimeBudget"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_DowntimeBudgetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DowntimeBudgetSpec defines the allowed disruption of the targets of a namespace",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target specifies the APIGroup, Kind & Name of the target resource whose disruption is limited. If it is not set, the total disruption of every target of the namespace is limited.",
+							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
+						},
+					},
+					"monthlyMinutes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonthlyMinutes specifies the allowed disruption in minutes per calendar month, in UTC.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"monthlyMinutes"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.TypedLocalObjectReference"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_DowntimeBudgetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DowntimeBudgetStatus defines the observed state of DowntimeBudget",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"periodStart": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PeriodStart specifies the start of the current budget period.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"periodEnd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PeriodEnd specifies the end of the current budget period, when the budget is reset.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"executions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Executions holds the number of executions counted against the budget in the current period.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"usedMinutes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UsedMinutes holds the disruption in minutes consumed in the current period.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"remainingMinutes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemainingMinutes holds the disruption in minutes left in the current period.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this resource.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_DurationEstimate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DurationEstimate specifies the duration of an operation estimated from the latest successful executions of the same kind of operation on the targets of the same kind and size.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the rolling average duration of the executions.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"samples": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Samples is the number of the executions the duration is estimated from.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"targetReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetReplicas is the number of replicas of the target, used as the size of the target.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_EmailNotifier(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EmailNotifier specifies the SMTP server and the recipients of the notifications",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"host", "from", "to"},
+			},
+		},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_ExecutionFailure(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExecutionFailure holds the details of a failed execution.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"recommendation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Recommendation holds the name of the executed Recommendation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target specifies the APIGroup, Kind & Name of the target resource of the Recommendation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
+						},
+					},
+					"result": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Result specifies the final phase of the Recommendation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason holds a message indicating details about the failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime specifies when the execution is completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"recommendation", "target", "result"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.TypedLocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_ExecutionHook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExecutionHook specifies the Job to run as a hook of the execution.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"job": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Job specifies the template of the Job to run.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/batch/v1.JobTemplateSpec"),
+						},
+					},
+				},
+				Required: []string{"job"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/batch/v1.JobTemplateSpec"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_ForcedExecution(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ForcedExecution specifies who forced the execution of a Recommendation and when it is forced.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"requestedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestedBy is the username who requested the execution bypassing the MaintenanceWindow.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timestamp specifies when the forced execution is started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"timestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_Impact(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Impact specifies the expected impact of executing the operation of a Recommendation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"expectedDowntime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpectedDowntime specifies how long the target is expected to be unavailable during the execution.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"dataMigrationSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataMigrationSize specifies the size of the data migrated by the operation, e.g. `10Gi`.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description holds a human readable description of the impact.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_ImpactLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImpactLimit specifies the limits of the Impact of the Recommendations.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"downtime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Downtime specifies the highest expected downtime.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"dataMigrationSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataMigrationSize specifies the largest size of the migrated data.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_JiraNotifier(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JiraNotifier specifies the Jira issues created for the Recommendations. An issue is created for the first notified Event of a Recommendation, every following Event is added as a comment, and the issue is transitioned as the Recommendation progresses.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"project": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Project specifies the key of the Jira project to create the issues in.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"issueType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IssueType specifies the type of the created issues.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"inProgressTransition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InProgressTransition specifies the name of the transition applied once the execution is started.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"doneTransition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DoneTransition specifies the name of the transition applied once the Recommendation is Succeeded, Failed, Stalled, Rejected or Skipped.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"project"},
+			},
+		},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_MaintenanceExecution(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceExecution is the Schema for the maintenanceexecutions API. It is a durable audit record of an executed Recommendation, independent of the Recommendation lifetime.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceExecutionSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceExecutionSpec"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_MaintenanceExecutionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceExecutionList contains a list of MaintenanceExecution",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceExecution"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceExecution"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_MaintenanceExecutionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceExecutionSpec holds the immutable record of an executed Recommendation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"recommendation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Recommendation holds the name of the executed Recommendation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"recommendationUID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecommendationUID holds the uid of the executed Recommendation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target specifies the APIGroup, Kind & Name of the target resource of the Recommendation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
+						},
+					},
+					"operationRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperationRef refers to the operation object created for the Recommendation.",
+							Ref:         ref("kmodules.xyz/client-go/api/v1.TypedObjectReference"),
+						},
+					},
+					"operationType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperationType holds the type of the operation, e.g. the `spec.type` of a KubeDB OpsRequest, if any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetReplicas holds the number of replicas of the target when the operation is created.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"downtime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Downtime holds the expected downtime of the executed Recommendation, if it is specified. Otherwise, the target is considered disrupted for the whole execution.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"approvedWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedWindow specifies the time window configuration used for the execution.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ApprovedWindow"),
+						},
+					},
+					"maintenanceWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindow holds the reference of the MaintenanceWindow or ClusterMaintenanceWindow in which the operation is created, if any.",
+							Ref:         ref("kmodules.xyz/client-go/api/v1.TypedObjectReference"),
+						},
+					},
+					"approver": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approver holds the details of the reviewer who approved the Recommendation.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.Subject"),
+						},
+					},
+					"approvedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedBy holds the identity of the authenticated user who approved the Recommendation.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.Approval"),
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime specifies when the operation is created.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime specifies when the execution is completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"result": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Result specifies the final phase of the Recommendation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason holds a message indicating details about the result.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failedAttempt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedAttempt holds the number of times the operation is failed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"recommendation", "target", "result"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.TypedLocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kmodules.xyz/client-go/api/v1.TypedObjectReference", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.Approval", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ApprovedWindow", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.Subject"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_MaintenanceFreeze(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceFreeze is the Schema for the maintenancefreezes API. While it is active, the executions of the Recommendations in its scope are held, regardless of their MaintenanceWindows and the forced executions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceFreezeSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceFreezeSpec"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_MaintenanceFreezeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceFreezeList contains a list of MaintenanceFreeze",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceFreeze"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceFreeze"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_MaintenanceFreezeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceFreezeSpec defines the time range and the scope of a MaintenanceFreeze",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start specifies the time from which the executions are held.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End specifies the time until which the executions are held.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces whose executions are held. If it is not set, the executions of all the namespaces are held.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets specifies the group and kind of the target objects whose executions are held. If it is empty, the executions of every target object are held.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"),
+									},
+								},
+							},
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason holds the reason of the freeze, e.g. the id of the change-management freeze.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindow is the Schema for the maintenancewindows API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowSpec", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindowStatus"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_MaintenanceWindowList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindowList contains a list of MaintenanceWindow",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindow"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.MaintenanceWindow"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_MaintenanceWindowSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindowSpec defines the desired state of MaintenanceWindow",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"isDefault": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"timezone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If the Timezone is not set or \"\" or \"UTC\", the given times and dates are considered as UTC. If the name is \"Local\", the given times and dates are considered as server local timezone.\n\nOtherwise, the Timezone should specify a location name corresponding to a file in the IANA Time Zone database, such as \"Asia/Dhaka\", \"America/New_York\", . Ref: https://www.iana.org/time-zones\n     https://en.wikipedia.org/wiki/List_of_tz_database_time_zones",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"days": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Days consists of a map of DayOfWeek and corresponding list of TimeWindow. There is `Logical OR` relationship between Days and Dates. Example:\n days:\n   Monday:\n    - start: 10:40AM\n      end: 7:00PM",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"array"},
+										Items: &spec.SchemaOrArray{
+											Schema: &spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Default: map[string]interface{}{},
+													Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.TimeWindow"),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"dates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dates consists of a list of Dates as Maintenance time. Dates are always needed to be given in UTC format. Format: yyyy-mm-ddThh.mm.ssZ [Here Z stands for Zero time zone / UTC time zone / GMT (+0000)] Example:\n dates:\n  - start: 2022-01-24T00:00:18Z\n    end: 2022-01-24T23:41:18Z",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.DateWindow"),
+									},
+								},
+							},
+						},
+					},
+					"distribution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Distribution specifies the managed clusters to distribute the MaintenanceWindow to, when the operator is running in the Open Cluster Management hub mode.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.Distribution"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/supervisor/apis/supervisor/v1alpha1.DateWindow", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.Distribution", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.TimeWindow"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_MaintenanceWindowStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindowStatus defines the observed state of MaintenanceWindow",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the current phase of the database",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "observedGeneration is the most recent generation observed for this resource. It corresponds to the resource's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions applied to the database, such as approval or denial.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kmodules.xyz/client-go/api/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"clusters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Clusters holds the status of the distributed MaintenanceWindow in each of the managed clusters.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ClusterStatus"),
+									},
+								},
+							},
+						},
+					},
+					"utilization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Utilization holds the utilization of the current or the last occurrence of the window.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.WindowUtilization"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kmodules.xyz/client-go/api/v1.Condition", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ClusterStatus", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.WindowUtilization"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_NodeMaintenance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeMaintenance is the operation of a Recommendation to cordon and drain the target Node. The OperationPhaseRules of the Recommendation are evaluated against the target Node once it is drained, and the Node is uncordoned once the operation is completed, i.e. succeeded, failed, stalled or deleted. Example:\n\n\toperation:\n\t  apiVersion: supervisor.appscode.com/v1alpha1\n\t  kind: NodeMaintenance\n\t  spec:\n\t    gracePeriodSeconds: 30",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.NodeMaintenanceSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/supervisor/apis/supervisor/v1alpha1.NodeMaintenanceSpec"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_NodeMaintenanceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"gracePeriodSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GracePeriodSeconds specifies the duration in seconds given to the evicted pods to terminate gracefully. The default grace period of the pods is used if it is not set.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_Notifier(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Notifier is the Schema for the notifiers API. It sends notifications for the Recommendations of its namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.NotifierSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.NotifierSpec"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_NotifierList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotifierList contains a list of Notifier",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.Notifier"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.Notifier"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_NotifierSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotifierSpec defines where and for which transitions of the Recommendations the notifications are sent",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies the backend of the Notifier.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef refers to the Secret of the same namespace holding the url or the credentials of the backend.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"email": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Email specifies the SMTP configuration of the Email Notifier.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.EmailNotifier"),
+						},
+					},
+					"jira": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Jira specifies the project and the workflow of the issues of the Jira Notifier.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.JiraNotifier"),
+						},
+					},
+					"events": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Events specifies the Event reasons of the Recommendations to notify, e.g. `AwaitingApproval`, `Approved`, `ExecutionSucceeded`, `ExecutionFailed`, and `Digest` for the periodic summary of the namespace. Every Event reason is notified if it is empty. The PagerDuty and Opsgenie Notifiers escalate the failed executions and the expired Critical Recommendations if it is empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is the Go template of the payload sent by the Slack, MSTeams and Webhook Notifiers, or of the body of the Email Notifier. The template is executed with the `.Event`, `.Text`, `.Recommendation`, `.Target`, `.TargetObject` and `.Window` fields and the sprig functions, e.g. `{\"summary\": {{ .Text | toJson }}}`. The default payload of the Notifier type is sent if it is empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interactive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interactive adds the Approve and Reject buttons to the AwaitingApproval messages of the Slack Notifier. The interactivity request url of the Slack app must point to the Slack callback server of the operator.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Paused stops sending the notifications.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.EmailNotifier", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.JiraNotifier"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_Operation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"types": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Types specifies the types (`.spec.type`) of the operations for which the ApprovalPolicy is effective, e.g. `UpdateVersion`, `HorizontalScaling`, `VerticalScaling`, `Restart`, `Reconfigure` or `ReconfigureTLS`. If it is empty, the ApprovalPolicy is effective for every type of the operation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"group", "kind"},
+			},
+		},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_OperationPhaseRules(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperationPhaseRules defines three identification rules of successful execution of the operation, progressing execution of the operation & failed execution of the operation. To specifies any field of the Operation object, the rule must start with the word `self`. Example:\n\n\t.status.phase -> self.status.phase\n\t.status.observedGeneration -> self.status.observedGeneration\n\nThe rules can be any valid expression supported by CEL(Common Expression Language). Ref: https://github.com/google/cel-spec",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"success": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Success defines a rule to identify the successful execution of the operation. Example:\n  success: `has(self.status.phase) && self.status.phase == 'Successful'`\nHere self.status.phase is pointing to .status.phase field of the Operation object. When .status.phase field presents and becomes `Successful`, the Success rule will satisfy.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"inProgress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InProgress defines a rule to identify that applied operation is progressing. Example:\n  inProgress: `has(self.status.phase) && self.status.phase == 'Progressing'`\nHere self.status.phase is pointing to .status.phase field of the Operation object. When .status.phase field presents and becomes `Progressing`, the InProgress rule will satisfy.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed defines a rule to identify that applied operation is failed. Example:\n  inProgress: `has(self.status.phase) && self.status.phase == 'Failed'`\nHere self.status.phase is pointing to .status.phase field of the Operation object. When .status.phase field presents and becomes `Failed`, the Failed rule will satisfy.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"success", "inProgress", "failed"},
+			},
+		},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_Patch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Patch is the operation of a Recommendation to apply the given patch to the target object. The OperationPhaseRules of the Recommendation are evaluated against the patched target object. Example:\n\n\toperation:\n\t  apiVersion: supervisor.appscode.com/v1alpha1\n\t  kind: Patch\n\t  spec:\n\t    type: Merge\n\t    patch: '{\"spec\":{\"replicas\":3}}'",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.PatchSpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/supervisor/apis/supervisor/v1alpha1.PatchSpec"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_PatchSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies the type of the patch.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"patch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patch holds the patch to apply. It must be a JSON document of the given patch type.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"patch"},
+			},
+		},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_PhaseCount(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PhaseCount holds the number of Recommendations in a phase.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+				},
+				Required: []string{"phase", "count"},
+			},
+		},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_PolicyException(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PolicyException specifies the target objects to exclude from an ApprovalPolicy. A target object is excluded if it matches any of the given fields.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces specifies the namespaces of the target objects to exclude.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"names": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Names specifies the names of the target objects to exclude.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector selects the target objects to exclude by their labels.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_PrometheusGate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrometheusGate specifies a PromQL query whose every sample must satisfy the threshold.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name identifies the gate in the status of the Recommendation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"query": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Query holds the PromQL query evaluated against the Prometheus server of the operator, e.g. `max(mysql_slave_lag_seconds{service=\"mysql\"})`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operator specifies how the samples of the query result are compared with the threshold.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"threshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Threshold holds the value the samples of the query result are compared with.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "query", "operator", "threshold"},
+			},
+		},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_Recommendation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Recommendation is the Schema for the recommendations API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationSpec", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationStatus"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_RecommendationGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RecommendationGroup is the Schema for the recommendationgroups API. It groups a set of Recommendations into one change unit.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationGroupSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationGroupStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationGroupSpec", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationGroupStatus"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_RecommendationGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RecommendationGroupList contains a list of RecommendationGroup",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationGroup"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.RecommendationGroup"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_RecommendationGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RecommendationGroupSpec defines the desired state of RecommendationGroup",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description specifies the reason why the Recommendations are grouped together.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary specifies the canary execution strategy for the member Recommendations. If it is specified, the canary members are executed first. Rest of the members are executed only after the canary members are verified.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.CanaryStrategy"),
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout specifies the staggered rollout strategy for the member Recommendations. If it is specified, the members are executed in waves.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.RolloutStrategy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubeops.dev/supervisor/apis/supervisor/v1alpha1.CanaryStrategy", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.RolloutStrategy"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_RecommendationGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RecommendationGroupStatus defines the observed state of RecommendationGroup",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"approvalStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the Approval Status of the RecommendationGroup. Approved or Rejected status is propagated to every member Recommendation. Member Recommendations are executed only when all of them are Approved, and none of them is executed if any of them is Rejected.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approvedWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedWindow is propagated to every member Recommendation along with the Approved status, so that all of them are executed in the same window.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ApprovedWindow"),
+						},
+					},
+					"reviewer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reviewer holds the user who changed the ApprovalStatus of the RecommendationGroup. It is recorded by the admission webhook and propagated to every member Recommendation.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.Subject"),
+						},
+					},
+					"reviewTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReviewTimestamp specifies when the ApprovalStatus of the RecommendationGroup is changed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"approvedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedBy holds the identity of the authenticated user who changed the ApprovalStatus to Approved. It is recorded by the admission webhook and propagated to every member Recommendation.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.Approval"),
+						},
+					},
+					"members": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Members holds the number of Recommendations referring to this group.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"canaryMembers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanaryMembers holds the names of the member Recommendations selected as canary.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the aggregated phase of the member Recommendations.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "observedGeneration is the most recent generation observed for this resource. It corresponds to the resource's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions applied to the RecommendationGroup.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kmodules.xyz/client-go/api/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kmodules.xyz/client-go/api/v1.Condition", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.Approval", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ApprovedWindow", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.Subject"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_RecommendationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RecommendationList contains a list of Recommendation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.Recommendation"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.Recommendation"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_RecommendationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RecommendationSpec defines the desired state of Recommendation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description specifies the reason why this recommendation is generated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vulnerabilityReport": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VulnerabilityReport specifies any kind vulnerability report like cve fixed information",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.VulnerabilityReport"),
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target specifies the APIGroup, Kind & Name of the target resource for which the recommendation is generated",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
+						},
+					},
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation holds a kubernetes object yaml which will be applied when this recommendation will be executed. It should be a valid kubernetes resource yaml containing apiVersion, kind and metadata fields. It can be any kind of kubernetes object e.g. KubeDB OpsRequest, Stash RestoreSession or a custom resource. The operation is validated using server-side dry-run before creating it. The operation may contain template variables which are resolved at execution time, e.g. `{{ .Namespace }}`, `{{ .Target.Name }}`, `{{ .CurrentVersion }}` and `{{ .RecommendedVersion }}`.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"recommendedVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecommendedVersion holds the version recommended for the target resource, if any. It is available to the operation templates as `{{ .RecommendedVersion }}`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rollbackOf": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RollbackOf refers to the failed Recommendation in the same namespace this Recommendation rolls back, if any.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"recommender": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Recommender holds the name and namespace of the component which generate this recommendation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kmodules.xyz/client-go/api/v1.ObjectReference"),
+						},
+					},
+					"deadline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The recommendation will be executed within the given Deadline. To maintain deadline, Parallelism can be compromised.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"requireExplicitApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If RequireExplicitApproval is set to `true` then the Recommendation must be Approved manually. Recommendation won't be executed without manual approval and any kind of ApprovalPolicy will be ignored.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"rules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules defines OperationPhaseRules. It contains three identification rules of successful execution of the operation, progressing execution of the operation & failed execution of the operation. Example: rules:\n  success:    `has(self.status.phase) && self.status.phase == 'Successful'`\n  inProgress: `has(self.status.phase) && self.status.phase == 'Progressing'`\n  failed:     `has(self.status.phase) && self.status.phase == 'Failed'`",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.OperationPhaseRules"),
+						},
+					},
+					"targetReadinessRule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetReadinessRule defines a rule to identify that the target is healthy enough to execute the operation. If the rule is not satisfied, the execution is held until the target becomes healthy. By default, the target must be in `Ready` phase if it has a `.status.phase` field. Example:\n  targetReadinessRule: `has(self.status.phase) && self.status.phase == 'Ready'`\nHere self.status.phase is pointing to .status.phase field of the target object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"preExecutionHook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreExecutionHook specifies the Job to run before executing the operation, e.g. taking an extra backup. The operation is created only after the Job is completed. If the Job is failed, it is counted as a failed attempt and the Job is recreated on the next attempt.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ExecutionHook"),
+						},
+					},
+					"backup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backup specifies the backup to trigger and wait for before executing the operation. It overrides the Backup of the matching ApprovalPolicy.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.BackupTrigger"),
+						},
+					},
+					"verification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verification specifies the checks to run after the operation is succeeded. The Recommendation is marked as Succeeded only if the verification is succeeded. Otherwise, it is marked as Failed with the verification output.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.Verification"),
+						},
+					},
+					"prometheusGates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrometheusGates specifies the PromQL thresholds, e.g. the replication lag below 10s, which must be satisfied before the operation is created and while it is in progress. The gates of the matching ApprovalPolicy are evaluated too. The execution is aborted if any of them is breached while the operation is in progress.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.PrometheusGate"),
+									},
+								},
+							},
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveDeadlineSeconds specifies the duration in seconds relative to the creation of the operation within which the operation must be completed. Otherwise, the Recommendation is marked as Stalled and it is not retried anymore.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"deleteStalledOperation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeleteStalledOperation specifies whether the created operation is deleted when the Recommendation is Stalled.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"propagationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PropagationPolicy specifies what happens to the running operation when the Recommendation is deleted during the execution. `Wait` keeps the Recommendation until the operation is completed and `Delete` deletes the operation along with the Recommendation. `Wait` is bounded by the ActiveDeadlineSeconds, or by an hour if it is not set. Then the operation is deleted only if DeleteStalledOperation is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"backoffLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackoffLimit specifies the number of retries before marking this recommendation failed. By default set as five(5). If BackoffLimit is zero(0), the operation will be tried to executed only once.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn specifies the list of Recommendations which must be Succeeded before this Recommendation is executed. If the namespace of a reference is omitted, the namespace of this Recommendation is used. If any of the referred Recommendations is Skipped or exceeds its BackoffLimit, this Recommendation will be Skipped too. A referred Recommendation which is not found is waited for, as it may be created later. A cycle in the dependencies is rejected, or the Recommendation is Skipped if the cycle is detected after its creation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kmodules.xyz/client-go/api/v1.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"severity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Severity specifies the urgency of the Recommendation. Possible values are `Critical`, `High`, `Medium`, `Low`. Critical is used for the Recommendations which must be executed as soon as possible, e.g. CVE driven version upgrades. Critical Recommendations are free to execute regardless of Parallelism within the MaintenanceWindow.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"impact": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Impact specifies the expected impact of executing the operation, e.g. the expected downtime. The ApprovalPolicies can require manual approval for the Recommendations of a higher impact.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.Impact"),
+						},
+					},
+					"groupRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GroupRef refers to the RecommendationGroup in the same namespace this Recommendation belongs to. All the Recommendations of a group are treated as one change unit: either all of them are Approved and executed in the same window, or none of them is executed.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"distribution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Distribution specifies the managed clusters to distribute the Recommendation to, when the operator is running in the Open Cluster Management hub mode. A distributed Recommendation is never executed in the hub cluster; it is Approved or Rejected in the hub and executed in each of the managed clusters.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.Distribution"),
+						},
+					},
+				},
+				Required: []string{"target", "operation", "recommender", "rules"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.TypedLocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension", "kmodules.xyz/client-go/api/v1.ObjectReference", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.BackupTrigger", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.Distribution", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ExecutionHook", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.Impact", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.OperationPhaseRules", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.PrometheusGate", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.Verification", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.VulnerabilityReport"},
 	}
 }
 
@@ -19139,103 +21395,530 @@ func schema_supervisor_apis_supervisor_v1alpha1_RecommendationStatus(ref common.
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RecommendationStatus defines the observed state of Recommendation",
+				Description: "RecommendationStatus defines the observed state of Recommendation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"approvalStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the Approval Status of the Recommendation. Possible values are `Pending`, `Approved`, `Rejected` Pending: Recommendation is yet to Approved or Rejected Approved: Recommendation is permitted to execute. Rejected: Recommendation is rejected and never be executed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the Recommendation current phase. Possible values are: Pending : Recommendation misses at least one pre-requisite for executing the operation.\n          It also tells that some user action is needed.\nSkipped : Operation is skipped because of Rejection ApprovalStatus. Waiting : Recommendation is waiting for the MaintenanceWindow to execute the operation\n          or waiting for others Recommendation to complete far maintaining Parallelism.\nInProgress : The operation execution is successfully started and waiting for its final status. Succeeded : Operation has been successfully executed. Failed : Operation execution has not completed successfully i.e. encountered an error Stalled : Operation execution has not completed within the ActiveDeadlineSeconds.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A message indicating details about Recommendation current phase.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reviewer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies Reviewer's details.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.Subject"),
+						},
+					},
+					"comments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies Reviewer's comment.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reviewTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contains review timestamp",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"approvedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedBy holds the identity of the authenticated user who changed the ApprovalStatus to Approved. It is recorded by the admission webhook and can't be modified by the users.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.Approval"),
+						},
+					},
+					"approvals": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approvals holds the approvals given by the distinct users. When the matched ApprovalPolicy requires multiple approvals, the Recommendation is kept Pending until the required number of approvals is gathered.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.Approval"),
+									},
+								},
+							},
+						},
+					},
+					"rejectionReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RejectionReason specifies why the Recommendation is Rejected. It is required when the ApprovalStatus is set to `Rejected`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approvedWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedWindow specifies the time window configuration for the Recommendation execution.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ApprovedWindow"),
+						},
+					},
+					"scheduledWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScheduledWindow specifies the concrete upcoming window in which the Approved Recommendation is going to be executed, while it is waiting for the MaintenanceWindow.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ScheduledWindow"),
+						},
+					},
+					"estimatedDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EstimatedDuration holds the duration of the operation estimated from the previous executions before creating the operation.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.DurationEstimate"),
+						},
+					},
+					"parallelism": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parallelism imposes some restriction to Recommendation execution. Possible values are: Namespace: Only one Recommendation can be executed at a time in a namespace. Target: Only one Recommendation for a given target can be executed at a time. TargetAndNamespace: Only one Recommendation for a given target can be executed at a time in a namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "observedGeneration is the most recent generation observed for this resource. It corresponds to the resource's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions applied to the Recommendation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kmodules.xyz/client-go/api/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"outdated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Outdated is indicating details whether the Recommendation is outdated or not. If the value is `true`, then Recommendation will not be executed. This indicates that after generating the Recommendation, the targeted resource is changed in such a way that the generated Recommendation has become outdated & can't be executed anymore.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"observedTargetGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedTargetGeneration holds the generation of the target object when the Recommendation is first observed. If the target object is changed before executing the operation, the Recommendation is marked as Outdated.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"approvedTargetSpecHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedTargetSpecHash holds the hash of the spec of the target object when the Recommendation is approved. If the spec of the target object is changed before creating the operation, the Recommendation is either skipped or sent back for approval.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"createdOperationRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreatedOperationRef holds the created operation name.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"previousVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreviousVersion holds the version of the target object when the operation is first created, if the Recommendation has a RecommendedVersion. It is the version the target is rolled back to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rollbackRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RollbackRef refers to the Recommendation created to roll back the target after the execution is failed.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"targetSnapshot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetSnapshot holds the snapshot of the spec of the target object taken before the operation is first created. It can be used to diff the target before & after the execution and as the source of truth of the rollback.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.TargetSnapshot"),
+						},
+					},
+					"failedAttempt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedAttempt holds the number of times the operation is failed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"verificationOutput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VerificationOutput holds the output of the verification of the executed operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"forcedExecution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForcedExecution holds the details of the emergency execution requested using the `supervisor.appscode.com/execute-now` annotation, which bypasses the MaintenanceWindow.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ForcedExecution"),
+						},
+					},
+					"changeRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChangeRequest holds the details of the change request created for the Recommendation in the change management system of the matched ApprovalPolicy.",
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ChangeRequest"),
+						},
+					},
+					"clusters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Clusters holds the status of the distributed Recommendation in each of the managed clusters.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ClusterStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kmodules.xyz/client-go/api/v1.Condition", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.Approval", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ApprovedWindow", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ChangeRequest", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ClusterStatus", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.DurationEstimate", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ForcedExecution", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ScheduledWindow", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.Subject", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.TargetSnapshot"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_RollingRestart(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RollingRestart is the operation of a Recommendation to restart the pods of the target Deployment, StatefulSet or DaemonSet in a rolling fashion, like `kubectl rollout restart`. The operation is succeeded when the rollout of the workload is completed. So, the OperationPhaseRules of the Recommendation are not used for this operation. Example:\n\n\toperation:\n\t  apiVersion: supervisor.appscode.com/v1alpha1\n\t  kind: RollingRestart",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"approvalStatus": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the Approval Status of the Recommendation. Possible values are `Pending`, `Approved`, `Rejected` Pending: Recommendation is yet to Approved or Rejected Approved: Recommendation is permitted to execute. Rejected: Recommendation is rejected and never be executed.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_RolloutStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutStrategy specifies how the same operation progresses gradually across the members of a RecommendationGroup or the managed clusters of a Distribution. The members are sorted by name and executed in waves. Each member is still executed within its own MaintenanceWindow.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"waveSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaveSize specifies the number of members in each wave.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable specifies the maximum number of members of a wave executing at a time. Every member of a wave can be executed at once if it is not set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"soakTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SoakTime specifies how long to wait after a wave is completed before starting the next wave.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"haltOnFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HaltOnFailure specifies whether the next waves are skipped if any member of a wave is failed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"waveSize"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_ScheduledWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScheduledWindow specifies a concrete time window",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maintenanceWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindow holds the reference of the MaintenanceWindow or ClusterMaintenanceWindow of this window, if any.",
+							Ref:         ref("kmodules.xyz/client-go/api/v1.TypedObjectReference"),
+						},
+					},
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start specifies when the window opens.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End specifies when the window is closed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"start"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kmodules.xyz/client-go/api/v1.TypedObjectReference"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_ServiceNowChangeRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceNowChangeRequest specifies the ServiceNow change request to create for the Recommendations which require approval. The Recommendations are Approved or Rejected once the change request is approved or rejected in ServiceNow.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef refers to the Secret holding the `url` of the ServiceNow instance and the `username` & `password` to authenticate with. If the namespace is not set, the Secret is looked up in the namespace of the Recommendation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies the type of the change request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"assignmentGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AssignmentGroup specifies the name or `sys_id` of the group the change request is assigned to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"category": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Category specifies the category of the change request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretReference"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_Subject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Subject contains a reference to the object or user identities a role binding applies to.  This can either hold a direct API object reference, or a value for non-objects such as user and group names.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of object being referenced. Values defined by this API group are \"User\", \"Group\", and \"ServiceAccount\". If the Authorizer does not recognized the kind value, the Authorizer should report an error.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phase": {
+					"apiGroup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the Recommendation current phase. Possible values are: Pending : Recommendation misses at least one pre-requisite for executing the operation.\n          It also tells that some user action is needed.\nSkipped : Operation is skipped because of Rejection ApprovalStatus. Waiting : Recommendation is waiting for the MaintenanceWindow to execute the operation\n          or waiting for others Recommendation to complete far maintaining Parallelism.\nInProgress : The operation execution is successfully started and waiting for its final status. Succeeded : Operation has been successfully executed. Failed : Operation execution has not completed successfully i.e. encountered an error",
+							Description: "APIGroup holds the API group of the referenced subject. Defaults to \"\" for ServiceAccount subjects. Defaults to \"rbac.authorization.k8s.io\" for User and Group subjects.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A message indicating details about Recommendation current phase.",
+							Description: "Name of the object being referenced.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reviewer": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies Reviewer's details.",
-							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.Subject"),
+							Description: "Namespace of the referenced object.  If the object kind is non-namespace, such as \"User\" or \"Group\", and this value is not empty the Authorizer should report an error.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"comments": {
+				},
+				Required: []string{"kind", "name"},
+			},
+			VendorExtensible: spec.VendorExtensible{
+				Extensions: spec.Extensions{
+					"x-kubernetes-map-type": "atomic",
+				},
+			},
+		},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_SupervisorReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SupervisorReport is the Schema for the supervisorreports API. It summarizes the Recommendations of its namespace, so that the dashboards don't need to list every Recommendation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies Reviewer's comment.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reviewTimestamp": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Contains review timestamp",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"approvedWindow": {
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.SupervisorReportSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.SupervisorReportStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.SupervisorReportSpec", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.SupervisorReportStatus"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_SupervisorReportList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SupervisorReportList contains a list of SupervisorReport",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ApprovedWindow specifies the time window configuration for the Recommendation execution.",
-							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ApprovedWindow"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"parallelism": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parallelism imposes some restriction to Recommendation execution. Possible values are: Namespace: Only one Recommendation can be executed at a time in a namespace. Target: Only one Recommendation for a given target can be executed at a time. TargetAndNamespace: Only one Recommendation for a given target can be executed at a time in a namespace.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"observedGeneration": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "observedGeneration is the most recent generation observed for this resource. It corresponds to the resource's generation, which is updated on mutation by the API Server.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"conditions": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions applied to the Recommendation.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kmodules.xyz/client-go/api/v1.Condition"),
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.SupervisorReport"),
 									},
 								},
 							},
 						},
 					},
-					"outdated": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Outdated is indicating details whether the Recommendation is outdated or not. If the value is `true`, then Recommendation will not be executed. This indicates that after generating the Recommendation, the targeted resource is changed in such a way that the generated Recommendation has become outdated & can't be executed anymore.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"createdOperationRef": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.SupervisorReport"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_SupervisorReportSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SupervisorReportSpec defines the desired state of SupervisorReport",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"failurePeriod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CreatedOperationRef holds the created operation name.",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Description: "FailurePeriod specifies how far back the failed executions are reported.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"failedAttempt": {
+					"maxItems": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FailedAttempt holds the number of times the operation is failed.",
-							Default:     0,
+							Description: "MaxItems specifies the maximum number of the upcoming executions and the recent failures reported.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
@@ -19244,56 +21927,91 @@ func schema_supervisor_apis_supervisor_v1alpha1_RecommendationStatus(ref common.
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kmodules.xyz/client-go/api/v1.Condition", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ApprovedWindow", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.Subject"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_supervisor_apis_supervisor_v1alpha1_Subject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_supervisor_apis_supervisor_v1alpha1_SupervisorReportStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Subject contains a reference to the object or user identities a role binding applies to.  This can either hold a direct API object reference, or a value for non-objects such as user and group names.",
+				Description: "SupervisorReportStatus defines the observed state of SupervisorReport",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"total": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind of object being referenced. Values defined by this API group are \"User\", \"Group\", and \"ServiceAccount\". If the Authorizer does not recognized the kind value, the Authorizer should report an error.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Total holds the number of Recommendations in the namespace.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"apiGroup": {
+					"pendingApproval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIGroup holds the API group of the referenced subject. Defaults to \"\" for ServiceAccount subjects. Defaults to \"rbac.authorization.k8s.io\" for User and Group subjects.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "PendingApproval holds the number of Recommendations waiting for approval.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"name": {
+					"phases": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the object being referenced.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Phases holds the number of Recommendations in each phase.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.PhaseCount"),
+									},
+								},
+							},
 						},
 					},
-					"namespace": {
+					"upcomingExecutions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace of the referenced object.  If the object kind is non-namespace, such as \"User\" or \"Group\", and this value is not empty the Authorizer should report an error.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "UpcomingExecutions holds the approved Recommendations scheduled for execution, sorted by the start of their windows.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.UpcomingExecution"),
+									},
+								},
+							},
+						},
+					},
+					"recentFailures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecentFailures holds the executions failed within the failure period, latest first.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ExecutionFailure"),
+									},
+								},
+							},
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime specifies when the report is updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "observedGeneration is the most recent generation observed for this resource. It corresponds to the resource's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-				},
-				Required: []string{"kind", "name"},
-			},
-			VendorExtensible: spec.VendorExtensible{
-				Extensions: spec.Extensions{
-					"x-kubernetes-map-type": "atomic",
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ExecutionFailure", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.PhaseCount", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.UpcomingExecution"},
 	}
 }
 
@@ -19330,12 +22048,61 @@ func schema_supervisor_apis_supervisor_v1alpha1_TargetRef(ref common.ReferenceCa
 							},
 						},
 					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector selects the target objects by their labels. If it is not set, the target objects of any labels are selected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"fieldSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FieldSelector selects the target objects by their fields. It supports the `=`, `==` and `!=` operators. Example: `spec.version=16.1,metadata.name!=prod-pg`",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"group", "kind"},
 			},
 		},
 		Dependencies: []string{
-			"kubeops.dev/supervisor/apis/supervisor/v1alpha1.Operation"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.Operation"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_TargetSnapshot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSnapshot specifies the snapshot of the spec of the target object of a Recommendation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timestamp specifies when the snapshot is taken.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation holds the generation of the target object when the snapshot is taken.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec holds the gzip compressed JSON of the spec of the target object.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+				},
+				Required: []string{"timestamp", "spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -19364,6 +22131,78 @@ func schema_supervisor_apis_supervisor_v1alpha1_TimeWindow(ref common.ReferenceC
 	}
 }
 
+func schema_supervisor_apis_supervisor_v1alpha1_UpcomingExecution(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UpcomingExecution holds the details of an approved Recommendation waiting for its window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"recommendation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Recommendation holds the name of the Recommendation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target specifies the APIGroup, Kind & Name of the target resource of the Recommendation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
+						},
+					},
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window specifies the window when the Recommendation is executed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubeops.dev/supervisor/apis/supervisor/v1alpha1.ScheduledWindow"),
+						},
+					},
+				},
+				Required: []string{"recommendation", "target", "window"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.TypedLocalObjectReference", "kubeops.dev/supervisor/apis/supervisor/v1alpha1.ScheduledWindow"},
+	}
+}
+
+func schema_supervisor_apis_supervisor_v1alpha1_Verification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Verification specifies either a Job or an HTTP probe to verify the executed operation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"job": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Job specifies the template of the Job to run for verification. The verification is succeeded if the Job is completed.",
+							Ref:         ref("k8s.io/api/batch/v1.JobTemplateSpec"),
+						},
+					},
+					"httpGet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTPGet specifies the HTTP request to perform for verification. The verification is succeeded if the response code is greater than or equal to 200 and less than 400. Host and a numeric port must be provided.",
+							Ref:         ref("k8s.io/api/core/v1.HTTPGetAction"),
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds specifies the timeout of the HTTP request. Defaults to 10 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/batch/v1.JobTemplateSpec", "k8s.io/api/core/v1.HTTPGetAction"},
+	}
+}
+
 func schema_supervisor_apis_supervisor_v1alpha1_Vulnerability(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19431,3 +22270,52 @@ func schema_supervisor_apis_supervisor_v1alpha1_VulnerabilityReport(ref common.R
 			"kubeops.dev/supervisor/apis/supervisor/v1alpha1.CVEReport"},
 	}
 }
+
+func schema_supervisor_apis_supervisor_v1alpha1_WindowUtilization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WindowUtilization specifies how much an occurrence of a MaintenanceWindow is used by the executions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start specifies when the occurrence opens.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End specifies when the occurrence is closed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"operations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operations is the number of the executions completed in the occurrence.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"busyMinutes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BusyMinutes is the total duration of the executions in the occurrence in minutes. The executions running in parallel are counted separately.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"queueOverflow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueueOverflow is the number of the Recommendations approved before the occurrence is closed which are still waiting for the window. While the window is open, it is the number of the Recommendations queued for the window.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// List of the operation kinds which are executed by supervisor itself.
+// These kinds are not served by the apiserver, they can only be used as the operation of a Recommendation.
+const (
+	ResourceKindNodeMaintenance = "NodeMaintenance"
+	ResourceKindRollingRestart  = "RollingRestart"
+	ResourceKindPatch           = "Patch"
+)
+
+// NodeMaintenance is the operation of a Recommendation to cordon and drain the target Node.
+// The OperationPhaseRules of the Recommendation are evaluated against the target Node once it is drained,
+// and the Node is uncordoned once the operation is completed, i.e. succeeded, failed, stalled or deleted.
+// Example:
+//
+//	operation:
+//	  apiVersion: supervisor.appscode.com/v1alpha1
+//	  kind: NodeMaintenance
+//	  spec:
+//	    gracePeriodSeconds: 30
+type NodeMaintenance struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	Spec NodeMaintenanceSpec `json:"spec,omitempty"`
+}
+
+type NodeMaintenanceSpec struct {
+	// GracePeriodSeconds specifies the duration in seconds given to the evicted pods to terminate gracefully.
+	// The default grace period of the pods is used if it is not set.
+	// +optional
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+}
+
+// RollingRestart is the operation of a Recommendation to restart the pods of the target
+// Deployment, StatefulSet or DaemonSet in a rolling fashion, like `kubectl rollout restart`.
+// The operation is succeeded when the rollout of the workload is completed.
+// So, the OperationPhaseRules of the Recommendation are not used for this operation.
+// Example:
+//
+//	operation:
+//	  apiVersion: supervisor.appscode.com/v1alpha1
+//	  kind: RollingRestart
+type RollingRestart struct {
+	metav1.TypeMeta `json:",inline"`
+}
+
+// Patch is the operation of a Recommendation to apply the given patch to the target object.
+// The OperationPhaseRules of the Recommendation are evaluated against the patched target object.
+// Example:
+//
+//	operation:
+//	  apiVersion: supervisor.appscode.com/v1alpha1
+//	  kind: Patch
+//	  spec:
+//	    type: Merge
+//	    patch: '{"spec":{"replicas":3}}'
+type Patch struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Spec PatchSpec `json:"spec"`
+}
+
+type PatchSpec struct {
+	// Type specifies the type of the patch.
+	// +optional
+	// +kubebuilder:default=Merge
+	Type PatchType `json:"type,omitempty"`
+
+	// Patch holds the patch to apply. It must be a JSON document of the given patch type.
+	Patch string `json:"patch"`
+}
+
+// +kubebuilder:validation:Enum=JSON;Merge;Strategic
+type PatchType string
+
+const (
+	JSONPatch           PatchType = "JSON"
+	MergePatch          PatchType = "Merge"
+	StrategicMergePatch PatchType = "Strategic"
+)
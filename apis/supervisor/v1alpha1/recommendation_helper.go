@@ -16,6 +16,14 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	"gomodules.xyz/pointer"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
 func (r *Recommendation) IsAwaitingOrProgressingRecommendation() bool {
 	return r.IsAwaitingRecommendation() || r.IsProgressingRecommendation()
 }
@@ -36,3 +44,158 @@ func (r *Recommendation) IsAwaitingRecommendation() bool {
 func (r *Recommendation) IsProgressingRecommendation() bool {
 	return r.Status.Phase == InProgress
 }
+
+// Level returns the precedence of the Severity. Higher level indicates more urgency.
+// Empty Severity is treated as Medium.
+func (s Severity) Level() int {
+	switch s {
+	case SeverityCritical:
+		return 4
+	case SeverityHigh:
+		return 3
+	case SeverityLow:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// IsTerminallyFailed returns true if the Recommendation is failed and its execution won't be retried anymore.
+// A Failed Recommendation is retried until its BackoffLimit is exceeded, unless the failure is final,
+// e.g. the verification of the executed operation is failed.
+func (r *Recommendation) IsTerminallyFailed() bool {
+	if r.Status.Phase == Stalled {
+		return true
+	}
+	if r.Status.Phase != Failed {
+		return false
+	}
+	switch r.Status.Reason {
+	case BackoffLimitExceeded, VerificationFailed, PrometheusGateBreached, OperationValidationFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *Recommendation) IsCritical() bool {
+	return r.Spec.Severity == SeverityCritical
+}
+
+func (r *Recommendation) IsExecuteNowRequested() bool {
+	return r.Annotations[ExecuteNowKey] == "true"
+}
+
+func (r *Recommendation) IsCircuitResetRequested() bool {
+	return r.Annotations[ResetCircuitKey] == "true"
+}
+
+// TargetIndexKey returns the key of the target of the Recommendation in the RecommendationTargetIndex.
+func (r *Recommendation) TargetIndexKey() string {
+	return TargetIndexKey(TargetGroup(r.Spec.Target.APIGroup), r.Spec.Target.Kind, r.Spec.Target.Name)
+}
+
+// TargetGroupKind returns the GroupKind of the target of the Recommendation.
+func (r *Recommendation) TargetGroupKind() schema.GroupKind {
+	return schema.GroupKind{Group: TargetGroup(r.Spec.Target.APIGroup), Kind: r.Spec.Target.Kind}
+}
+
+// TargetGroup returns the API group of a target reference. The APIGroup of the target references
+// may hold the version too, e.g. `kubedb.com/v1alpha2`, which is trimmed.
+func TargetGroup(apiGroup *string) string {
+	group := pointer.String(apiGroup)
+	if gv, err := schema.ParseGroupVersion(group); err == nil {
+		group = gv.Group
+	}
+	return group
+}
+
+// IsSameTarget returns true if both of the target references refer to the same object,
+// regardless of the version of the APIGroup.
+func IsSameTarget(a, b core.TypedLocalObjectReference) bool {
+	return TargetGroup(a.APIGroup) == TargetGroup(b.APIGroup) && a.Kind == b.Kind && a.Name == b.Name
+}
+
+// TargetIndexKey returns the key of the given target object in the RecommendationTargetIndex.
+// The version of the target is not a part of the key.
+func TargetIndexKey(group, kind, name string) string {
+	return group + "/" + kind + "/" + name
+}
+
+// ApprovedWindowIndexKey returns the key of the approved window of the Recommendation in the RecommendationWindowIndex.
+// Empty key is returned if the Recommendation isn't approved for a MaintenanceWindow or ClusterMaintenanceWindow.
+func (r *Recommendation) ApprovedWindowIndexKey() string {
+	if r.Status.ApprovedWindow == nil || r.Status.ApprovedWindow.MaintenanceWindow == nil {
+		return ""
+	}
+	ref := r.Status.ApprovedWindow.MaintenanceWindow
+	if ref.Kind == ResourceKindClusterMaintenanceWindow {
+		return WindowIndexKey(ResourceKindClusterMaintenanceWindow, "", ref.Name)
+	}
+	ns := ref.Namespace
+	if ns == "" {
+		ns = r.Namespace
+	}
+	return WindowIndexKey(ResourceKindMaintenanceWindow, ns, ref.Name)
+}
+
+// DependencyIndexKeys returns the keys of the Recommendations depended on by the Recommendation in the RecommendationDependencyIndex.
+func (r *Recommendation) DependencyIndexKeys() []string {
+	keys := make([]string, 0, len(r.Spec.DependsOn))
+	for _, ref := range r.Spec.DependsOn {
+		keys = append(keys, r.DependencyKey(ref).String())
+	}
+	return keys
+}
+
+// DependencyKey returns the key of the given dependency of the Recommendation.
+// The namespace of the Recommendation is used if the namespace of the reference is omitted.
+func (r *Recommendation) DependencyKey(ref kmapi.ObjectReference) types.NamespacedName {
+	key := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+	if key.Namespace == "" {
+		key.Namespace = r.Namespace
+	}
+	return key
+}
+
+// DependencyCycle returns the chain of the Recommendations leading from the Recommendation back to itself through
+// spec.dependsOn, e.g. [demo/a demo/b demo/a], or nil if the dependencies of the Recommendation have no such cycle.
+// The get function returns the Recommendation of the given key, or nil if it is not found.
+func (r *Recommendation) DependencyCycle(get func(key types.NamespacedName) (*Recommendation, error)) ([]string, error) {
+	self := types.NamespacedName{Namespace: r.Namespace, Name: r.Name}
+	visited := map[types.NamespacedName]bool{}
+
+	var walk func(rc *Recommendation, path []string) ([]string, error)
+	walk = func(rc *Recommendation, path []string) ([]string, error) {
+		for _, ref := range rc.Spec.DependsOn {
+			key := rc.DependencyKey(ref)
+			chain := append(path[:len(path):len(path)], key.String())
+			if key == self {
+				return chain, nil
+			}
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+
+			dep, err := get(key)
+			if err != nil {
+				return nil, err
+			}
+			if dep == nil {
+				continue
+			}
+			if cycle, err := walk(dep, chain); err != nil || cycle != nil {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+	return walk(r, []string{self.String()})
+}
+
+// WindowIndexKey returns the key of the given window in the RecommendationWindowIndex.
+// The namespace is empty for the ClusterMaintenanceWindows.
+func WindowIndexKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
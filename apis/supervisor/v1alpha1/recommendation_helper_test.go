@@ -0,0 +1,118 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
+// dependent returns a Recommendation of the given key depending on the given Recommendations.
+func dependent(namespace, name string, deps ...kmapi.ObjectReference) *Recommendation {
+	return &Recommendation{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       RecommendationSpec{DependsOn: deps},
+	}
+}
+
+func TestDependencyCycle(t *testing.T) {
+	cases := []struct {
+		name     string
+		rcmd     *Recommendation
+		existing []*Recommendation
+		want     []string
+	}{
+		{
+			name:     "no dependency",
+			rcmd:     dependent("demo", "a"),
+			existing: []*Recommendation{dependent("demo", "b", kmapi.ObjectReference{Name: "a"})},
+		},
+		{
+			name: "chain without any cycle",
+			rcmd: dependent("demo", "a", kmapi.ObjectReference{Name: "b"}),
+			existing: []*Recommendation{
+				dependent("demo", "b", kmapi.ObjectReference{Name: "c"}),
+				dependent("demo", "c"),
+			},
+		},
+		{
+			name: "missing dependency",
+			rcmd: dependent("demo", "a", kmapi.ObjectReference{Name: "b"}),
+		},
+		{
+			name:     "cycle of two Recommendations",
+			rcmd:     dependent("demo", "a", kmapi.ObjectReference{Name: "b"}),
+			existing: []*Recommendation{dependent("demo", "b", kmapi.ObjectReference{Name: "a"})},
+			want:     []string{"demo/a", "demo/b", "demo/a"},
+		},
+		{
+			name: "cycle across namespaces",
+			rcmd: dependent("demo", "a", kmapi.ObjectReference{Namespace: "other", Name: "b"}),
+			existing: []*Recommendation{
+				dependent("other", "b", kmapi.ObjectReference{Name: "c"}),
+				dependent("other", "c", kmapi.ObjectReference{Namespace: "demo", Name: "a"}),
+			},
+			want: []string{"demo/a", "other/b", "other/c", "demo/a"},
+		},
+		{
+			name:     "same name in another namespace is not a cycle",
+			rcmd:     dependent("demo", "a", kmapi.ObjectReference{Namespace: "other", Name: "b"}),
+			existing: []*Recommendation{dependent("other", "b", kmapi.ObjectReference{Name: "a"})},
+		},
+		{
+			name: "cycle not leading back to the Recommendation",
+			rcmd: dependent("demo", "a", kmapi.ObjectReference{Name: "b"}),
+			existing: []*Recommendation{
+				dependent("demo", "b", kmapi.ObjectReference{Name: "c"}),
+				dependent("demo", "c", kmapi.ObjectReference{Name: "b"}),
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := map[types.NamespacedName]*Recommendation{}
+			for _, rc := range tc.existing {
+				store[types.NamespacedName{Namespace: rc.Namespace, Name: rc.Name}] = rc
+			}
+			got, err := tc.rcmd.DependencyCycle(func(key types.NamespacedName) (*Recommendation, error) {
+				return store[key], nil
+			})
+			if err != nil {
+				t.Fatalf("DependencyCycle() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("DependencyCycle() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDependencyCycleLookupError(t *testing.T) {
+	errLookup := errors.New("lookup failed")
+	rcmd := dependent("demo", "a", kmapi.ObjectReference{Name: "b"})
+	_, err := rcmd.DependencyCycle(func(key types.NamespacedName) (*Recommendation, error) {
+		return nil, errLookup
+	})
+	if !errors.Is(err, errLookup) {
+		t.Errorf("DependencyCycle() error = %v, want %v", err, errLookup)
+	}
+}
@@ -19,7 +19,9 @@ package v1alpha1
 import (
 	"kubeops.dev/supervisor/crds"
 
+	batch "k8s.io/api/batch/v1"
 	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	kmapi "kmodules.xyz/client-go/api/v1"
@@ -46,10 +48,23 @@ type RecommendationSpec struct {
 
 	// Operation holds a kubernetes object yaml which will be applied when this recommendation will be executed.
 	// It should be a valid kubernetes resource yaml containing apiVersion, kind and metadata fields.
+	// It can be any kind of kubernetes object e.g. KubeDB OpsRequest, Stash RestoreSession or a custom resource.
+	// The operation is validated using server-side dry-run before creating it.
+	// The operation may contain template variables which are resolved at execution time, e.g.
+	// `{{ .Namespace }}`, `{{ .Target.Name }}`, `{{ .CurrentVersion }}` and `{{ .RecommendedVersion }}`.
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:EmbeddedResource
 	Operation runtime.RawExtension `json:"operation"`
 
+	// RecommendedVersion holds the version recommended for the target resource, if any.
+	// It is available to the operation templates as `{{ .RecommendedVersion }}`.
+	// +optional
+	RecommendedVersion string `json:"recommendedVersion,omitempty"`
+
+	// RollbackOf refers to the failed Recommendation in the same namespace this Recommendation rolls back, if any.
+	// +optional
+	RollbackOf *core.LocalObjectReference `json:"rollbackOf,omitempty"`
+
 	// Recommender holds the name and namespace of the component which generate this recommendation.
 	Recommender kmapi.ObjectReference `json:"recommender"`
 
@@ -72,6 +87,57 @@ type RecommendationSpec struct {
 	//   failed:     `has(self.status.phase) && self.status.phase == 'Failed'`
 	Rules OperationPhaseRules `json:"rules"`
 
+	// TargetReadinessRule defines a rule to identify that the target is healthy enough to execute the operation.
+	// If the rule is not satisfied, the execution is held until the target becomes healthy.
+	// By default, the target must be in `Ready` phase if it has a `.status.phase` field.
+	// Example:
+	//   targetReadinessRule: `has(self.status.phase) && self.status.phase == 'Ready'`
+	// Here self.status.phase is pointing to .status.phase field of the target object.
+	// +optional
+	TargetReadinessRule string `json:"targetReadinessRule,omitempty"`
+
+	// PreExecutionHook specifies the Job to run before executing the operation, e.g. taking an extra backup.
+	// The operation is created only after the Job is completed.
+	// If the Job is failed, it is counted as a failed attempt and the Job is recreated on the next attempt.
+	// +optional
+	PreExecutionHook *ExecutionHook `json:"preExecutionHook,omitempty"`
+
+	// Backup specifies the backup to trigger and wait for before executing the operation.
+	// It overrides the Backup of the matching ApprovalPolicy.
+	// +optional
+	Backup *BackupTrigger `json:"backup,omitempty"`
+
+	// Verification specifies the checks to run after the operation is succeeded.
+	// The Recommendation is marked as Succeeded only if the verification is succeeded.
+	// Otherwise, it is marked as Failed with the verification output.
+	// +optional
+	Verification *Verification `json:"verification,omitempty"`
+
+	// PrometheusGates specifies the PromQL thresholds, e.g. the replication lag below 10s, which must be satisfied
+	// before the operation is created and while it is in progress. The gates of the matching ApprovalPolicy
+	// are evaluated too. The execution is aborted if any of them is breached while the operation is in progress.
+	// +optional
+	PrometheusGates []PrometheusGate `json:"prometheusGates,omitempty"`
+
+	// ActiveDeadlineSeconds specifies the duration in seconds relative to the creation of the operation
+	// within which the operation must be completed. Otherwise, the Recommendation is marked as Stalled
+	// and it is not retried anymore.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// DeleteStalledOperation specifies whether the created operation is deleted when the Recommendation is Stalled.
+	// +optional
+	DeleteStalledOperation bool `json:"deleteStalledOperation,omitempty"`
+
+	// PropagationPolicy specifies what happens to the running operation when the Recommendation is deleted
+	// during the execution. `Wait` keeps the Recommendation until the operation is completed and `Delete`
+	// deletes the operation along with the Recommendation. `Wait` is bounded by the ActiveDeadlineSeconds,
+	// or by an hour if it is not set. Then the operation is deleted only if DeleteStalledOperation is set.
+	// +optional
+	// +kubebuilder:default=Wait
+	PropagationPolicy OperationPropagationPolicy `json:"propagationPolicy,omitempty"`
+
 	// BackoffLimit specifies the number of retries before marking this recommendation failed.
 	// By default set as five(5).
 	// If BackoffLimit is zero(0), the operation will be tried to executed only once.
@@ -79,6 +145,154 @@ type RecommendationSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=10
 	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// DependsOn specifies the list of Recommendations which must be Succeeded before this Recommendation is executed.
+	// If the namespace of a reference is omitted, the namespace of this Recommendation is used.
+	// If any of the referred Recommendations is Skipped or exceeds its BackoffLimit, this Recommendation will be Skipped too.
+	// A referred Recommendation which is not found is waited for, as it may be created later.
+	// A cycle in the dependencies is rejected, or the Recommendation is Skipped if the cycle is detected after its creation.
+	// +optional
+	DependsOn []kmapi.ObjectReference `json:"dependsOn,omitempty"`
+
+	// Severity specifies the urgency of the Recommendation.
+	// Possible values are `Critical`, `High`, `Medium`, `Low`.
+	// Critical is used for the Recommendations which must be executed as soon as possible, e.g. CVE driven version upgrades.
+	// Critical Recommendations are free to execute regardless of Parallelism within the MaintenanceWindow.
+	// +optional
+	// +kubebuilder:default=Medium
+	Severity Severity `json:"severity,omitempty"`
+
+	// Impact specifies the expected impact of executing the operation, e.g. the expected downtime.
+	// The ApprovalPolicies can require manual approval for the Recommendations of a higher impact.
+	// +optional
+	Impact *Impact `json:"impact,omitempty"`
+
+	// GroupRef refers to the RecommendationGroup in the same namespace this Recommendation belongs to.
+	// All the Recommendations of a group are treated as one change unit: either all of them are Approved
+	// and executed in the same window, or none of them is executed.
+	// +optional
+	GroupRef *core.LocalObjectReference `json:"groupRef,omitempty"`
+
+	// Distribution specifies the managed clusters to distribute the Recommendation to, when the operator
+	// is running in the Open Cluster Management hub mode. A distributed Recommendation is never executed
+	// in the hub cluster; it is Approved or Rejected in the hub and executed in each of the managed clusters.
+	// +optional
+	Distribution *Distribution `json:"distribution,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Wait;Delete
+type OperationPropagationPolicy string
+
+const (
+	OperationPropagationWait   OperationPropagationPolicy = "Wait"
+	OperationPropagationDelete OperationPropagationPolicy = "Delete"
+)
+
+// +kubebuilder:validation:Enum=Stash;KubeStash
+type BackupProvider string
+
+const (
+	BackupProviderStash     BackupProvider = "Stash"
+	BackupProviderKubeStash BackupProvider = "KubeStash"
+)
+
+// BackupTrigger specifies the BackupConfiguration to trigger a BackupSession from.
+type BackupTrigger struct {
+	// Provider specifies the backup solution. Possible values are `Stash` and `KubeStash`.
+	// +kubebuilder:default=KubeStash
+	Provider BackupProvider `json:"provider,omitempty"`
+
+	// BackupConfiguration specifies the name of the BackupConfiguration of the target
+	// in the namespace of the Recommendation.
+	BackupConfiguration string `json:"backupConfiguration"`
+
+	// Session specifies the session name of the KubeStash BackupConfiguration to trigger.
+	// +optional
+	Session string `json:"session,omitempty"`
+
+	// OperationTypes specifies the types (`.spec.type`) of the operations, e.g. `UpdateVersion` or `Reconfigure`,
+	// for which the backup is triggered. If it is empty, the backup is triggered for every operation.
+	// +optional
+	OperationTypes []string `json:"operationTypes,omitempty"`
+}
+
+// List of keys of the ServiceNow Secret
+const (
+	ServiceNowURLKey      = "url"
+	ServiceNowUsernameKey = "username"
+	ServiceNowPasswordKey = "password"
+)
+
+// ServiceNowChangeRequest specifies the ServiceNow change request to create for the Recommendations
+// which require approval. The Recommendations are Approved or Rejected once the change request is
+// approved or rejected in ServiceNow.
+type ServiceNowChangeRequest struct {
+	// SecretRef refers to the Secret holding the `url` of the ServiceNow instance and the
+	// `username` & `password` to authenticate with. If the namespace is not set,
+	// the Secret is looked up in the namespace of the Recommendation.
+	SecretRef core.SecretReference `json:"secretRef"`
+
+	// Type specifies the type of the change request.
+	// +optional
+	// +kubebuilder:default=normal
+	// +kubebuilder:validation:Enum=normal;standard;emergency
+	Type string `json:"type,omitempty"`
+
+	// AssignmentGroup specifies the name or `sys_id` of the group the change request is assigned to.
+	// +optional
+	AssignmentGroup string `json:"assignmentGroup,omitempty"`
+
+	// Category specifies the category of the change request.
+	// +optional
+	Category string `json:"category,omitempty"`
+}
+
+// ExecutionHook specifies the Job to run as a hook of the execution.
+type ExecutionHook struct {
+	// Job specifies the template of the Job to run.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Job batch.JobTemplateSpec `json:"job"`
+}
+
+// PrometheusGate specifies a PromQL query whose every sample must satisfy the threshold.
+type PrometheusGate struct {
+	// Name identifies the gate in the status of the Recommendation.
+	Name string `json:"name"`
+
+	// Query holds the PromQL query evaluated against the Prometheus server of the operator,
+	// e.g. `max(mysql_slave_lag_seconds{service="mysql"})`.
+	Query string `json:"query"`
+
+	// Operator specifies how the samples of the query result are compared with the threshold.
+	// +kubebuilder:validation:Enum="<";"<=";">";">=";"==";"!="
+	Operator string `json:"operator"`
+
+	// Threshold holds the value the samples of the query result are compared with.
+	// +kubebuilder:validation:Pattern=`^[-+]?[0-9]*\.?[0-9]+([eE][-+]?[0-9]+)?$`
+	Threshold string `json:"threshold"`
+}
+
+// Verification specifies either a Job or an HTTP probe to verify the executed operation.
+type Verification struct {
+	// Job specifies the template of the Job to run for verification.
+	// The verification is succeeded if the Job is completed.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Job *batch.JobTemplateSpec `json:"job,omitempty"`
+
+	// HTTPGet specifies the HTTP request to perform for verification.
+	// The verification is succeeded if the response code is greater than or equal to 200 and less than 400.
+	// Host and a numeric port must be provided.
+	// +optional
+	HTTPGet *core.HTTPGetAction `json:"httpGet,omitempty"`
+
+	// TimeoutSeconds specifies the timeout of the HTTP request. Defaults to 10 seconds.
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
 }
 
 type ReportGenerationStatus string
@@ -150,6 +364,21 @@ const (
 	ApprovalRejected ApprovalStatus = "Rejected"
 )
 
+// +kubebuilder:validation:Enum=Unnecessary;HighRisk;BadTiming;Duplicate;GroupRejected;PolicyDenied;Stale;ChangeRequestRejected;Other
+type RejectionReason string
+
+const (
+	RejectionReasonUnnecessary   RejectionReason = "Unnecessary"
+	RejectionReasonHighRisk      RejectionReason = "HighRisk"
+	RejectionReasonBadTiming     RejectionReason = "BadTiming"
+	RejectionReasonDuplicate     RejectionReason = "Duplicate"
+	RejectionReasonGroupRejected RejectionReason = "GroupRejected"
+	RejectionReasonPolicyDenied  RejectionReason = "PolicyDenied"
+	RejectionReasonStale         RejectionReason = "Stale"
+	RejectionReasonChangeRequest RejectionReason = "ChangeRequestRejected"
+	RejectionReasonOther         RejectionReason = "Other"
+)
+
 // Subject contains a reference to the object or user identities a role binding applies to.  This can either hold a direct API object reference,
 // or a value for non-objects such as user and group names.
 // +structType=atomic
@@ -191,6 +420,7 @@ type RecommendationStatus struct {
 	// InProgress : The operation execution is successfully started and waiting for its final status.
 	// Succeeded : Operation has been successfully executed.
 	// Failed : Operation execution has not completed successfully i.e. encountered an error
+	// Stalled : Operation execution has not completed within the ActiveDeadlineSeconds.
 	// +optional
 	Phase RecommendationPhase `json:"phase,omitempty"`
 
@@ -211,10 +441,35 @@ type RecommendationStatus struct {
 	// +optional
 	ReviewTimestamp *metav1.Time `json:"reviewTimestamp,omitempty"`
 
+	// ApprovedBy holds the identity of the authenticated user who changed the ApprovalStatus to Approved.
+	// It is recorded by the admission webhook and can't be modified by the users.
+	// +optional
+	ApprovedBy *Approval `json:"approvedBy,omitempty"`
+
+	// Approvals holds the approvals given by the distinct users. When the matched ApprovalPolicy requires
+	// multiple approvals, the Recommendation is kept Pending until the required number of approvals is gathered.
+	// +optional
+	Approvals []Approval `json:"approvals,omitempty"`
+
+	// RejectionReason specifies why the Recommendation is Rejected.
+	// It is required when the ApprovalStatus is set to `Rejected`.
+	// +optional
+	RejectionReason RejectionReason `json:"rejectionReason,omitempty"`
+
 	// ApprovedWindow specifies the time window configuration for the Recommendation execution.
 	// +optional
 	ApprovedWindow *ApprovedWindow `json:"approvedWindow,omitempty"`
 
+	// ScheduledWindow specifies the concrete upcoming window in which the Approved Recommendation
+	// is going to be executed, while it is waiting for the MaintenanceWindow.
+	// +optional
+	ScheduledWindow *ScheduledWindow `json:"scheduledWindow,omitempty"`
+
+	// EstimatedDuration holds the duration of the operation estimated from the previous executions
+	// before creating the operation.
+	// +optional
+	EstimatedDuration *DurationEstimate `json:"estimatedDuration,omitempty"`
+
 	// Parallelism imposes some restriction to Recommendation execution.
 	// Possible values are:
 	// Namespace: Only one Recommendation can be executed at a time in a namespace.
@@ -241,17 +496,168 @@ type RecommendationStatus struct {
 	// +kubebuilder:default=false
 	Outdated bool `json:"outdated"`
 
+	// ObservedTargetGeneration holds the generation of the target object when the Recommendation is first observed.
+	// If the target object is changed before executing the operation, the Recommendation is marked as Outdated.
+	// +optional
+	ObservedTargetGeneration int64 `json:"observedTargetGeneration,omitempty"`
+
+	// ApprovedTargetSpecHash holds the hash of the spec of the target object when the Recommendation is approved.
+	// If the spec of the target object is changed before creating the operation, the Recommendation is either
+	// skipped or sent back for approval.
+	// +optional
+	ApprovedTargetSpecHash string `json:"approvedTargetSpecHash,omitempty"`
+
 	// CreatedOperationRef holds the created operation name.
 	// +optional
 	CreatedOperationRef *core.LocalObjectReference `json:"createdOperationRef,omitempty"`
 
+	// PreviousVersion holds the version of the target object when the operation is first created,
+	// if the Recommendation has a RecommendedVersion. It is the version the target is rolled back to.
+	// +optional
+	PreviousVersion string `json:"previousVersion,omitempty"`
+
+	// RollbackRef refers to the Recommendation created to roll back the target after the execution is failed.
+	// +optional
+	RollbackRef *core.LocalObjectReference `json:"rollbackRef,omitempty"`
+
+	// TargetSnapshot holds the snapshot of the spec of the target object taken before the operation is first created.
+	// It can be used to diff the target before & after the execution and as the source of truth of the rollback.
+	// +optional
+	TargetSnapshot *TargetSnapshot `json:"targetSnapshot,omitempty"`
+
 	// FailedAttempt holds the number of times the operation is failed.
 	// +optional
 	// +kubebuilder:default=0
 	FailedAttempt int32 `json:"failedAttempt"`
+
+	// VerificationOutput holds the output of the verification of the executed operation.
+	// +optional
+	VerificationOutput string `json:"verificationOutput,omitempty"`
+
+	// ForcedExecution holds the details of the emergency execution requested using the
+	// `supervisor.appscode.com/execute-now` annotation, which bypasses the MaintenanceWindow.
+	// +optional
+	ForcedExecution *ForcedExecution `json:"forcedExecution,omitempty"`
+
+	// ChangeRequest holds the details of the change request created for the Recommendation
+	// in the change management system of the matched ApprovalPolicy.
+	// +optional
+	ChangeRequest *ChangeRequest `json:"changeRequest,omitempty"`
+
+	// Clusters holds the status of the distributed Recommendation in each of the managed clusters.
+	// +optional
+	Clusters []ClusterStatus `json:"clusters,omitempty"`
+}
+
+// Approval specifies who approved the Recommendation and when it is approved.
+type Approval struct {
+	// Username is the name of the user who approved the Recommendation.
+	Username string `json:"username"`
+
+	// Groups holds the groups of the user who approved the Recommendation.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+
+	// Timestamp specifies when the Recommendation is approved.
+	Timestamp metav1.Time `json:"timestamp"`
+}
+
+// TargetSnapshot specifies the snapshot of the spec of the target object of a Recommendation.
+type TargetSnapshot struct {
+	// Timestamp specifies when the snapshot is taken.
+	Timestamp metav1.Time `json:"timestamp"`
+
+	// Generation holds the generation of the target object when the snapshot is taken.
+	// +optional
+	Generation int64 `json:"generation,omitempty"`
+
+	// Spec holds the gzip compressed JSON of the spec of the target object.
+	Spec []byte `json:"spec"`
+}
+
+// ForcedExecution specifies who forced the execution of a Recommendation and when it is forced.
+type ForcedExecution struct {
+	// RequestedBy is the username who requested the execution bypassing the MaintenanceWindow.
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+
+	// Timestamp specifies when the forced execution is started.
+	Timestamp metav1.Time `json:"timestamp"`
+}
+
+// ChangeRequest specifies the change request created for a Recommendation in ServiceNow.
+type ChangeRequest struct {
+	// ID holds the `sys_id` of the change request.
+	ID string `json:"id"`
+
+	// Number holds the human readable number of the change request, e.g. `CHG0030001`.
+	// +optional
+	Number string `json:"number,omitempty"`
+
+	// Approval holds the last observed approval state of the change request,
+	// e.g. `requested`, `approved` or `rejected`.
+	// +optional
+	Approval string `json:"approval,omitempty"`
+
+	// LastSyncTime specifies when the approval state is last observed.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// Distribution specifies the Open Cluster Management ManagedClusters to distribute an object to through ManifestWorks.
+// The object is distributed to the union of the listed and the selected clusters.
+type Distribution struct {
+	// Clusters specifies the names of the ManagedClusters.
+	// +optional
+	Clusters []string `json:"clusters,omitempty"`
+
+	// ClusterSelector selects the ManagedClusters by their labels.
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+
+	// Rollout specifies the staggered rollout strategy of a distributed Recommendation.
+	// If it is specified, the Recommendation is distributed to the clusters in waves.
+	// It is ignored for the MaintenanceWindows.
+	// +optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
+}
+
+// ClusterStatus specifies the status of a distributed object in a managed cluster, as reported back by its ManifestWork.
+type ClusterStatus struct {
+	// Cluster specifies the name of the ManagedCluster.
+	Cluster string `json:"cluster"`
+
+	// Applied is true once the object is applied in the managed cluster.
+	Applied bool `json:"applied"`
+
+	// Phase holds the phase of the distributed Recommendation in the managed cluster.
+	// +optional
+	Phase RecommendationPhase `json:"phase,omitempty"`
+
+	// ApprovalStatus holds the approval status of the distributed object in the managed cluster.
+	// +optional
+	ApprovalStatus ApprovalStatus `json:"approvalStatus,omitempty"`
+
+	// Reason holds the reason of the phase of the distributed Recommendation in the managed cluster.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// CompletionTime specifies when the distributed Recommendation is first observed as completed in the managed cluster.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=Pending;Skipped;Waiting;InProgress;Succeeded;Failed
+// +kubebuilder:validation:Enum=Critical;High;Medium;Low
+type Severity string
+
+const (
+	SeverityCritical Severity = "Critical"
+	SeverityHigh     Severity = "High"
+	SeverityMedium   Severity = "Medium"
+	SeverityLow      Severity = "Low"
+)
+
+// +kubebuilder:validation:Enum=Pending;Skipped;Waiting;InProgress;Succeeded;Failed;Stalled
 type RecommendationPhase string
 
 const (
@@ -261,6 +667,7 @@ const (
 	InProgress RecommendationPhase = "InProgress"
 	Succeeded  RecommendationPhase = "Succeeded"
 	Failed     RecommendationPhase = "Failed"
+	Stalled    RecommendationPhase = "Stalled"
 )
 
 // +kubebuilder:validation:Enum=Immediate;NextAvailable;SpecificDates
@@ -311,6 +718,51 @@ type ApprovedWindow struct {
 	Dates []DateWindow `json:"dates,omitempty"`
 }
 
+// Impact specifies the expected impact of executing the operation of a Recommendation.
+type Impact struct {
+	// ExpectedDowntime specifies how long the target is expected to be unavailable during the execution.
+	// +optional
+	ExpectedDowntime *metav1.Duration `json:"expectedDowntime,omitempty"`
+
+	// DataMigrationSize specifies the size of the data migrated by the operation, e.g. `10Gi`.
+	// +optional
+	DataMigrationSize *resource.Quantity `json:"dataMigrationSize,omitempty"`
+
+	// Description holds a human readable description of the impact.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// DurationEstimate specifies the duration of an operation estimated from the latest successful executions
+// of the same kind of operation on the targets of the same kind and size.
+type DurationEstimate struct {
+	// Duration is the rolling average duration of the executions.
+	// +optional
+	Duration metav1.Duration `json:"duration,omitempty"`
+
+	// Samples is the number of the executions the duration is estimated from.
+	// +optional
+	Samples int32 `json:"samples,omitempty"`
+
+	// TargetReplicas is the number of replicas of the target, used as the size of the target.
+	// +optional
+	TargetReplicas int32 `json:"targetReplicas,omitempty"`
+}
+
+// ScheduledWindow specifies a concrete time window
+type ScheduledWindow struct {
+	// MaintenanceWindow holds the reference of the MaintenanceWindow or ClusterMaintenanceWindow of this window, if any.
+	// +optional
+	MaintenanceWindow *kmapi.TypedObjectReference `json:"maintenanceWindow,omitempty"`
+
+	// Start specifies when the window opens.
+	Start metav1.Time `json:"start"`
+
+	// End specifies when the window is closed.
+	// +optional
+	End metav1.Time `json:"end,omitempty"`
+}
+
 // +kubebuilder:validation:Enum=Namespace;Target;TargetAndNamespace
 type Parallelism string
 
@@ -323,7 +775,12 @@ const (
 //+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Approval",type="string",JSONPath=".status.approvalStatus"
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.target.name"
+// +kubebuilder:printcolumn:name="Severity",type="string",JSONPath=".spec.severity"
+// +kubebuilder:printcolumn:name="Next Window",type="string",JSONPath=".status.scheduledWindow.start"
 // +kubebuilder:printcolumn:name="Outdated",type="boolean",JSONPath=".status.outdated"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
@@ -350,6 +807,9 @@ func init() {
 	SchemeBuilder.Register(&Recommendation{}, &RecommendationList{})
 }
 
+// Hub marks the v1alpha1 Recommendation as the conversion hub.
+func (*Recommendation) Hub() {}
+
 func (_ Recommendation) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
 	return crds.MustCustomResourceDefinition(GroupVersion.WithResource(ResourceRecommendations))
 }
@@ -17,11 +17,16 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"reflect"
+	"strings"
 
 	"gomodules.xyz/pointer"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -42,6 +47,15 @@ func (r *Recommendation) Default() {
 	if r.Spec.BackoffLimit == nil {
 		r.Spec.BackoffLimit = pointer.Int32P(DefaultBackoffLimit)
 	}
+	if r.Spec.Severity == "" {
+		r.Spec.Severity = SeverityMedium
+	}
+	if r.Spec.TargetReadinessRule == "" {
+		r.Spec.TargetReadinessRule = DefaultTargetReadinessRule
+	}
+	if r.Spec.PropagationPolicy == "" {
+		r.Spec.PropagationPolicy = OperationPropagationWait
+	}
 }
 
 var _ webhook.Validator = &Recommendation{}
@@ -62,6 +76,12 @@ func (r *Recommendation) ValidateUpdate(old runtime.Object) (admission.Warnings,
 	if !reflect.DeepEqual(obj.Spec.Operation, r.Spec.Operation) || !reflect.DeepEqual(obj.Spec.Target, r.Spec.Target) {
 		return nil, errors.New("can't update operation or target field. fields are immutable")
 	}
+	if obj.Status.ApprovalStatus == ApprovalApproved && !isExecutionSpecEqual(&obj.Spec, &r.Spec) {
+		return nil, errors.New("can't update the execution fields of an approved recommendation. reject it and create a new recommendation instead")
+	}
+	if r.Status.ApprovalStatus == ApprovalRejected && obj.Status.ApprovalStatus != ApprovalRejected && r.Status.RejectionReason == "" {
+		return nil, errors.New("rejectionReason field .status.rejectionReason must be provided to reject a recommendation")
+	}
 	return nil, r.validateRecommendation()
 }
 
@@ -72,6 +92,26 @@ func (r *Recommendation) ValidateDelete() (admission.Warnings, error) {
 	return nil, nil
 }
 
+// isExecutionSpecEqual returns true if none of the fields deciding how the operation is executed differs,
+// so that the approved execution can't be swapped silently between the approval and the execution.
+// PropagationPolicy is left mutable, as it only decides the cleanup of the operation when the Recommendation is deleted.
+// BackoffLimit is left mutable too, so that it can be raised to retry a Recommendation which has exceeded it.
+func isExecutionSpecEqual(a, b *RecommendationSpec) bool {
+	return reflect.DeepEqual(a.Rules, b.Rules) &&
+		a.TargetReadinessRule == b.TargetReadinessRule &&
+		reflect.DeepEqual(a.PreExecutionHook, b.PreExecutionHook) &&
+		reflect.DeepEqual(a.Backup, b.Backup) &&
+		reflect.DeepEqual(a.Verification, b.Verification) &&
+		reflect.DeepEqual(a.PrometheusGates, b.PrometheusGates) &&
+		reflect.DeepEqual(a.ActiveDeadlineSeconds, b.ActiveDeadlineSeconds) &&
+		a.DeleteStalledOperation == b.DeleteStalledOperation &&
+		reflect.DeepEqual(a.RollbackOf, b.RollbackOf) &&
+		reflect.DeepEqual(a.DependsOn, b.DependsOn) &&
+		reflect.DeepEqual(a.GroupRef, b.GroupRef) &&
+		reflect.DeepEqual(a.Distribution, b.Distribution) &&
+		a.RecommendedVersion == b.RecommendedVersion
+}
+
 func (r *Recommendation) validateRecommendation() error {
 	klog.Info("Validating Recommendation webhook")
 	if r.Spec.BackoffLimit == nil {
@@ -80,6 +120,36 @@ func (r *Recommendation) validateRecommendation() error {
 	if len(r.Spec.Rules.Success) == 0 || len(r.Spec.Rules.InProgress) == 0 || len(r.Spec.Rules.Failed) == 0 {
 		return errors.New("success/inProgress/failed rules can't be empty")
 	}
+	if impact := r.Spec.Impact; impact != nil {
+		if impact.ExpectedDowntime != nil && impact.ExpectedDowntime.Duration < 0 {
+			return errors.New("expectedDowntime field .spec.impact.expectedDowntime must not be negative")
+		}
+		if impact.DataMigrationSize != nil && impact.DataMigrationSize.Sign() < 0 {
+			return errors.New("dataMigrationSize field .spec.impact.dataMigrationSize must not be negative")
+		}
+	}
+	for _, dep := range r.Spec.DependsOn {
+		if dep.Name == r.Name && (dep.Namespace == "" || dep.Namespace == r.Namespace) {
+			return errors.New("recommendation can't depend on itself")
+		}
+	}
+	if len(r.Spec.DependsOn) > 0 && webhookClient != nil {
+		cycle, err := r.DependencyCycle(func(key types.NamespacedName) (*Recommendation, error) {
+			dep := &Recommendation{}
+			if err := webhookClient.Get(context.TODO(), key, dep); kerr.IsNotFound(err) {
+				return nil, nil
+			} else if err != nil {
+				return nil, err
+			}
+			return dep, nil
+		})
+		if err != nil {
+			return err
+		}
+		if cycle != nil {
+			return fmt.Errorf("recommendation can't depend on itself through the dependency cycle %s", strings.Join(cycle, " -> "))
+		}
+	}
 
 	return nil
 }
@@ -0,0 +1,177 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"kubeops.dev/supervisor/crds"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kmapi "kmodules.xyz/client-go/api/v1"
+	"kmodules.xyz/client-go/apiextensions"
+)
+
+const (
+	ResourceKindRecommendationGroup = "RecommendationGroup"
+	ResourceRecommendationGroup     = "recommendationgroup"
+	ResourceRecommendationGroups    = "recommendationgroups"
+)
+
+// RecommendationGroupSpec defines the desired state of RecommendationGroup
+type RecommendationGroupSpec struct {
+	// Description specifies the reason why the Recommendations are grouped together.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Canary specifies the canary execution strategy for the member Recommendations.
+	// If it is specified, the canary members are executed first. Rest of the members are executed
+	// only after the canary members are verified.
+	// +optional
+	Canary *CanaryStrategy `json:"canary,omitempty"`
+
+	// Rollout specifies the staggered rollout strategy for the member Recommendations.
+	// If it is specified, the members are executed in waves.
+	// +optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
+}
+
+// CanaryStrategy specifies how the canary members of a RecommendationGroup are executed.
+type CanaryStrategy struct {
+	// Replicas specifies the number of member Recommendations to execute first.
+	// Members are sorted by name to select the canary members.
+	// +kubebuilder:validation:Minimum=1
+	Replicas int32 `json:"replicas"`
+
+	// VerificationPeriod specifies how long to wait after the canary members are succeeded
+	// before executing the rest of the members.
+	// +optional
+	VerificationPeriod metav1.Duration `json:"verificationPeriod,omitempty"`
+
+	// HaltOnFailure specifies whether the rest of the members are skipped if any of the canary members is failed.
+	// If it is false, the rest of the members are executed once the canary members are completed.
+	// +optional
+	HaltOnFailure bool `json:"haltOnFailure,omitempty"`
+}
+
+// RolloutStrategy specifies how the same operation progresses gradually across the members of a RecommendationGroup
+// or the managed clusters of a Distribution. The members are sorted by name and executed in waves. Each member is
+// still executed within its own MaintenanceWindow.
+type RolloutStrategy struct {
+	// WaveSize specifies the number of members in each wave.
+	// +kubebuilder:validation:Minimum=1
+	WaveSize int32 `json:"waveSize"`
+
+	// MaxUnavailable specifies the maximum number of members of a wave executing at a time.
+	// Every member of a wave can be executed at once if it is not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+
+	// SoakTime specifies how long to wait after a wave is completed before starting the next wave.
+	// +optional
+	SoakTime metav1.Duration `json:"soakTime,omitempty"`
+
+	// HaltOnFailure specifies whether the next waves are skipped if any member of a wave is failed.
+	// +optional
+	HaltOnFailure bool `json:"haltOnFailure,omitempty"`
+}
+
+// RecommendationGroupStatus defines the observed state of RecommendationGroup
+type RecommendationGroupStatus struct {
+	// Specifies the Approval Status of the RecommendationGroup.
+	// Approved or Rejected status is propagated to every member Recommendation.
+	// Member Recommendations are executed only when all of them are Approved,
+	// and none of them is executed if any of them is Rejected.
+	// +optional
+	// +kubebuilder:default=Pending
+	ApprovalStatus ApprovalStatus `json:"approvalStatus"`
+
+	// ApprovedWindow is propagated to every member Recommendation along with the Approved status,
+	// so that all of them are executed in the same window.
+	// +optional
+	ApprovedWindow *ApprovedWindow `json:"approvedWindow,omitempty"`
+
+	// Reviewer holds the user who changed the ApprovalStatus of the RecommendationGroup.
+	// It is recorded by the admission webhook and propagated to every member Recommendation.
+	// +optional
+	Reviewer *Subject `json:"reviewer,omitempty"`
+
+	// ReviewTimestamp specifies when the ApprovalStatus of the RecommendationGroup is changed.
+	// +optional
+	ReviewTimestamp *metav1.Time `json:"reviewTimestamp,omitempty"`
+
+	// ApprovedBy holds the identity of the authenticated user who changed the ApprovalStatus to Approved.
+	// It is recorded by the admission webhook and propagated to every member Recommendation.
+	// +optional
+	ApprovedBy *Approval `json:"approvedBy,omitempty"`
+
+	// Members holds the number of Recommendations referring to this group.
+	// +optional
+	Members int32 `json:"members,omitempty"`
+
+	// CanaryMembers holds the names of the member Recommendations selected as canary.
+	// +optional
+	CanaryMembers []string `json:"canaryMembers,omitempty"`
+
+	// Phase is the aggregated phase of the member Recommendations.
+	// +optional
+	Phase RecommendationPhase `json:"phase,omitempty"`
+
+	// observedGeneration is the most recent generation observed for this resource. It corresponds to the
+	// resource's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions applied to the RecommendationGroup.
+	// +optional
+	Conditions []kmapi.Condition `json:"conditions,omitempty"`
+}
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Approval",type="string",JSONPath=".status.approvalStatus"
+// +kubebuilder:printcolumn:name="Members",type="integer",JSONPath=".status.members"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// RecommendationGroup is the Schema for the recommendationgroups API.
+// It groups a set of Recommendations into one change unit.
+type RecommendationGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RecommendationGroupSpec   `json:"spec,omitempty"`
+	Status RecommendationGroupStatus `json:"status,omitempty"`
+}
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+//+kubebuilder:object:root=true
+
+// RecommendationGroupList contains a list of RecommendationGroup
+type RecommendationGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RecommendationGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RecommendationGroup{}, &RecommendationGroupList{})
+}
+
+func (_ RecommendationGroup) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(GroupVersion.WithResource(ResourceRecommendationGroups))
+}
@@ -0,0 +1,151 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"kubeops.dev/supervisor/crds"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"kmodules.xyz/client-go/apiextensions"
+)
+
+const (
+	ResourceKindSupervisorReport = "SupervisorReport"
+	ResourceSupervisorReport     = "supervisorreport"
+	ResourceSupervisorReports    = "supervisorreports"
+)
+
+// SupervisorReportSpec defines the desired state of SupervisorReport
+type SupervisorReportSpec struct {
+	// FailurePeriod specifies how far back the failed executions are reported.
+	// +optional
+	// +kubebuilder:default="24h"
+	FailurePeriod metav1.Duration `json:"failurePeriod,omitempty"`
+
+	// MaxItems specifies the maximum number of the upcoming executions and the recent failures reported.
+	// +optional
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	MaxItems int32 `json:"maxItems,omitempty"`
+}
+
+// SupervisorReportStatus defines the observed state of SupervisorReport
+type SupervisorReportStatus struct {
+	// Total holds the number of Recommendations in the namespace.
+	// +optional
+	Total int32 `json:"total,omitempty"`
+
+	// PendingApproval holds the number of Recommendations waiting for approval.
+	// +optional
+	PendingApproval int32 `json:"pendingApproval,omitempty"`
+
+	// Phases holds the number of Recommendations in each phase.
+	// +optional
+	Phases []PhaseCount `json:"phases,omitempty"`
+
+	// UpcomingExecutions holds the approved Recommendations scheduled for execution, sorted by the start of their windows.
+	// +optional
+	UpcomingExecutions []UpcomingExecution `json:"upcomingExecutions,omitempty"`
+
+	// RecentFailures holds the executions failed within the failure period, latest first.
+	// +optional
+	RecentFailures []ExecutionFailure `json:"recentFailures,omitempty"`
+
+	// LastUpdateTime specifies when the report is updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// observedGeneration is the most recent generation observed for this resource. It corresponds to the
+	// resource's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// PhaseCount holds the number of Recommendations in a phase.
+type PhaseCount struct {
+	Phase RecommendationPhase `json:"phase"`
+	Count int32               `json:"count"`
+}
+
+// UpcomingExecution holds the details of an approved Recommendation waiting for its window.
+type UpcomingExecution struct {
+	// Recommendation holds the name of the Recommendation.
+	Recommendation string `json:"recommendation"`
+
+	// Target specifies the APIGroup, Kind & Name of the target resource of the Recommendation.
+	Target core.TypedLocalObjectReference `json:"target"`
+
+	// Window specifies the window when the Recommendation is executed.
+	Window ScheduledWindow `json:"window"`
+}
+
+// ExecutionFailure holds the details of a failed execution.
+type ExecutionFailure struct {
+	// Recommendation holds the name of the executed Recommendation.
+	Recommendation string `json:"recommendation"`
+
+	// Target specifies the APIGroup, Kind & Name of the target resource of the Recommendation.
+	Target core.TypedLocalObjectReference `json:"target"`
+
+	// Result specifies the final phase of the Recommendation.
+	Result RecommendationPhase `json:"result"`
+
+	// Reason holds a message indicating details about the failure.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// CompletionTime specifies when the execution is completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Total",type="integer",JSONPath=".status.total"
+// +kubebuilder:printcolumn:name="Pending",type="integer",JSONPath=".status.pendingApproval"
+// +kubebuilder:printcolumn:name="Updated",type="date",JSONPath=".status.lastUpdateTime"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// SupervisorReport is the Schema for the supervisorreports API.
+// It summarizes the Recommendations of its namespace, so that the dashboards don't need to list every Recommendation.
+type SupervisorReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SupervisorReportSpec   `json:"spec,omitempty"`
+	Status SupervisorReportStatus `json:"status,omitempty"`
+}
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+//+kubebuilder:object:root=true
+
+// SupervisorReportList contains a list of SupervisorReport
+type SupervisorReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SupervisorReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SupervisorReport{}, &SupervisorReportList{})
+}
+
+func (_ SupervisorReport) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(GroupVersion.WithResource(ResourceSupervisorReports))
+}
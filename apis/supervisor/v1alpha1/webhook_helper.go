@@ -17,11 +17,26 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"gomodules.xyz/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-var webhookClient client.Client
+var (
+	webhookClient   client.Client
+	defaultTimezone string
+)
 
 func SetupWebhookClient(c client.Client) {
 	webhookClient = c
 }
+
+// SetDefaultTimezone sets the timezone of the MaintenanceWindows and ClusterMaintenanceWindows created without any timezone.
+func SetDefaultTimezone(tz string) {
+	defaultTimezone = tz
+}
+
+func defaultWindowTimezone(spec *MaintenanceWindowSpec) {
+	if spec.Timezone == nil && defaultTimezone != "" {
+		spec.Timezone = pointer.StringP(defaultTimezone)
+	}
+}
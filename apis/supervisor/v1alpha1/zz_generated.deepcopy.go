@@ -22,17 +22,74 @@ limitations under the License.
 package v1alpha1
 
 import (
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
-	v1 "kmodules.xyz/client-go/api/v1"
+	apiv1 "kmodules.xyz/client-go/api/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Approval) DeepCopyInto(out *Approval) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Approval.
+func (in *Approval) DeepCopy() *Approval {
+	if in == nil {
+		return nil
+	}
+	out := new(Approval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApprovalPolicy) DeepCopyInto(out *ApprovalPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.MaintenanceWindowRef = in.MaintenanceWindowRef
+	if in.Except != nil {
+		in, out := &in.Except, &out.Except
+		*out = new(PolicyException)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxImpact != nil {
+		in, out := &in.MaxImpact, &out.MaxImpact
+		*out = new(ImpactLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequiredApprovals != nil {
+		in, out := &in.RequiredApprovals, &out.RequiredApprovals
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AutoRejectAfter != nil {
+		in, out := &in.AutoRejectAfter, &out.AutoRejectAfter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxQueueDuration != nil {
+		in, out := &in.MaxQueueDuration, &out.MaxQueueDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ValidFrom != nil {
+		in, out := &in.ValidFrom, &out.ValidFrom
+		*out = (*in).DeepCopy()
+	}
+	if in.ValidUntil != nil {
+		in, out := &in.ValidUntil, &out.ValidUntil
+		*out = (*in).DeepCopy()
+	}
 	if in.Targets != nil {
 		in, out := &in.Targets, &out.Targets
 		*out = make([]TargetRef, len(*in))
@@ -40,6 +97,21 @@ func (in *ApprovalPolicy) DeepCopyInto(out *ApprovalPolicy) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceNow != nil {
+		in, out := &in.ServiceNow, &out.ServiceNow
+		*out = new(ServiceNowChangeRequest)
+		**out = **in
+	}
+	if in.PrometheusGates != nil {
+		in, out := &in.PrometheusGates, &out.PrometheusGates
+		*out = make([]PrometheusGate, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -99,7 +171,7 @@ func (in *ApprovedWindow) DeepCopyInto(out *ApprovedWindow) {
 	*out = *in
 	if in.MaintenanceWindow != nil {
 		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
-		*out = new(v1.TypedObjectReference)
+		*out = new(apiv1.TypedObjectReference)
 		**out = **in
 	}
 	if in.Dates != nil {
@@ -122,6 +194,27 @@ func (in *ApprovedWindow) DeepCopy() *ApprovedWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupTrigger) DeepCopyInto(out *BackupTrigger) {
+	*out = *in
+	if in.OperationTypes != nil {
+		in, out := &in.OperationTypes, &out.OperationTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupTrigger.
+func (in *BackupTrigger) DeepCopy() *BackupTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CVEReport) DeepCopyInto(out *CVEReport) {
 	*out = *in
@@ -151,60 +244,123 @@ func (in *CVEReport) DeepCopy() *CVEReport {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterMaintenanceWindow) DeepCopyInto(out *ClusterMaintenanceWindow) {
+func (in *CanaryStrategy) DeepCopyInto(out *CanaryStrategy) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.VerificationPeriod = in.VerificationPeriod
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMaintenanceWindow.
-func (in *ClusterMaintenanceWindow) DeepCopy() *ClusterMaintenanceWindow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStrategy.
+func (in *CanaryStrategy) DeepCopy() *CanaryStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterMaintenanceWindow)
+	out := new(CanaryStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterMaintenanceWindow) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChangeRequest) DeepCopyInto(out *ChangeRequest) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
 	}
-	return nil
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeRequest.
+func (in *ChangeRequest) DeepCopy() *ChangeRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeRequest)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterMaintenanceWindowList) DeepCopyInto(out *ClusterMaintenanceWindowList) {
+func (in *ClusterApprovalPolicy) DeepCopyInto(out *ClusterApprovalPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ClusterMaintenanceWindow, len(*in))
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	out.MaintenanceWindowRef = in.MaintenanceWindowRef
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]TargetRef, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Except != nil {
+		in, out := &in.Except, &out.Except
+		*out = new(PolicyException)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxImpact != nil {
+		in, out := &in.MaxImpact, &out.MaxImpact
+		*out = new(ImpactLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequiredApprovals != nil {
+		in, out := &in.RequiredApprovals, &out.RequiredApprovals
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AutoRejectAfter != nil {
+		in, out := &in.AutoRejectAfter, &out.AutoRejectAfter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxQueueDuration != nil {
+		in, out := &in.MaxQueueDuration, &out.MaxQueueDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ValidFrom != nil {
+		in, out := &in.ValidFrom, &out.ValidFrom
+		*out = (*in).DeepCopy()
+	}
+	if in.ValidUntil != nil {
+		in, out := &in.ValidUntil, &out.ValidUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceNow != nil {
+		in, out := &in.ServiceNow, &out.ServiceNow
+		*out = new(ServiceNowChangeRequest)
+		**out = **in
+	}
+	if in.PrometheusGates != nil {
+		in, out := &in.PrometheusGates, &out.PrometheusGates
+		*out = make([]PrometheusGate, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMaintenanceWindowList.
-func (in *ClusterMaintenanceWindowList) DeepCopy() *ClusterMaintenanceWindowList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterApprovalPolicy.
+func (in *ClusterApprovalPolicy) DeepCopy() *ClusterApprovalPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterMaintenanceWindowList)
+	out := new(ClusterApprovalPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterMaintenanceWindowList) DeepCopyObject() runtime.Object {
+func (in *ClusterApprovalPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -212,25 +368,40 @@ func (in *ClusterMaintenanceWindowList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DateWindow) DeepCopyInto(out *DateWindow) {
+func (in *ClusterApprovalPolicyList) DeepCopyInto(out *ClusterApprovalPolicyList) {
 	*out = *in
-	in.Start.DeepCopyInto(&out.Start)
-	in.End.DeepCopyInto(&out.End)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterApprovalPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DateWindow.
-func (in *DateWindow) DeepCopy() *DateWindow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterApprovalPolicyList.
+func (in *ClusterApprovalPolicyList) DeepCopy() *ClusterApprovalPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(DateWindow)
+	out := new(ClusterApprovalPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterApprovalPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+func (in *ClusterMaintenanceWindow) DeepCopyInto(out *ClusterMaintenanceWindow) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -239,18 +410,18 @@ func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
-func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMaintenanceWindow.
+func (in *ClusterMaintenanceWindow) DeepCopy() *ClusterMaintenanceWindow {
 	if in == nil {
 		return nil
 	}
-	out := new(MaintenanceWindow)
+	out := new(ClusterMaintenanceWindow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MaintenanceWindow) DeepCopyObject() runtime.Object {
+func (in *ClusterMaintenanceWindow) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -258,13 +429,13 @@ func (in *MaintenanceWindow) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MaintenanceWindowList) DeepCopyInto(out *MaintenanceWindowList) {
+func (in *ClusterMaintenanceWindowList) DeepCopyInto(out *ClusterMaintenanceWindowList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MaintenanceWindow, len(*in))
+		*out = make([]ClusterMaintenanceWindow, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -272,18 +443,18 @@ func (in *MaintenanceWindowList) DeepCopyInto(out *MaintenanceWindowList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowList.
-func (in *MaintenanceWindowList) DeepCopy() *MaintenanceWindowList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMaintenanceWindowList.
+func (in *ClusterMaintenanceWindowList) DeepCopy() *ClusterMaintenanceWindowList {
 	if in == nil {
 		return nil
 	}
-	out := new(MaintenanceWindowList)
+	out := new(ClusterMaintenanceWindowList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MaintenanceWindowList) DeepCopyObject() runtime.Object {
+func (in *ClusterMaintenanceWindowList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -291,108 +462,76 @@ func (in *MaintenanceWindowList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MaintenanceWindowSpec) DeepCopyInto(out *MaintenanceWindowSpec) {
+func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 	*out = *in
-	if in.Timezone != nil {
-		in, out := &in.Timezone, &out.Timezone
-		*out = new(string)
-		**out = **in
-	}
-	if in.Days != nil {
-		in, out := &in.Days, &out.Days
-		*out = make(map[DayOfWeek][]TimeWindow, len(*in))
-		for key, val := range *in {
-			var outVal []TimeWindow
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = make([]TimeWindow, len(*in))
-				for i := range *in {
-					(*in)[i].DeepCopyInto(&(*out)[i])
-				}
-			}
-			(*out)[key] = outVal
-		}
-	}
-	if in.Dates != nil {
-		in, out := &in.Dates, &out.Dates
-		*out = make([]DateWindow, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowSpec.
-func (in *MaintenanceWindowSpec) DeepCopy() *MaintenanceWindowSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
+func (in *ClusterStatus) DeepCopy() *ClusterStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MaintenanceWindowSpec)
+	out := new(ClusterStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MaintenanceWindowStatus) DeepCopyInto(out *MaintenanceWindowStatus) {
+func (in *DateWindow) DeepCopyInto(out *DateWindow) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowStatus.
-func (in *MaintenanceWindowStatus) DeepCopy() *MaintenanceWindowStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DateWindow.
+func (in *DateWindow) DeepCopy() *DateWindow {
 	if in == nil {
 		return nil
 	}
-	out := new(MaintenanceWindowStatus)
+	out := new(DateWindow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Operation) DeepCopyInto(out *Operation) {
+func (in *Distribution) DeepCopyInto(out *Distribution) {
 	*out = *in
-	out.GroupKind = in.GroupKind
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Operation.
-func (in *Operation) DeepCopy() *Operation {
-	if in == nil {
-		return nil
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(Operation)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OperationPhaseRules) DeepCopyInto(out *OperationPhaseRules) {
-	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationPhaseRules.
-func (in *OperationPhaseRules) DeepCopy() *OperationPhaseRules {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Distribution.
+func (in *Distribution) DeepCopy() *Distribution {
 	if in == nil {
 		return nil
 	}
-	out := new(OperationPhaseRules)
+	out := new(Distribution)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Recommendation) DeepCopyInto(out *Recommendation) {
+func (in *DowntimeBudget) DeepCopyInto(out *DowntimeBudget) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -401,18 +540,18 @@ func (in *Recommendation) DeepCopyInto(out *Recommendation) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Recommendation.
-func (in *Recommendation) DeepCopy() *Recommendation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DowntimeBudget.
+func (in *DowntimeBudget) DeepCopy() *DowntimeBudget {
 	if in == nil {
 		return nil
 	}
-	out := new(Recommendation)
+	out := new(DowntimeBudget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Recommendation) DeepCopyObject() runtime.Object {
+func (in *DowntimeBudget) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -420,13 +559,13 @@ func (in *Recommendation) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RecommendationList) DeepCopyInto(out *RecommendationList) {
+func (in *DowntimeBudgetList) DeepCopyInto(out *DowntimeBudgetList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Recommendation, len(*in))
+		*out = make([]DowntimeBudget, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -434,18 +573,18 @@ func (in *RecommendationList) DeepCopyInto(out *RecommendationList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecommendationList.
-func (in *RecommendationList) DeepCopy() *RecommendationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DowntimeBudgetList.
+func (in *DowntimeBudgetList) DeepCopy() *DowntimeBudgetList {
 	if in == nil {
 		return nil
 	}
-	out := new(RecommendationList)
+	out := new(DowntimeBudgetList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RecommendationList) DeepCopyObject() runtime.Object {
+func (in *DowntimeBudgetList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -453,30 +592,1097 @@ func (in *RecommendationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RecommendationSpec) DeepCopyInto(out *RecommendationSpec) {
+func (in *DowntimeBudgetSpec) DeepCopyInto(out *DowntimeBudgetSpec) {
 	*out = *in
-	if in.VulnerabilityReport != nil {
-		in, out := &in.VulnerabilityReport, &out.VulnerabilityReport
-		*out = new(VulnerabilityReport)
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(corev1.TypedLocalObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
-	in.Target.DeepCopyInto(&out.Target)
-	in.Operation.DeepCopyInto(&out.Operation)
-	out.Recommender = in.Recommender
-	if in.Deadline != nil {
-		in, out := &in.Deadline, &out.Deadline
-		*out = (*in).DeepCopy()
-	}
-	out.Rules = in.Rules
-	if in.BackoffLimit != nil {
-		in, out := &in.BackoffLimit, &out.BackoffLimit
-		*out = new(int32)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecommendationSpec.
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DowntimeBudgetSpec.
+func (in *DowntimeBudgetSpec) DeepCopy() *DowntimeBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DowntimeBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DowntimeBudgetStatus) DeepCopyInto(out *DowntimeBudgetStatus) {
+	*out = *in
+	if in.PeriodStart != nil {
+		in, out := &in.PeriodStart, &out.PeriodStart
+		*out = (*in).DeepCopy()
+	}
+	if in.PeriodEnd != nil {
+		in, out := &in.PeriodEnd, &out.PeriodEnd
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DowntimeBudgetStatus.
+func (in *DowntimeBudgetStatus) DeepCopy() *DowntimeBudgetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DowntimeBudgetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DurationEstimate) DeepCopyInto(out *DurationEstimate) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DurationEstimate.
+func (in *DurationEstimate) DeepCopy() *DurationEstimate {
+	if in == nil {
+		return nil
+	}
+	out := new(DurationEstimate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailNotifier) DeepCopyInto(out *EmailNotifier) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailNotifier.
+func (in *EmailNotifier) DeepCopy() *EmailNotifier {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailNotifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionFailure) DeepCopyInto(out *ExecutionFailure) {
+	*out = *in
+	in.Target.DeepCopyInto(&out.Target)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionFailure.
+func (in *ExecutionFailure) DeepCopy() *ExecutionFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionHook) DeepCopyInto(out *ExecutionHook) {
+	*out = *in
+	in.Job.DeepCopyInto(&out.Job)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionHook.
+func (in *ExecutionHook) DeepCopy() *ExecutionHook {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForcedExecution) DeepCopyInto(out *ForcedExecution) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForcedExecution.
+func (in *ForcedExecution) DeepCopy() *ForcedExecution {
+	if in == nil {
+		return nil
+	}
+	out := new(ForcedExecution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Impact) DeepCopyInto(out *Impact) {
+	*out = *in
+	if in.ExpectedDowntime != nil {
+		in, out := &in.ExpectedDowntime, &out.ExpectedDowntime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DataMigrationSize != nil {
+		in, out := &in.DataMigrationSize, &out.DataMigrationSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Impact.
+func (in *Impact) DeepCopy() *Impact {
+	if in == nil {
+		return nil
+	}
+	out := new(Impact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImpactLimit) DeepCopyInto(out *ImpactLimit) {
+	*out = *in
+	if in.Downtime != nil {
+		in, out := &in.Downtime, &out.Downtime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DataMigrationSize != nil {
+		in, out := &in.DataMigrationSize, &out.DataMigrationSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImpactLimit.
+func (in *ImpactLimit) DeepCopy() *ImpactLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ImpactLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraNotifier) DeepCopyInto(out *JiraNotifier) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraNotifier.
+func (in *JiraNotifier) DeepCopy() *JiraNotifier {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraNotifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceExecution) DeepCopyInto(out *MaintenanceExecution) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceExecution.
+func (in *MaintenanceExecution) DeepCopy() *MaintenanceExecution {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceExecution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceExecution) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceExecutionList) DeepCopyInto(out *MaintenanceExecutionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MaintenanceExecution, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceExecutionList.
+func (in *MaintenanceExecutionList) DeepCopy() *MaintenanceExecutionList {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceExecutionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceExecutionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceExecutionSpec) DeepCopyInto(out *MaintenanceExecutionSpec) {
+	*out = *in
+	out.Recommendation = in.Recommendation
+	in.Target.DeepCopyInto(&out.Target)
+	if in.OperationRef != nil {
+		in, out := &in.OperationRef, &out.OperationRef
+		*out = new(apiv1.TypedObjectReference)
+		**out = **in
+	}
+	if in.Downtime != nil {
+		in, out := &in.Downtime, &out.Downtime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ApprovedWindow != nil {
+		in, out := &in.ApprovedWindow, &out.ApprovedWindow
+		*out = new(ApprovedWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(apiv1.TypedObjectReference)
+		**out = **in
+	}
+	if in.Approver != nil {
+		in, out := &in.Approver, &out.Approver
+		*out = new(Subject)
+		**out = **in
+	}
+	if in.ApprovedBy != nil {
+		in, out := &in.ApprovedBy, &out.ApprovedBy
+		*out = new(Approval)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceExecutionSpec.
+func (in *MaintenanceExecutionSpec) DeepCopy() *MaintenanceExecutionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceExecutionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceFreeze) DeepCopyInto(out *MaintenanceFreeze) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceFreeze.
+func (in *MaintenanceFreeze) DeepCopy() *MaintenanceFreeze {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceFreeze)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceFreeze) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceFreezeList) DeepCopyInto(out *MaintenanceFreezeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MaintenanceFreeze, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceFreezeList.
+func (in *MaintenanceFreezeList) DeepCopy() *MaintenanceFreezeList {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceFreezeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceFreezeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceFreezeSpec) DeepCopyInto(out *MaintenanceFreezeSpec) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]v1.GroupKind, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceFreezeSpec.
+func (in *MaintenanceFreezeSpec) DeepCopy() *MaintenanceFreezeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceFreezeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceWindow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowList) DeepCopyInto(out *MaintenanceWindowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowList.
+func (in *MaintenanceWindowList) DeepCopy() *MaintenanceWindowList {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceWindowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowSpec) DeepCopyInto(out *MaintenanceWindowSpec) {
+	*out = *in
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make(map[DayOfWeek][]TimeWindow, len(*in))
+		for key, val := range *in {
+			var outVal []TimeWindow
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]TimeWindow, len(*in))
+				for i := range *in {
+					(*in)[i].DeepCopyInto(&(*out)[i])
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Dates != nil {
+		in, out := &in.Dates, &out.Dates
+		*out = make([]DateWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Distribution != nil {
+		in, out := &in.Distribution, &out.Distribution
+		*out = new(Distribution)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowSpec.
+func (in *MaintenanceWindowSpec) DeepCopy() *MaintenanceWindowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowStatus) DeepCopyInto(out *MaintenanceWindowStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]apiv1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Utilization != nil {
+		in, out := &in.Utilization, &out.Utilization
+		*out = new(WindowUtilization)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowStatus.
+func (in *MaintenanceWindowStatus) DeepCopy() *MaintenanceWindowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeMaintenance) DeepCopyInto(out *NodeMaintenance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMaintenance.
+func (in *NodeMaintenance) DeepCopy() *NodeMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeMaintenanceSpec) DeepCopyInto(out *NodeMaintenanceSpec) {
+	*out = *in
+	if in.GracePeriodSeconds != nil {
+		in, out := &in.GracePeriodSeconds, &out.GracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMaintenanceSpec.
+func (in *NodeMaintenanceSpec) DeepCopy() *NodeMaintenanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeMaintenanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Notifier) DeepCopyInto(out *Notifier) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notifier.
+func (in *Notifier) DeepCopy() *Notifier {
+	if in == nil {
+		return nil
+	}
+	out := new(Notifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Notifier) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifierList) DeepCopyInto(out *NotifierList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Notifier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifierList.
+func (in *NotifierList) DeepCopy() *NotifierList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifierList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotifierList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifierSpec) DeepCopyInto(out *NotifierSpec) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(EmailNotifier)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Jira != nil {
+		in, out := &in.Jira, &out.Jira
+		*out = new(JiraNotifier)
+		**out = **in
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifierSpec.
+func (in *NotifierSpec) DeepCopy() *NotifierSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifierSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Operation) DeepCopyInto(out *Operation) {
+	*out = *in
+	out.GroupKind = in.GroupKind
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Operation.
+func (in *Operation) DeepCopy() *Operation {
+	if in == nil {
+		return nil
+	}
+	out := new(Operation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationPhaseRules) DeepCopyInto(out *OperationPhaseRules) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationPhaseRules.
+func (in *OperationPhaseRules) DeepCopy() *OperationPhaseRules {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationPhaseRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Patch) DeepCopyInto(out *Patch) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.Spec = in.Spec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Patch.
+func (in *Patch) DeepCopy() *Patch {
+	if in == nil {
+		return nil
+	}
+	out := new(Patch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PatchSpec) DeepCopyInto(out *PatchSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchSpec.
+func (in *PatchSpec) DeepCopy() *PatchSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PatchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhaseCount) DeepCopyInto(out *PhaseCount) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhaseCount.
+func (in *PhaseCount) DeepCopy() *PhaseCount {
+	if in == nil {
+		return nil
+	}
+	out := new(PhaseCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyException) DeepCopyInto(out *PolicyException) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyException.
+func (in *PolicyException) DeepCopy() *PolicyException {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyException)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusGate) DeepCopyInto(out *PrometheusGate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusGate.
+func (in *PrometheusGate) DeepCopy() *PrometheusGate {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Recommendation) DeepCopyInto(out *Recommendation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Recommendation.
+func (in *Recommendation) DeepCopy() *Recommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(Recommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Recommendation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecommendationGroup) DeepCopyInto(out *RecommendationGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecommendationGroup.
+func (in *RecommendationGroup) DeepCopy() *RecommendationGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(RecommendationGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RecommendationGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecommendationGroupList) DeepCopyInto(out *RecommendationGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RecommendationGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecommendationGroupList.
+func (in *RecommendationGroupList) DeepCopy() *RecommendationGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(RecommendationGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RecommendationGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecommendationGroupSpec) DeepCopyInto(out *RecommendationGroupSpec) {
+	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryStrategy)
+		**out = **in
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecommendationGroupSpec.
+func (in *RecommendationGroupSpec) DeepCopy() *RecommendationGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RecommendationGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecommendationGroupStatus) DeepCopyInto(out *RecommendationGroupStatus) {
+	*out = *in
+	if in.ApprovedWindow != nil {
+		in, out := &in.ApprovedWindow, &out.ApprovedWindow
+		*out = new(ApprovedWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reviewer != nil {
+		in, out := &in.Reviewer, &out.Reviewer
+		*out = new(Subject)
+		**out = **in
+	}
+	if in.ReviewTimestamp != nil {
+		in, out := &in.ReviewTimestamp, &out.ReviewTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.ApprovedBy != nil {
+		in, out := &in.ApprovedBy, &out.ApprovedBy
+		*out = new(Approval)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CanaryMembers != nil {
+		in, out := &in.CanaryMembers, &out.CanaryMembers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]apiv1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecommendationGroupStatus.
+func (in *RecommendationGroupStatus) DeepCopy() *RecommendationGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RecommendationGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecommendationList) DeepCopyInto(out *RecommendationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Recommendation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecommendationList.
+func (in *RecommendationList) DeepCopy() *RecommendationList {
+	if in == nil {
+		return nil
+	}
+	out := new(RecommendationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RecommendationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecommendationSpec) DeepCopyInto(out *RecommendationSpec) {
+	*out = *in
+	if in.VulnerabilityReport != nil {
+		in, out := &in.VulnerabilityReport, &out.VulnerabilityReport
+		*out = new(VulnerabilityReport)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Target.DeepCopyInto(&out.Target)
+	in.Operation.DeepCopyInto(&out.Operation)
+	if in.RollbackOf != nil {
+		in, out := &in.RollbackOf, &out.RollbackOf
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	out.Recommender = in.Recommender
+	if in.Deadline != nil {
+		in, out := &in.Deadline, &out.Deadline
+		*out = (*in).DeepCopy()
+	}
+	out.Rules = in.Rules
+	if in.PreExecutionHook != nil {
+		in, out := &in.PreExecutionHook, &out.PreExecutionHook
+		*out = new(ExecutionHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(Verification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusGates != nil {
+		in, out := &in.PrometheusGates, &out.PrometheusGates
+		*out = make([]PrometheusGate, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]apiv1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Impact != nil {
+		in, out := &in.Impact, &out.Impact
+		*out = new(Impact)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Distribution != nil {
+		in, out := &in.Distribution, &out.Distribution
+		*out = new(Distribution)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecommendationSpec.
 func (in *RecommendationSpec) DeepCopy() *RecommendationSpec {
 	if in == nil {
 		return nil
@@ -498,14 +1704,36 @@ func (in *RecommendationStatus) DeepCopyInto(out *RecommendationStatus) {
 		in, out := &in.ReviewTimestamp, &out.ReviewTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.ApprovedBy != nil {
+		in, out := &in.ApprovedBy, &out.ApprovedBy
+		*out = new(Approval)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]Approval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ApprovedWindow != nil {
 		in, out := &in.ApprovedWindow, &out.ApprovedWindow
 		*out = new(ApprovedWindow)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ScheduledWindow != nil {
+		in, out := &in.ScheduledWindow, &out.ScheduledWindow
+		*out = new(ScheduledWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EstimatedDuration != nil {
+		in, out := &in.EstimatedDuration, &out.EstimatedDuration
+		*out = new(DurationEstimate)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]apiv1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -515,6 +1743,33 @@ func (in *RecommendationStatus) DeepCopyInto(out *RecommendationStatus) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.RollbackRef != nil {
+		in, out := &in.RollbackRef, &out.RollbackRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.TargetSnapshot != nil {
+		in, out := &in.TargetSnapshot, &out.TargetSnapshot
+		*out = new(TargetSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForcedExecution != nil {
+		in, out := &in.ForcedExecution, &out.ForcedExecution
+		*out = new(ForcedExecution)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChangeRequest != nil {
+		in, out := &in.ChangeRequest, &out.ChangeRequest
+		*out = new(ChangeRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -528,6 +1783,85 @@ func (in *RecommendationStatus) DeepCopy() *RecommendationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingRestart) DeepCopyInto(out *RollingRestart) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingRestart.
+func (in *RollingRestart) DeepCopy() *RollingRestart {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingRestart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int32)
+		**out = **in
+	}
+	out.SoakTime = in.SoakTime
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategy.
+func (in *RolloutStrategy) DeepCopy() *RolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledWindow) DeepCopyInto(out *ScheduledWindow) {
+	*out = *in
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(apiv1.TypedObjectReference)
+		**out = **in
+	}
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledWindow.
+func (in *ScheduledWindow) DeepCopy() *ScheduledWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceNowChangeRequest) DeepCopyInto(out *ServiceNowChangeRequest) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNowChangeRequest.
+func (in *ServiceNowChangeRequest) DeepCopy() *ServiceNowChangeRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceNowChangeRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subject) DeepCopyInto(out *Subject) {
 	*out = *in
@@ -544,6 +1878,123 @@ func (in *Subject) DeepCopy() *Subject {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupervisorReport) DeepCopyInto(out *SupervisorReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupervisorReport.
+func (in *SupervisorReport) DeepCopy() *SupervisorReport {
+	if in == nil {
+		return nil
+	}
+	out := new(SupervisorReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SupervisorReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupervisorReportList) DeepCopyInto(out *SupervisorReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SupervisorReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupervisorReportList.
+func (in *SupervisorReportList) DeepCopy() *SupervisorReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(SupervisorReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SupervisorReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupervisorReportSpec) DeepCopyInto(out *SupervisorReportSpec) {
+	*out = *in
+	out.FailurePeriod = in.FailurePeriod
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupervisorReportSpec.
+func (in *SupervisorReportSpec) DeepCopy() *SupervisorReportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SupervisorReportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupervisorReportStatus) DeepCopyInto(out *SupervisorReportStatus) {
+	*out = *in
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]PhaseCount, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpcomingExecutions != nil {
+		in, out := &in.UpcomingExecutions, &out.UpcomingExecutions
+		*out = make([]UpcomingExecution, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RecentFailures != nil {
+		in, out := &in.RecentFailures, &out.RecentFailures
+		*out = make([]ExecutionFailure, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupervisorReportStatus.
+func (in *SupervisorReportStatus) DeepCopy() *SupervisorReportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SupervisorReportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetRef) DeepCopyInto(out *TargetRef) {
 	*out = *in
@@ -551,7 +2002,14 @@ func (in *TargetRef) DeepCopyInto(out *TargetRef) {
 	if in.Operations != nil {
 		in, out := &in.Operations, &out.Operations
 		*out = make([]Operation, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -566,6 +2024,28 @@ func (in *TargetRef) DeepCopy() *TargetRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSnapshot) DeepCopyInto(out *TargetSnapshot) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSnapshot.
+func (in *TargetSnapshot) DeepCopy() *TargetSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeWindow) DeepCopyInto(out *TimeWindow) {
 	*out = *in
@@ -584,6 +2064,50 @@ func (in *TimeWindow) DeepCopy() *TimeWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpcomingExecution) DeepCopyInto(out *UpcomingExecution) {
+	*out = *in
+	in.Target.DeepCopyInto(&out.Target)
+	in.Window.DeepCopyInto(&out.Window)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpcomingExecution.
+func (in *UpcomingExecution) DeepCopy() *UpcomingExecution {
+	if in == nil {
+		return nil
+	}
+	out := new(UpcomingExecution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Verification) DeepCopyInto(out *Verification) {
+	*out = *in
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(batchv1.JobTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPGet != nil {
+		in, out := &in.HTTPGet, &out.HTTPGet
+		*out = new(corev1.HTTPGetAction)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Verification.
+func (in *Verification) DeepCopy() *Verification {
+	if in == nil {
+		return nil
+	}
+	out := new(Verification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Vulnerability) DeepCopyInto(out *Vulnerability) {
 	*out = *in
@@ -625,3 +2149,21 @@ func (in *VulnerabilityReport) DeepCopy() *VulnerabilityReport {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WindowUtilization) DeepCopyInto(out *WindowUtilization) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WindowUtilization.
+func (in *WindowUtilization) DeepCopy() *WindowUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(WindowUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
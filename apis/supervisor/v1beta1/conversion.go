@@ -0,0 +1,86 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"gomodules.xyz/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+var (
+	_ conversion.Convertible = &MaintenanceWindow{}
+	_ conversion.Convertible = &Recommendation{}
+)
+
+// ConvertTo converts the MaintenanceWindow to the v1alpha1 hub version.
+// The UTC timezone is kept explicit, as the unset timezone of v1alpha1 is defaulted by the webhook
+// to the default timezone of the operator, which may not be UTC.
+func (src *MaintenanceWindow) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.MaintenanceWindow)
+	in := src.DeepCopy()
+	dst.ObjectMeta = in.ObjectMeta
+	dst.Spec = v1alpha1.MaintenanceWindowSpec{
+		IsDefault:    in.Spec.IsDefault,
+		Days:         in.Spec.Days,
+		Dates:        in.Spec.Dates,
+		Distribution: in.Spec.Distribution,
+	}
+	if in.Spec.Timezone != "" {
+		dst.Spec.Timezone = pointer.StringP(in.Spec.Timezone)
+	}
+	dst.Status = in.Status
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub version to the MaintenanceWindow.
+func (dst *MaintenanceWindow) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.MaintenanceWindow).DeepCopy()
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = MaintenanceWindowSpec{
+		IsDefault:    src.Spec.IsDefault,
+		Timezone:     "UTC",
+		Days:         src.Spec.Days,
+		Dates:        src.Spec.Dates,
+		Distribution: src.Spec.Distribution,
+	}
+	if tz := pointer.String(src.Spec.Timezone); tz != "" {
+		dst.Spec.Timezone = tz
+	}
+	dst.Status = src.Status
+	return nil
+}
+
+// ConvertTo converts the Recommendation to the v1alpha1 hub version.
+func (src *Recommendation) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.Recommendation)
+	in := src.DeepCopy()
+	dst.ObjectMeta = in.ObjectMeta
+	dst.Spec = in.Spec
+	dst.Status = in.Status
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub version to the Recommendation.
+func (dst *Recommendation) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.Recommendation).DeepCopy()
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = src.Spec
+	dst.Status = src.Status
+	return nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaintenanceWindowSpec defines the desired state of MaintenanceWindow
+type MaintenanceWindowSpec struct {
+	// +optional
+	IsDefault bool `json:"isDefault,omitempty"`
+	// Timezone specifies the timezone of the given times and dates.
+	// If the name is "UTC", the given times and dates are considered as UTC.
+	// If the name is "Local", the given times and dates are considered as server local timezone.
+	//
+	// Otherwise, the Timezone should specify a location name corresponding to a file
+	// in the IANA Time Zone database, such as "Asia/Dhaka", "America/New_York", .
+	// Ref: https://www.iana.org/time-zones
+	//      https://en.wikipedia.org/wiki/List_of_tz_database_time_zones
+	// +optional
+	// +kubebuilder:default=UTC
+	Timezone string `json:"timezone,omitempty"`
+	// Days consists of a map of DayOfWeek and corresponding list of TimeWindow.
+	// There is `Logical OR` relationship between Days and Dates.
+	// Example:
+	//  days:
+	//    Monday:
+	//     - start: 10:40AM
+	//       end: 7:00PM
+	// +optional
+	Days map[v1alpha1.DayOfWeek][]v1alpha1.TimeWindow `json:"days,omitempty"`
+	// Dates consists of a list of Dates as Maintenance time.
+	// Dates are always needed to be given in UTC format.
+	// Format: yyyy-mm-ddThh.mm.ssZ [Here Z stands for Zero time zone / UTC time zone / GMT (+0000)]
+	// Example:
+	//  dates:
+	//   - start: 2022-01-24T00:00:18Z
+	//     end: 2022-01-24T23:41:18Z
+	// +optional
+	Dates []v1alpha1.DateWindow `json:"dates,omitempty"`
+
+	// Distribution specifies the managed clusters to distribute the MaintenanceWindow to, when the operator
+	// is running in the Open Cluster Management hub mode.
+	// +optional
+	Distribution *v1alpha1.Distribution `json:"distribution,omitempty"`
+}
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Default",type="boolean",JSONPath=".spec.isDefault"
+// +kubebuilder:printcolumn:name="Timezone",type="string",JSONPath=".spec.timezone"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// MaintenanceWindow is the Schema for the maintenancewindows API
+type MaintenanceWindow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MaintenanceWindowSpec            `json:"spec,omitempty"`
+	Status v1alpha1.MaintenanceWindowStatus `json:"status,omitempty"`
+}
+
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+//+kubebuilder:object:root=true
+
+// MaintenanceWindowList contains a list of MaintenanceWindow
+type MaintenanceWindowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MaintenanceWindow `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MaintenanceWindow{}, &MaintenanceWindowList{})
+}
@@ -0,0 +1,248 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admissionpolicy
+
+import (
+	"fmt"
+	"strings"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/webhooks"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	admissionregistration "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	RecommendationPolicyName    = "supervisor-recommendation"
+	ApprovalPolicyName          = "supervisor-recommendation-approval"
+	TwoPersonRulePolicyName     = "supervisor-two-person-rule"
+	MaintenanceWindowPolicyName = "supervisor-maintenance-window"
+)
+
+// executionFields are the spec fields of a Recommendation which can't be changed once it is Approved.
+// backoffLimit is left out, so that it can be raised to retry a Recommendation which has exceeded it.
+var executionFields = []string{
+	"rules",
+	"targetReadinessRule",
+	"preExecutionHook",
+	"backup",
+	"verification",
+	"prometheusGates",
+	"activeDeadlineSeconds",
+	"deleteStalledOperation",
+	"rollbackOf",
+	"dependsOn",
+	"groupRef",
+	"distribution",
+	"recommendedVersion",
+}
+
+// Generate returns the ValidatingAdmissionPolicies and their bindings enforcing the validations of the
+// Recommendation and MaintenanceWindow webhooks with CEL, for the clusters preferring webhook-less admission
+// (Kubernetes 1.28+). The two-person rule is enforced in the namespaces matching the twoPersonRuleSelector,
+// if it is given. The validations needing API lookups, i.e. the uniqueness of the default MaintenanceWindows
+// and the windows in use by the Recommendations, can't be expressed in CEL and are only enforced by the webhooks.
+func Generate(actions []admissionregistration.ValidationAction, twoPersonRuleSelector *metav1.LabelSelector) []runtime.Object {
+	objs := []runtime.Object{
+		recommendationPolicy(),
+		newBinding(RecommendationPolicyName, actions, nil),
+		approvalPolicy(),
+		newBinding(ApprovalPolicyName, actions, nil),
+		maintenanceWindowPolicy(),
+		newBinding(MaintenanceWindowPolicyName, actions, nil),
+	}
+	if twoPersonRuleSelector != nil {
+		objs = append(objs, twoPersonRulePolicy(), newBinding(TwoPersonRulePolicyName, actions, twoPersonRuleSelector))
+	}
+	return objs
+}
+
+func recommendationPolicy() *admissionregistration.ValidatingAdmissionPolicy {
+	unchanged := make([]string, 0, len(executionFields))
+	for _, f := range executionFields {
+		unchanged = append(unchanged, fmt.Sprintf("(has(object.spec.%[1]s) == has(oldObject.spec.%[1]s) && (!has(object.spec.%[1]s) || object.spec.%[1]s == oldObject.spec.%[1]s))", f))
+	}
+
+	return newPolicy(RecommendationPolicyName, []string{api.ResourceRecommendations}, nil, recommendationVariables(), []admissionregistration.Validation{
+		{
+			Expression: "has(object.spec.backoffLimit)",
+			Message:    "backoffLimit field .spec.backoffLimit must not be nil",
+		},
+		{
+			Expression: "object.spec.rules.success != '' && object.spec.rules.inProgress != '' && object.spec.rules.failed != ''",
+			Message:    "success/inProgress/failed rules can't be empty",
+		},
+		// Longer dependency cycles need the referred Recommendations, so they are detected by the controller instead.
+		{
+			Expression: "!has(object.spec.dependsOn) || object.spec.dependsOn.all(d, d.name != object.metadata.name || (has(d.namespace) && d.namespace != '' && d.namespace != object.metadata.namespace))",
+			Message:    "recommendation can't depend on itself",
+		},
+		{
+			Expression: "oldObject == null || (object.spec.operation == oldObject.spec.operation && object.spec.target == oldObject.spec.target)",
+			Message:    "can't update operation or target field. fields are immutable",
+		},
+		{
+			Expression: "variables.oldStatus != 'Approved' || (" + strings.Join(unchanged, " && ") + ")",
+			Message:    "can't update the execution fields of an approved recommendation. reject it and create a new recommendation instead",
+		},
+		{
+			Expression: fmt.Sprintf("!has(object.metadata.annotations) || !('%[1]s' in object.metadata.annotations) || object.metadata.annotations['%[1]s'] != 'true' || "+
+				"(oldObject != null && has(oldObject.metadata.annotations) && '%[1]s' in oldObject.metadata.annotations && oldObject.metadata.annotations['%[1]s'] == 'true') || "+
+				"authorizer.group('%[2]s').resource('%[3]s').namespace(object.metadata.namespace).name(object.metadata.name).check('%[4]s').allowed()",
+				api.ExecuteNowKey, api.GroupVersion.Group, api.ResourceRecommendations, webhooks.ExecuteNowVerb),
+			MessageExpression: fmt.Sprintf("'user ' + request.userInfo.username + ' is not allowed to %s recommendations in namespace ' + object.metadata.namespace", webhooks.ExecuteNowVerb),
+			Reason:            reasonPtr(metav1.StatusReasonForbidden),
+		},
+	})
+}
+
+func approvalPolicy() *admissionregistration.ValidatingAdmissionPolicy {
+	return newPolicy(ApprovalPolicyName, []string{api.ResourceRecommendations, api.ResourceRecommendations + "/status"}, approvalChanged(), recommendationVariables(), []admissionregistration.Validation{
+		{
+			Expression: "variables.newStatus != 'Rejected' || variables.oldStatus == 'Rejected' || (has(object.status.rejectionReason) && object.status.rejectionReason != '')",
+			Message:    "rejectionReason field .status.rejectionReason must be provided to reject a recommendation",
+		},
+		{
+			Expression: fmt.Sprintf("authorizer.group('%s').resource('%s').namespace(object.metadata.namespace).name(object.metadata.name).check('%s').allowed()",
+				api.GroupVersion.Group, api.ResourceRecommendations, webhooks.ApproveVerb),
+			MessageExpression: fmt.Sprintf("'user ' + request.userInfo.username + ' is not allowed to %s recommendations in namespace ' + object.metadata.namespace", webhooks.ApproveVerb),
+			Reason:            reasonPtr(metav1.StatusReasonForbidden),
+		},
+	})
+}
+
+// twoPersonRulePolicy forbids the creator of a Recommendation to approve it. Without the mutating webhook recording
+// the creator, the creator has to set the created-by annotation to their own username on creation, and it can't be
+// changed afterwards. So the creator can't escape the rule by omitting or forging the annotation.
+func twoPersonRulePolicy() *admissionregistration.ValidatingAdmissionPolicy {
+	return newPolicy(TwoPersonRulePolicyName, []string{api.ResourceRecommendations, api.ResourceRecommendations + "/status"}, nil, recommendationVariables(), []admissionregistration.Validation{
+		{
+			Expression:        "request.operation != 'CREATE' || variables.creator == request.userInfo.username",
+			MessageExpression: fmt.Sprintf("'annotation %s must be set to the username of the creator ' + request.userInfo.username", api.CreatedByKey),
+		},
+		{
+			Expression: "oldObject == null || variables.creator == variables.oldCreator",
+			Message:    fmt.Sprintf("annotation %s is immutable", api.CreatedByKey),
+		},
+		{
+			Expression:        "variables.newStatus != 'Approved' || variables.oldStatus == 'Approved' || variables.creator != request.userInfo.username",
+			MessageExpression: "'user ' + request.userInfo.username + ' is not allowed to approve the Recommendation created by themselves in namespace ' + object.metadata.namespace",
+			Reason:            reasonPtr(metav1.StatusReasonForbidden),
+		},
+	})
+}
+
+// maintenanceWindowPolicy validates the timezone of the MaintenanceWindows and ClusterMaintenanceWindows.
+// An unknown timezone fails the evaluation of the timestamp in that timezone, which rejects the request.
+func maintenanceWindowPolicy() *admissionregistration.ValidatingAdmissionPolicy {
+	return newPolicy(MaintenanceWindowPolicyName, []string{api.ResourceMaintenanceWindows, api.ResourceClusterMaintenanceWindows}, nil, nil, []admissionregistration.Validation{
+		{
+			Expression: "!has(object.spec.timezone) || object.spec.timezone in ['', 'UTC', 'Local'] || " +
+				"(!object.spec.timezone.matches('^[+-]') && timestamp('2000-01-01T00:00:00Z').getHours(object.spec.timezone) >= 0)",
+			MessageExpression: "'unknown time zone ' + object.spec.timezone",
+		},
+	})
+}
+
+// approvalChanged matches the requests changing the ApprovalStatus of the Recommendations.
+func approvalChanged() []admissionregistration.MatchCondition {
+	return []admissionregistration.MatchCondition{
+		{
+			Name:       "approval-status-changed",
+			Expression: "has(object.status) && has(object.status.approvalStatus) && object.status.approvalStatus != '' && object.status.approvalStatus != (oldObject != null && has(oldObject.status) && has(oldObject.status.approvalStatus) && oldObject.status.approvalStatus != '' ? oldObject.status.approvalStatus : 'Pending')",
+		},
+	}
+}
+
+// recommendationVariables are the variables of the Recommendation policies holding the old and new ApprovalStatus,
+// and the old and new creator recorded in the annotations.
+func recommendationVariables() []admissionregistration.Variable {
+	return []admissionregistration.Variable{
+		{
+			Name:       "oldStatus",
+			Expression: "oldObject != null && has(oldObject.status) && has(oldObject.status.approvalStatus) && oldObject.status.approvalStatus != '' ? oldObject.status.approvalStatus : 'Pending'",
+		},
+		{
+			Name:       "newStatus",
+			Expression: "has(object.status) && has(object.status.approvalStatus) ? object.status.approvalStatus : ''",
+		},
+		{
+			Name:       "creator",
+			Expression: fmt.Sprintf("has(object.metadata.annotations) && '%[1]s' in object.metadata.annotations ? object.metadata.annotations['%[1]s'] : ''", api.CreatedByKey),
+		},
+		{
+			Name:       "oldCreator",
+			Expression: fmt.Sprintf("oldObject != null && has(oldObject.metadata.annotations) && '%[1]s' in oldObject.metadata.annotations ? oldObject.metadata.annotations['%[1]s'] : ''", api.CreatedByKey),
+		},
+	}
+}
+
+func newPolicy(name string, resources []string, conditions []admissionregistration.MatchCondition, variables []admissionregistration.Variable, validations []admissionregistration.Validation) *admissionregistration.ValidatingAdmissionPolicy {
+	failurePolicy := admissionregistration.Fail
+	return &admissionregistration.ValidatingAdmissionPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionregistration.SchemeGroupVersion.String(),
+			Kind:       "ValidatingAdmissionPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: admissionregistration.ValidatingAdmissionPolicySpec{
+			FailurePolicy: &failurePolicy,
+			MatchConstraints: &admissionregistration.MatchResources{
+				ResourceRules: []admissionregistration.NamedRuleWithOperations{
+					{
+						RuleWithOperations: admissionregistrationv1.RuleWithOperations{
+							Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+							Rule: admissionregistrationv1.Rule{
+								APIGroups:   []string{api.GroupVersion.Group},
+								APIVersions: []string{api.GroupVersion.Version},
+								Resources:   resources,
+							},
+						},
+					},
+				},
+			},
+			MatchConditions: conditions,
+			Variables:       variables,
+			Validations:     validations,
+		},
+	}
+}
+
+func newBinding(policyName string, actions []admissionregistration.ValidationAction, namespaceSelector *metav1.LabelSelector) *admissionregistration.ValidatingAdmissionPolicyBinding {
+	b := &admissionregistration.ValidatingAdmissionPolicyBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionregistration.SchemeGroupVersion.String(),
+			Kind:       "ValidatingAdmissionPolicyBinding",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: policyName},
+		Spec: admissionregistration.ValidatingAdmissionPolicyBindingSpec{
+			PolicyName:        policyName,
+			ValidationActions: actions,
+		},
+	}
+	if namespaceSelector != nil {
+		b.Spec.MatchResources = &admissionregistration.MatchResources{NamespaceSelector: namespaceSelector}
+	}
+	return b
+}
+
+func reasonPtr(r metav1.StatusReason) *metav1.StatusReason {
+	return &r
+}
@@ -0,0 +1,192 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package argocd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/digest"
+	"kubeops.dev/supervisor/pkg/target"
+
+	"github.com/jonboulle/clockwork"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// trackingIDKey is the annotation set by ArgoCD on the managed objects when the annotation based tracking is used.
+	trackingIDKey = "argocd.argoproj.io/tracking-id"
+	// instanceKey is the label set by ArgoCD on the managed objects when the label based tracking is used.
+	instanceKey = "app.kubernetes.io/instance"
+)
+
+var (
+	applicationGVK = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Application"}
+	appProjectGVK  = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "AppProject"}
+)
+
+// SyncWindowChecker checks the sync windows of the ArgoCD Application managing the target of a Recommendation.
+type SyncWindowChecker struct {
+	ctx       context.Context
+	kc        client.Client
+	rcmd      *api.Recommendation
+	namespace string
+	clock     clockwork.Clock
+}
+
+// NewSyncWindowChecker returns the SyncWindowChecker looking up the ArgoCD Applications in the given namespace.
+func NewSyncWindowChecker(ctx context.Context, kc client.Client, rcmd *api.Recommendation, namespace string, clock clockwork.Clock) *SyncWindowChecker {
+	return &SyncWindowChecker{
+		ctx:       ctx,
+		kc:        kc,
+		rcmd:      rcmd,
+		namespace: namespace,
+		clock:     clock,
+	}
+}
+
+// IsSyncBlocked returns true if the target of the Recommendation is managed by an ArgoCD Application
+// and the syncs of the Application are blocked by the sync windows of its AppProject, i.e. a matching
+// deny window is active or none of the matching allow windows is active.
+// The message holds the details of the blocking window.
+func (c *SyncWindowChecker) IsSyncBlocked() (blocked bool, msg string, err error) {
+	obj, err := target.GetTarget(c.ctx, c.kc, c.rcmd)
+	if err != nil {
+		return false, "", err
+	}
+	appName := applicationName(obj)
+	if appName == "" {
+		return false, "", nil
+	}
+
+	app := &unstructured.Unstructured{}
+	app.SetGroupVersionKind(applicationGVK)
+	if err = c.kc.Get(c.ctx, client.ObjectKey{Namespace: c.namespace, Name: appName}, app); err != nil {
+		if client.IgnoreNotFound(err) == nil || meta.IsNoMatchError(err) {
+			return false, "", nil
+		}
+		return false, "", err
+	}
+	projectName, _, _ := unstructured.NestedString(app.Object, "spec", "project")
+	if projectName == "" {
+		projectName = "default"
+	}
+	destNamespace, _, _ := unstructured.NestedString(app.Object, "spec", "destination", "namespace")
+	destServer, _, _ := unstructured.NestedString(app.Object, "spec", "destination", "server")
+	destName, _, _ := unstructured.NestedString(app.Object, "spec", "destination", "name")
+
+	project := &unstructured.Unstructured{}
+	project.SetGroupVersionKind(appProjectGVK)
+	if err = c.kc.Get(c.ctx, client.ObjectKey{Namespace: c.namespace, Name: projectName}, project); err != nil {
+		return false, "", client.IgnoreNotFound(err)
+	}
+	windows, _, err := unstructured.NestedSlice(project.Object, "spec", "syncWindows")
+	if err != nil {
+		return false, "", err
+	}
+
+	now := c.clock.Now()
+	var hasAllow, allowActive bool
+	for _, w := range windows {
+		window, ok := w.(map[string]any)
+		if !ok {
+			continue
+		}
+		if !matchesAny(window, "applications", appName) &&
+			!matchesAny(window, "namespaces", destNamespace) &&
+			!matchesAny(window, "clusters", destServer, destName) {
+			continue
+		}
+		active, err := isActive(window, now)
+		if err != nil {
+			return false, "", fmt.Errorf("invalid sync window of AppProject %s: %w", projectName, err)
+		}
+		kind, _, _ := unstructured.NestedString(window, "kind")
+		schedule, _, _ := unstructured.NestedString(window, "schedule")
+		switch kind {
+		case "deny":
+			if active {
+				return true, fmt.Sprintf("Syncs of ArgoCD Application %s are denied by the sync window %q of AppProject %s", appName, schedule, projectName), nil
+			}
+		case "allow":
+			hasAllow = true
+			allowActive = allowActive || active
+		}
+	}
+	if hasAllow && !allowActive {
+		return true, fmt.Sprintf("None of the allow sync windows of ArgoCD Application %s in AppProject %s is active", appName, projectName), nil
+	}
+	return false, "", nil
+}
+
+// applicationName returns the name of the ArgoCD Application tracking the object, if any.
+func applicationName(obj *unstructured.Unstructured) string {
+	if id, ok := obj.GetAnnotations()[trackingIDKey]; ok {
+		name, _, _ := strings.Cut(id, ":")
+		// the Applications in any namespace are tracked as `<namespace>_<name>`
+		if _, after, found := strings.Cut(name, "_"); found {
+			return after
+		}
+		return name
+	}
+	return obj.GetLabels()[instanceKey]
+}
+
+func matchesAny(window map[string]any, field string, values ...string) bool {
+	patterns, _, _ := unstructured.NestedStringSlice(window, field)
+	for _, p := range patterns {
+		for _, v := range values {
+			if v == "" {
+				continue
+			}
+			if ok, _ := filepath.Match(p, v); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isActive returns true if the window is started within its duration before the given time.
+func isActive(window map[string]any, now time.Time) (bool, error) {
+	spec, _, _ := unstructured.NestedString(window, "schedule")
+	schedule, err := digest.ParseSchedule(spec)
+	if err != nil {
+		return false, err
+	}
+	d, _, _ := unstructured.NestedString(window, "duration")
+	duration, err := time.ParseDuration(d)
+	if err != nil {
+		return false, err
+	}
+	if tz, _, _ := unstructured.NestedString(window, "timeZone"); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return false, err
+		}
+		now = now.In(loc)
+	}
+	start := schedule.Next(now.Add(-duration))
+	return !start.IsZero() && !start.After(now), nil
+}
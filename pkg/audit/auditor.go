@@ -0,0 +1,152 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/sharding"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// List of audited events
+const (
+	EventCreated         = "Created"
+	EventPhaseChanged    = "PhaseChanged"
+	EventApprovalChanged = "ApprovalChanged"
+	EventDeleted         = "Deleted"
+)
+
+// operatorActor is the actor of the transitions made by the operator itself.
+const operatorActor = "supervisor"
+
+// Record is the structured audit record of a state transition of a Recommendation.
+type Record struct {
+	Timestamp              time.Time                      `json:"timestamp"`
+	Event                  string                         `json:"event"`
+	Namespace              string                         `json:"namespace"`
+	Name                   string                         `json:"name"`
+	UID                    types.UID                      `json:"uid"`
+	Target                 core.TypedLocalObjectReference `json:"target"`
+	Phase                  api.RecommendationPhase        `json:"phase,omitempty"`
+	PreviousPhase          api.RecommendationPhase        `json:"previousPhase,omitempty"`
+	ApprovalStatus         api.ApprovalStatus             `json:"approvalStatus,omitempty"`
+	PreviousApprovalStatus api.ApprovalStatus             `json:"previousApprovalStatus,omitempty"`
+	Actor                  string                         `json:"actor"`
+	Reason                 string                         `json:"reason,omitempty"`
+	Comments               string                         `json:"comments,omitempty"`
+}
+
+// Auditor writes an audit Record to the Sink for every state transition of the Recommendations.
+// Unlike the Events, the Records are kept as long as the Sink keeps them.
+// Only the Records of the namespaces owned by the given shard are written, when the reconciliation is sharded.
+type Auditor struct {
+	sink  Sink
+	shard sharding.Shard
+}
+
+func NewAuditor(sink Sink, shard sharding.Shard) *Auditor {
+	return &Auditor{sink: sink, shard: shard}
+}
+
+// SetupWithManager registers the Auditor to the Recommendation informer of the Manager.
+func (a *Auditor) SetupWithManager(mgr manager.Manager) error {
+	informer, err := mgr.GetCache().GetInformer(context.Background(), &api.Recommendation{})
+	if err != nil {
+		return err
+	}
+	_, err = informer.AddEventHandler(toolscache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			if rcmd, ok := obj.(*api.Recommendation); ok && !isInInitialList {
+				a.write(newRecord(EventCreated, nil, rcmd))
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldRcmd, ok1 := oldObj.(*api.Recommendation)
+			rcmd, ok2 := newObj.(*api.Recommendation)
+			if !ok1 || !ok2 {
+				return
+			}
+			if oldRcmd.Status.ApprovalStatus != rcmd.Status.ApprovalStatus {
+				a.write(newRecord(EventApprovalChanged, oldRcmd, rcmd))
+			}
+			if oldRcmd.Status.Phase != rcmd.Status.Phase {
+				a.write(newRecord(EventPhaseChanged, oldRcmd, rcmd))
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if d, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = d.Obj
+			}
+			if rcmd, ok := obj.(*api.Recommendation); ok {
+				a.write(newRecord(EventDeleted, nil, rcmd))
+			}
+		},
+	})
+	return err
+}
+
+func (a *Auditor) write(rec Record) {
+	if !a.shard.OwnsNamespace(rec.Namespace) {
+		return
+	}
+	if err := a.sink.Write(rec); err != nil {
+		klog.Errorf("failed to write audit record of Recommendation %s/%s: %v", rec.Namespace, rec.Name, err)
+	}
+}
+
+func newRecord(event string, oldRcmd, rcmd *api.Recommendation) Record {
+	rec := Record{
+		Timestamp:      time.Now().UTC(),
+		Event:          event,
+		Namespace:      rcmd.Namespace,
+		Name:           rcmd.Name,
+		UID:            rcmd.UID,
+		Target:         rcmd.Spec.Target,
+		Phase:          rcmd.Status.Phase,
+		ApprovalStatus: rcmd.Status.ApprovalStatus,
+		Actor:          operatorActor,
+		Reason:         rcmd.Status.Reason,
+	}
+	if oldRcmd != nil {
+		rec.PreviousPhase = oldRcmd.Status.Phase
+		rec.PreviousApprovalStatus = oldRcmd.Status.ApprovalStatus
+	}
+
+	switch event {
+	case EventCreated:
+		if creator, ok := rcmd.Annotations[api.CreatedByKey]; ok {
+			rec.Actor = creator
+		}
+	case EventApprovalChanged:
+		if rcmd.Status.Reviewer != nil {
+			rec.Actor = rcmd.Status.Reviewer.Name
+		}
+		if rcmd.Status.RejectionReason != "" {
+			rec.Reason = string(rcmd.Status.RejectionReason)
+		}
+		rec.Comments = rcmd.Status.Comments
+	}
+	return rec
+}
@@ -0,0 +1,147 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/policy"
+	"kubeops.dev/supervisor/pkg/shared"
+
+	"gomodules.xyz/pointer"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	stashBackupSessionGVK = schema.GroupVersionKind{
+		Group:   "stash.appscode.com",
+		Version: "v1beta1",
+		Kind:    "BackupSession",
+	}
+	kubeStashBackupSessionGVK = schema.GroupVersionKind{
+		Group:   "core.kubestash.com",
+		Version: "v1alpha1",
+		Kind:    "BackupSession",
+	}
+)
+
+type BackupManager struct {
+	ctx  context.Context
+	kc   client.Client
+	rcmd *api.Recommendation
+}
+
+func NewBackupManager(ctx context.Context, kc client.Client, rcmd *api.Recommendation) *BackupManager {
+	return &BackupManager{
+		ctx:  ctx,
+		kc:   kc,
+		rcmd: rcmd,
+	}
+}
+
+// GetBackupTrigger returns the BackupTrigger of the Recommendation if the backup is needed before executing its operation.
+// The BackupTrigger of the Recommendation gets priority over the matching ApprovalPolicy.
+func (m *BackupManager) GetBackupTrigger() (*api.BackupTrigger, error) {
+	trigger := m.rcmd.Spec.Backup
+	if trigger == nil {
+		approvalPolicy, err := policy.NewApprovalPolicyFinder(m.ctx, m.kc, m.rcmd).FindApprovalPolicy()
+		if err != nil {
+			return nil, err
+		}
+		if approvalPolicy == nil || approvalPolicy.Backup == nil {
+			return nil, nil
+		}
+		trigger = approvalPolicy.Backup
+	}
+	if len(trigger.OperationTypes) == 0 {
+		return trigger, nil
+	}
+
+	opsType, err := shared.GetOperationType(m.rcmd.Spec.Operation)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range trigger.OperationTypes {
+		if t == opsType {
+			return trigger, nil
+		}
+	}
+	return nil, nil
+}
+
+// TriggerAndWait creates a BackupSession for the given BackupTrigger if it doesn't exist and returns its result.
+// nil result means the BackupSession is still running.
+func (m *BackupManager) TriggerAndWait(trigger *api.BackupTrigger) (*bool, string, error) {
+	session := m.newBackupSession(trigger)
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(session.GroupVersionKind())
+	err := m.kc.Get(m.ctx, client.ObjectKeyFromObject(session), existing)
+	if kerr.IsNotFound(err) {
+		return nil, "", m.kc.Create(m.ctx, session)
+	} else if err != nil {
+		return nil, "", err
+	}
+
+	phase, _, err := unstructured.NestedString(existing.Object, "status", "phase")
+	if err != nil {
+		return nil, "", err
+	}
+	switch phase {
+	case "Succeeded":
+		return pointer.BoolP(true), fmt.Sprintf("BackupSession %s is succeeded", existing.GetName()), nil
+	case "Failed", "Skipped":
+		return pointer.BoolP(false), fmt.Sprintf("BackupSession %s is %s", existing.GetName(), phase), nil
+	}
+	return nil, "", nil
+}
+
+// Cleanup deletes the BackupSession created by TriggerAndWait, so that a new one is created on the next attempt.
+func (m *BackupManager) Cleanup(trigger *api.BackupTrigger) error {
+	return client.IgnoreNotFound(m.kc.Delete(m.ctx, m.newBackupSession(trigger)))
+}
+
+func (m *BackupManager) newBackupSession(trigger *api.BackupTrigger) *unstructured.Unstructured {
+	session := &unstructured.Unstructured{}
+	session.SetName(fmt.Sprintf("%s-backup", m.rcmd.Name))
+	session.SetNamespace(m.rcmd.Namespace)
+
+	if trigger.Provider == api.BackupProviderStash {
+		session.SetGroupVersionKind(stashBackupSessionGVK)
+		_ = unstructured.SetNestedMap(session.Object, map[string]interface{}{
+			"apiGroup": stashBackupSessionGVK.Group,
+			"kind":     "BackupConfiguration",
+			"name":     trigger.BackupConfiguration,
+		}, "spec", "invoker")
+		return session
+	}
+
+	session.SetGroupVersionKind(kubeStashBackupSessionGVK)
+	_ = unstructured.SetNestedMap(session.Object, map[string]interface{}{
+		"apiGroup": kubeStashBackupSessionGVK.Group,
+		"kind":     "BackupConfiguration",
+		"name":     trigger.BackupConfiguration,
+	}, "spec", "invoker")
+	if trigger.Session != "" {
+		_ = unstructured.SetNestedField(session.Object, trigger.Session, "spec", "session")
+	}
+	return session
+}
@@ -0,0 +1,172 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package changerequest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/shared"
+
+	"github.com/jonboulle/clockwork"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Approval states of the ServiceNow change requests
+const (
+	ServiceNowApprovalRequested = "requested"
+	ServiceNowApprovalApproved  = "approved"
+	ServiceNowApprovalRejected  = "rejected"
+)
+
+const serviceNowChangeRequestPath = "/api/now/table/change_request"
+
+// ServiceNowManager creates the ServiceNow change request of a Recommendation and observes its approval state.
+type ServiceNowManager struct {
+	ctx    context.Context
+	kc     client.Client
+	rcmd   *api.Recommendation
+	spec   *api.ServiceNowChangeRequest
+	client *http.Client
+	clock  clockwork.Clock
+}
+
+func NewServiceNowManager(ctx context.Context, kc client.Client, rcmd *api.Recommendation, spec *api.ServiceNowChangeRequest, clock clockwork.Clock) *ServiceNowManager {
+	return &ServiceNowManager{
+		ctx:    ctx,
+		kc:     kc,
+		rcmd:   rcmd,
+		spec:   spec,
+		client: &http.Client{Timeout: 30 * time.Second},
+		clock:  clock,
+	}
+}
+
+type serviceNowResponse struct {
+	Result struct {
+		SysID    string `json:"sys_id"`
+		Number   string `json:"number"`
+		Approval string `json:"approval"`
+	} `json:"result"`
+}
+
+// Sync creates the change request if the Recommendation doesn't have one yet.
+// Otherwise, it fetches the current approval state of the change request.
+func (m *ServiceNowManager) Sync() (*api.ChangeRequest, error) {
+	secret := &core.Secret{}
+	ns := m.spec.SecretRef.Namespace
+	if ns == "" {
+		ns = m.rcmd.Namespace
+	}
+	if err := m.kc.Get(m.ctx, client.ObjectKey{Namespace: ns, Name: m.spec.SecretRef.Name}, secret); err != nil {
+		return nil, err
+	}
+	baseURL := strings.TrimSuffix(string(secret.Data[api.ServiceNowURLKey]), "/")
+	if baseURL == "" {
+		return nil, fmt.Errorf("secret %s/%s doesn't have the %q key", ns, secret.Name, api.ServiceNowURLKey)
+	}
+
+	var req *http.Request
+	var err error
+	if m.rcmd.Status.ChangeRequest == nil {
+		body, err := m.changeRequestBody()
+		if err != nil {
+			return nil, err
+		}
+		req, err = http.NewRequestWithContext(m.ctx, http.MethodPost, baseURL+serviceNowChangeRequestPath, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+	} else {
+		url := fmt.Sprintf("%s%s/%s?sysparm_fields=sys_id,number,approval", baseURL, serviceNowChangeRequestPath, m.rcmd.Status.ChangeRequest.ID)
+		req, err = http.NewRequestWithContext(m.ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(string(secret.Data[api.ServiceNowUsernameKey]), string(secret.Data[api.ServiceNowPasswordKey]))
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("ServiceNow responded with status %d", resp.StatusCode)
+	}
+	out := serviceNowResponse{}
+	if err = json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	if out.Result.SysID == "" {
+		return nil, fmt.Errorf("ServiceNow responded without the sys_id of the change request")
+	}
+	return &api.ChangeRequest{
+		ID:           out.Result.SysID,
+		Number:       out.Result.Number,
+		Approval:     out.Result.Approval,
+		LastSyncTime: &metav1.Time{Time: m.clock.Now().UTC()},
+	}, nil
+}
+
+func (m *ServiceNowManager) changeRequestBody() ([]byte, error) {
+	gvk, err := shared.GetGVK(m.rcmd.Spec.Operation)
+	if err != nil {
+		return nil, err
+	}
+	opsType, err := shared.GetOperationType(m.rcmd.Spec.Operation)
+	if err != nil {
+		return nil, err
+	}
+	operation := gvk.Kind
+	if opsType != "" {
+		operation = fmt.Sprintf("%s %s", opsType, gvk.Kind)
+	}
+
+	target := m.rcmd.Spec.Target
+	description := fmt.Sprintf("Recommendation: %s/%s\nTarget: %s %s/%s\nOperation: %s\nSeverity: %s",
+		m.rcmd.Namespace, m.rcmd.Name, target.Kind, m.rcmd.Namespace, target.Name, operation, m.rcmd.Spec.Severity)
+	if m.rcmd.Spec.Description != "" {
+		description = fmt.Sprintf("%s\n\n%s", m.rcmd.Spec.Description, description)
+	}
+
+	body := map[string]string{
+		"short_description": fmt.Sprintf("%s of %s %s/%s", operation, target.Kind, m.rcmd.Namespace, target.Name),
+		"description":       description,
+		"correlation_id":    string(m.rcmd.UID),
+	}
+	if m.spec.Type != "" {
+		body["type"] = m.spec.Type
+	}
+	if m.spec.AssignmentGroup != "" {
+		body["assignment_group"] = m.spec.AssignmentGroup
+	}
+	if m.spec.Category != "" {
+		body["category"] = m.spec.Category
+	}
+	return json.Marshal(body)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmds
+
+import (
+	"fmt"
+	"io"
+
+	"kubeops.dev/supervisor/pkg/admissionpolicy"
+
+	"github.com/spf13/cobra"
+	admissionregistration "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func NewCmdAdmissionPolicies(out io.Writer) *cobra.Command {
+	var (
+		actions               []string
+		twoPersonRuleSelector string
+	)
+	cmd := &cobra.Command{
+		Use:               "admission-policies",
+		Short:             "Print the ValidatingAdmissionPolicies equivalent to the Supervisor webhook validations",
+		Long:              "Print the ValidatingAdmissionPolicy and ValidatingAdmissionPolicyBinding manifests (Kubernetes 1.28+) enforcing the Recommendation and MaintenanceWindow validations of the webhooks with CEL, for the clusters preferring webhook-less admission.",
+		DisableAutoGenTag: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validationActions := make([]admissionregistration.ValidationAction, 0, len(actions))
+			for _, a := range actions {
+				switch va := admissionregistration.ValidationAction(a); va {
+				case admissionregistration.Deny, admissionregistration.Warn, admissionregistration.Audit:
+					validationActions = append(validationActions, va)
+				default:
+					return fmt.Errorf("unknown validation action %q", a)
+				}
+			}
+			var sel *metav1.LabelSelector
+			if twoPersonRuleSelector != "" {
+				var err error
+				if sel, err = metav1.ParseToLabelSelector(twoPersonRuleSelector); err != nil {
+					return fmt.Errorf("invalid two-person-rule-namespace-selector: %w", err)
+				}
+			}
+
+			for _, obj := range admissionpolicy.Generate(validationActions, sel) {
+				data, err := yaml.Marshal(obj)
+				if err != nil {
+					return err
+				}
+				if _, err = fmt.Fprintf(out, "---\n%s", data); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&actions, "validation-actions", []string{string(admissionregistration.Deny)}, "Validation actions of the bindings, any of `Deny`, `Warn` and `Audit`")
+	cmd.Flags().StringVar(&twoPersonRuleSelector, "two-person-rule-namespace-selector", twoPersonRuleSelector, "Label selector of the namespaces where the creator of a Recommendation is not allowed to approve it. The creator has to set the `supervisor.appscode.com/created-by` annotation to their username there. Empty means the rule is disabled")
+
+	return cmd
+}
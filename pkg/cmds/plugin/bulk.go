@@ -0,0 +1,130 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/shared"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// bulkOptions holds the filters and the guardrails of reviewing many Recommendations at once.
+type bulkOptions struct {
+	selector string
+	opType   string
+	dryRun   bool
+	yes      bool
+	maxCount int
+}
+
+func (b *bulkOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&b.selector, "selector", "l", b.selector, "Label selector of the pending Recommendations to review")
+	cmd.Flags().StringVar(&b.opType, "op-type", b.opType, "Type (.spec.type) of the operations of the pending Recommendations to review, e.g. Restart. It is matched case-insensitively")
+	cmd.Flags().BoolVar(&b.dryRun, "dry-run", b.dryRun, "If true, only print the matched Recommendations without reviewing them")
+	cmd.Flags().BoolVarP(&b.yes, "yes", "y", b.yes, "If true, skip the confirmation prompt")
+	cmd.Flags().IntVar(&b.maxCount, "max-count", b.maxCount, "Maximum number of Recommendations to review at once. The command fails if more Recommendations are matched")
+}
+
+func (b *bulkOptions) isSet() bool {
+	return b.selector != "" || b.opType != ""
+}
+
+// reviewAll updates the approval decision of the pending Recommendations of the namespace matching the filters,
+// after printing them and getting the confirmation of the user.
+func (o *options) reviewAll(ctx context.Context, b bulkOptions, status api.ApprovalStatus, decide func(in *api.Recommendation)) error {
+	selector, err := labels.Parse(b.selector)
+	if err != nil {
+		return fmt.Errorf("invalid selector: %w", err)
+	}
+	kc, err := o.client()
+	if err != nil {
+		return err
+	}
+	ns, err := o.namespace()
+	if err != nil {
+		return err
+	}
+	rcmdList := &api.RecommendationList{}
+	if err = kc.List(ctx, rcmdList, client.InNamespace(ns), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return err
+	}
+
+	matched := make([]api.Recommendation, 0)
+	for _, rcmd := range rcmdList.Items {
+		if !isPending(&rcmd) {
+			continue
+		}
+		if b.opType != "" {
+			opsType, err := shared.GetOperationType(rcmd.Spec.Operation)
+			if err != nil || !strings.EqualFold(opsType, b.opType) {
+				continue
+			}
+		}
+		matched = append(matched, rcmd)
+	}
+	if len(matched) == 0 {
+		fmt.Fprintf(o.out, "no pending Recommendation matched in namespace %s\n", ns)
+		return nil
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].Name < matched[j].Name
+	})
+
+	w := o.tabWriter()
+	fmt.Fprintln(w, "NAME\tTARGET\tSEVERITY\tDESCRIPTION")
+	for _, rcmd := range matched {
+		fmt.Fprintf(w, "%s\t%s/%s\t%s\t%s\n", rcmd.Name, rcmd.Spec.Target.Kind, rcmd.Spec.Target.Name, rcmd.Spec.Severity, rcmd.Spec.Description)
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	if b.maxCount > 0 && len(matched) > b.maxCount {
+		return fmt.Errorf("%d Recommendations matched, which is more than the --max-count %d", len(matched), b.maxCount)
+	}
+	if b.dryRun {
+		fmt.Fprintf(o.out, "%d Recommendation(s) would be %s (dry run)\n", len(matched), status)
+		return nil
+	}
+	if !b.yes && !o.confirm(fmt.Sprintf("%s %d Recommendation(s) in namespace %s?", status, len(matched), ns)) {
+		fmt.Fprintln(o.out, "aborted")
+		return nil
+	}
+
+	for i := range matched {
+		if err = o.patchReview(ctx, kc, &matched[i], status, decide); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// confirm asks the user the question and returns true if the answer is yes.
+func (o *options) confirm(question string) bool {
+	fmt.Fprintf(o.out, "%s [y/N]: ", question)
+	answer, _ := bufio.NewReader(o.in).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
@@ -0,0 +1,84 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newCmdHistory(o *options) *cobra.Command {
+	var kind string
+	cmd := &cobra.Command{
+		Use:               "history <target>",
+		Short:             "List the executions of the Recommendations of a target object",
+		DisableAutoGenTag: true,
+		Args:              cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kc, err := o.client()
+			if err != nil {
+				return err
+			}
+			ns, err := o.namespace()
+			if err != nil {
+				return err
+			}
+			execList := &api.MaintenanceExecutionList{}
+			if err = kc.List(cmd.Context(), execList, client.InNamespace(ns)); err != nil {
+				return err
+			}
+
+			executions := make([]api.MaintenanceExecution, 0)
+			for _, me := range execList.Items {
+				if me.Spec.Target.Name == args[0] && (kind == "" || me.Spec.Target.Kind == kind) {
+					executions = append(executions, me)
+				}
+			}
+			sort.Slice(executions, func(i, j int) bool {
+				return executions[i].CreationTimestamp.Before(&executions[j].CreationTimestamp)
+			})
+
+			w := o.tabWriter()
+			fmt.Fprintln(w, "RECOMMENDATION\tTARGET\tOPERATION\tRESULT\tSTART\tCOMPLETION\tREASON")
+			for _, me := range executions {
+				operation := "<none>"
+				if me.Spec.OperationRef != nil {
+					operation = me.Spec.OperationRef.Kind + "/" + me.Spec.OperationRef.Name
+				}
+				fmt.Fprintf(w, "%s\t%s/%s\t%s\t%s\t%s\t%s\t%s\n", me.Spec.Recommendation.Name, me.Spec.Target.Kind, me.Spec.Target.Name,
+					operation, me.Spec.Result, formatTime(me.Spec.StartTime), formatTime(me.Spec.CompletionTime), me.Spec.Reason)
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().StringVar(&kind, "kind", kind, "Kind of the target object, e.g. MongoDB. Empty means any kind")
+	return cmd
+}
+
+func formatTime(t *metav1.Time) string {
+	if t == nil {
+		return "<none>"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
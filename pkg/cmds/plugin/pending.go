@@ -0,0 +1,81 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newCmdPending(o *options) *cobra.Command {
+	var allNamespaces bool
+	cmd := &cobra.Command{
+		Use:               "pending",
+		Short:             "List the Recommendations awaiting approval",
+		DisableAutoGenTag: true,
+		Args:              cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kc, err := o.client()
+			if err != nil {
+				return err
+			}
+			var opts []client.ListOption
+			if !allNamespaces {
+				ns, err := o.namespace()
+				if err != nil {
+					return err
+				}
+				opts = append(opts, client.InNamespace(ns))
+			}
+			rcmdList := &api.RecommendationList{}
+			if err = kc.List(cmd.Context(), rcmdList, opts...); err != nil {
+				return err
+			}
+
+			pending := make([]api.Recommendation, 0)
+			for _, rcmd := range rcmdList.Items {
+				if isPending(&rcmd) {
+					pending = append(pending, rcmd)
+				}
+			}
+			sort.Slice(pending, func(i, j int) bool {
+				return pending[i].CreationTimestamp.Before(&pending[j].CreationTimestamp)
+			})
+
+			w := o.tabWriter()
+			fmt.Fprintln(w, "NAMESPACE\tNAME\tTARGET\tSEVERITY\tDEADLINE\tAGE")
+			for _, rcmd := range pending {
+				deadline := "<none>"
+				if rcmd.Spec.Deadline != nil {
+					deadline = rcmd.Spec.Deadline.UTC().Format(time.RFC3339)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s/%s\t%s\t%s\t%s\n", rcmd.Namespace, rcmd.Name, rcmd.Spec.Target.Kind, rcmd.Spec.Target.Name,
+					rcmd.Spec.Severity, deadline, duration.HumanDuration(time.Since(rcmd.CreationTimestamp.Time)))
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", allNamespaces, "If true, list the pending Recommendations of all namespaces")
+	return cmd
+}
@@ -0,0 +1,147 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kmapi "kmodules.xyz/client-go/api/v1"
+	kmc "kmodules.xyz/client-go/client"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newCmdApprove(o *options) *cobra.Command {
+	var (
+		reason            string
+		window            string
+		maintenanceWindow string
+		bulk              = bulkOptions{maxCount: 20}
+	)
+	cmd := &cobra.Command{
+		Use:   "approve [<name>]",
+		Short: "Approve a Recommendation, or the pending Recommendations matching the filters",
+		Example: `  # approve a Recommendation
+  kubectl supervisor approve mg-restart -n prod
+
+  # approve the pending Restart Recommendations labeled app=mongo
+  kubectl supervisor approve --selector app=mongo --op-type restart -n prod`,
+		DisableAutoGenTag: true,
+		Args:              cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && !bulk.isSet() {
+				return errors.New("either the name of the Recommendation or a filter must be provided")
+			}
+			if len(args) == 1 && bulk.isSet() {
+				return errors.New("the name of the Recommendation can't be used with the filters")
+			}
+			var approvedWindow *api.ApprovedWindow
+			if window != "" || maintenanceWindow != "" {
+				approvedWindow = &api.ApprovedWindow{Window: api.WindowType(window)}
+				if maintenanceWindow != "" {
+					approvedWindow.MaintenanceWindow = &kmapi.TypedObjectReference{
+						APIGroup: api.GroupVersion.Group,
+						Kind:     api.ResourceKindMaintenanceWindow,
+						Name:     maintenanceWindow,
+					}
+				}
+			}
+			decide := func(in *api.Recommendation) {
+				in.Status.Comments = reason
+				if approvedWindow != nil {
+					in.Status.ApprovedWindow = approvedWindow
+				}
+			}
+			if len(args) == 0 {
+				return o.reviewAll(cmd.Context(), bulk, api.ApprovalApproved, decide)
+			}
+			return o.review(cmd.Context(), args[0], api.ApprovalApproved, decide)
+		},
+	}
+	bulk.addFlags(cmd)
+	cmd.Flags().StringVar(&reason, "reason", reason, "Comments of the approval")
+	cmd.Flags().StringVar(&window, "window", window, "Window of the execution: Immediate, NextAvailable or SpecificDates")
+	cmd.Flags().StringVar(&maintenanceWindow, "maintenance-window", maintenanceWindow, "Name of the MaintenanceWindow of the namespace to execute the Recommendation in")
+	return cmd
+}
+
+func newCmdReject(o *options) *cobra.Command {
+	var (
+		reason          string
+		rejectionReason = string(api.RejectionReasonOther)
+	)
+	cmd := &cobra.Command{
+		Use:               "reject <name>",
+		Short:             "Reject a Recommendation",
+		DisableAutoGenTag: true,
+		Args:              cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.review(cmd.Context(), args[0], api.ApprovalRejected, func(in *api.Recommendation) {
+				in.Status.RejectionReason = api.RejectionReason(rejectionReason)
+				in.Status.Comments = reason
+			})
+		},
+	}
+	cmd.Flags().StringVar(&reason, "reason", reason, "Comments of the rejection")
+	cmd.Flags().StringVar(&rejectionReason, "rejection-reason", rejectionReason, "Category of the rejection: Unnecessary, HighRisk, BadTiming, Duplicate or Other")
+	return cmd
+}
+
+// review updates the approval decision of the pending Recommendation through its status subresource.
+// The reviewer is recorded by the mutating webhook from the authenticated user of the request.
+func (o *options) review(ctx context.Context, name string, status api.ApprovalStatus, decide func(in *api.Recommendation)) error {
+	kc, err := o.client()
+	if err != nil {
+		return err
+	}
+	ns, err := o.namespace()
+	if err != nil {
+		return err
+	}
+	rcmd := &api.Recommendation{}
+	if err = kc.Get(ctx, client.ObjectKey{Namespace: ns, Name: name}, rcmd); err != nil {
+		return err
+	}
+	if !isPending(rcmd) {
+		return fmt.Errorf("recommendation %s/%s is already %s", ns, name, rcmd.Status.ApprovalStatus)
+	}
+	return o.patchReview(ctx, kc, rcmd, status, decide)
+}
+
+func (o *options) patchReview(ctx context.Context, kc client.Client, rcmd *api.Recommendation, status api.ApprovalStatus, decide func(in *api.Recommendation)) error {
+	_, err := kmc.PatchStatus(ctx, kc, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.ApprovalStatus = status
+		decide(in)
+		in.Status.ReviewTimestamp = &metav1.Time{Time: metav1.Now().UTC()}
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(o.out, "recommendation %s/%s %s\n", rcmd.Namespace, rcmd.Name, status)
+	return nil
+}
+
+func isPending(rcmd *api.Recommendation) bool {
+	return rcmd.Status.ApprovalStatus == "" || rcmd.Status.ApprovalStatus == api.ApprovalPending
+}
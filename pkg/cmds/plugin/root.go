@@ -0,0 +1,118 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/maintenance"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(api.AddToScheme(scheme))
+}
+
+// options holds the kubeconfig flags shared by the commands of the plugin.
+type options struct {
+	configFlags *genericclioptions.ConfigFlags
+	in          io.Reader
+	out         io.Writer
+}
+
+// NewRootCmd returns the root command of the `kubectl supervisor` plugin.
+func NewRootCmd() *cobra.Command {
+	o := &options{
+		configFlags: genericclioptions.NewConfigFlags(true),
+		in:          os.Stdin,
+		out:         os.Stdout,
+	}
+	rootCmd := &cobra.Command{
+		Use:               "kubectl-supervisor [command]",
+		Short:             `kubectl plugin to review the Recommendations of Supervisor`,
+		DisableAutoGenTag: true,
+		SilenceUsage:      true,
+	}
+	o.configFlags.AddFlags(rootCmd.PersistentFlags())
+
+	rootCmd.AddCommand(newCmdPending(o))
+	rootCmd.AddCommand(newCmdApprove(o))
+	rootCmd.AddCommand(newCmdReject(o))
+	rootCmd.AddCommand(newCmdWindows(o))
+	rootCmd.AddCommand(newCmdHistory(o))
+	rootCmd.AddCommand(newCmdSchedule(o))
+	return rootCmd
+}
+
+func (o *options) client() (client.Client, error) {
+	cfg, err := o.configFlags.ToRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	return client.New(cfg, client.Options{Scheme: scheme})
+}
+
+// cachedClient returns a client reading from an informer cache having the same field indexes as the operator,
+// so that the code paths of the operator relying on the indexes can be reused.
+func (o *options) cachedClient(ctx context.Context) (client.Client, error) {
+	cfg, err := o.configFlags.ToRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	c, err := cache.New(cfg, cache.Options{Scheme: scheme})
+	if err != nil {
+		return nil, err
+	}
+	if err = maintenance.IndexDefaultMaintenanceWindows(ctx, c); err != nil {
+		return nil, err
+	}
+	go func() {
+		_ = c.Start(ctx)
+	}()
+	if !c.WaitForCacheSync(ctx) {
+		return nil, errors.New("failed to sync the cache")
+	}
+	return client.New(cfg, client.Options{
+		Scheme: scheme,
+		Cache:  &client.CacheOptions{Reader: c},
+	})
+}
+
+// namespace returns the namespace of the --namespace flag or the current context.
+func (o *options) namespace() (string, error) {
+	ns, _, err := o.configFlags.ToRawKubeConfigLoader().Namespace()
+	return ns, err
+}
+
+func (o *options) tabWriter() *tabwriter.Writer {
+	return tabwriter.NewWriter(o.out, 0, 8, 2, ' ', 0)
+}
@@ -0,0 +1,135 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/maintenance"
+	"kubeops.dev/supervisor/pkg/parallelism"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newCmdSchedule(o *options) *cobra.Command {
+	return &cobra.Command{
+		Use:               "schedule <recommendation>",
+		Short:             "Show when a Recommendation is going to be executed",
+		Long:              "Show the MaintenanceWindows resolved for a Recommendation, its next scheduled window and its position in the execution queue, using the same code path as the operator",
+		DisableAutoGenTag: true,
+		Args:              cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			kc, err := o.cachedClient(ctx)
+			if err != nil {
+				return err
+			}
+			ns, err := o.namespace()
+			if err != nil {
+				return err
+			}
+			rcmd := &api.Recommendation{}
+			if err = kc.Get(ctx, client.ObjectKey{Namespace: ns, Name: args[0]}, rcmd); err != nil {
+				return err
+			}
+
+			clock := api.GetClock()
+			rcmdMaintenance := maintenance.NewRecommendationMaintenance(ctx, kc, rcmd, clock)
+			mwList, err := rcmdMaintenance.AvailableMaintenanceWindowList()
+			if err != nil {
+				return err
+			}
+			// the operator keeps the Recommendation Pending with the error as the reason, so it is reported rather than returned
+			maintenanceTime := "false"
+			if isMaintenanceTime, err := rcmdMaintenance.IsMaintenanceTime(); err != nil {
+				maintenanceTime = "error: " + err.Error()
+			} else if isMaintenanceTime {
+				maintenanceTime = "true"
+			}
+			next, err := rcmdMaintenance.NextScheduledWindow()
+			if err != nil {
+				return err
+			}
+			position, length, err := parallelism.NewParallelRunner(ctx, kc, rcmd).QueuePosition()
+			if err != nil {
+				return err
+			}
+
+			approval := rcmd.Status.ApprovalStatus
+			if approval == "" {
+				approval = api.ApprovalPending
+			}
+			window := "<none>"
+			if aw := rcmd.Status.ApprovedWindow; aw != nil {
+				window = string(aw.Window)
+			}
+
+			w := o.tabWriter()
+			fmt.Fprintf(w, "Recommendation:\t%s/%s\n", rcmd.Namespace, rcmd.Name)
+			fmt.Fprintf(w, "Approval:\t%s\n", approval)
+			fmt.Fprintf(w, "Phase:\t%s\t%s\n", rcmd.Status.Phase, rcmd.Status.Reason)
+			fmt.Fprintf(w, "Approved Window:\t%s\n", window)
+			if len(mwList.Items) == 0 {
+				fmt.Fprintf(w, "Maintenance Windows:\t<none>\n")
+			}
+			for i, mw := range mwList.Items {
+				label := ""
+				if i == 0 {
+					label = "Maintenance Windows:"
+				}
+				kind, name := api.ResourceKindMaintenanceWindow, mw.Namespace+"/"+mw.Name
+				if mw.Namespace == "" {
+					kind, name = api.ResourceKindClusterMaintenanceWindow, mw.Name
+				}
+				fmt.Fprintf(w, "%s\t%s %s\n", label, kind, name)
+			}
+			fmt.Fprintf(w, "Maintenance Time:\t%s\n", maintenanceTime)
+			if next == nil {
+				fmt.Fprintf(w, "Next Window:\t<none>\n")
+			} else {
+				source := "ApprovedWindow"
+				if next.MaintenanceWindow != nil {
+					source = next.MaintenanceWindow.Kind + " " + next.MaintenanceWindow.Name
+				}
+				end := "<none>"
+				if !next.End.IsZero() {
+					end = next.End.UTC().Format(time.RFC3339)
+				}
+				fmt.Fprintf(w, "Next Window:\t%s - %s\t%s\n", next.Start.UTC().Format(time.RFC3339), end, source)
+			}
+			if position == 0 {
+				fmt.Fprintf(w, "Queue Position:\tnot queued\t%d Recommendation(s) in the %s queue\n", length, parallelismOf(rcmd))
+			} else {
+				fmt.Fprintf(w, "Queue Position:\t%d of %d\tin the %s queue\n", position, length, parallelismOf(rcmd))
+			}
+			if approval != api.ApprovalApproved {
+				fmt.Fprintf(w, "\nThe Recommendation is not executed until it is Approved.\n")
+			}
+			return w.Flush()
+		},
+	}
+}
+
+func parallelismOf(rcmd *api.Recommendation) api.Parallelism {
+	if rcmd.Status.Parallelism == "" {
+		return api.QueuePerNamespace
+	}
+	return rcmd.Status.Parallelism
+}
@@ -0,0 +1,92 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/maintenance"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newCmdWindows(o *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "windows",
+		Short:             "Inspect the maintenance windows",
+		DisableAutoGenTag: true,
+	}
+	cmd.AddCommand(newCmdWindowsNext(o))
+	return cmd
+}
+
+func newCmdWindowsNext(o *options) *cobra.Command {
+	return &cobra.Command{
+		Use:               "next",
+		Short:             "List the current or next window of the MaintenanceWindows of the namespace and the ClusterMaintenanceWindows",
+		DisableAutoGenTag: true,
+		Args:              cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kc, err := o.client()
+			if err != nil {
+				return err
+			}
+			ns, err := o.namespace()
+			if err != nil {
+				return err
+			}
+			mwList := &api.MaintenanceWindowList{}
+			if err = kc.List(cmd.Context(), mwList, client.InNamespace(ns)); err != nil {
+				return err
+			}
+			cmwList := &api.ClusterMaintenanceWindowList{}
+			if err = kc.List(cmd.Context(), cmwList); err != nil {
+				return err
+			}
+			for _, cmw := range cmwList.Items {
+				mwList.Items = append(mwList.Items, api.MaintenanceWindow{ObjectMeta: cmw.ObjectMeta, Spec: cmw.Spec})
+			}
+
+			now := time.Now()
+			windows := make([]*api.ScheduledWindow, 0, len(mwList.Items))
+			for _, mw := range mwList.Items {
+				next, err := maintenance.NextWindow(mw, now)
+				if err != nil {
+					return fmt.Errorf("failed to get the next window of %s: %w", mw.Name, err)
+				}
+				if next != nil {
+					windows = append(windows, next)
+				}
+			}
+			sort.Slice(windows, func(i, j int) bool {
+				return windows[i].Start.Before(&windows[j].Start)
+			})
+
+			w := o.tabWriter()
+			fmt.Fprintln(w, "KIND\tNAME\tSTART\tEND\tACTIVE")
+			for _, next := range windows {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\n", next.MaintenanceWindow.Kind, next.MaintenanceWindow.Name,
+					next.Start.UTC().Format(time.RFC3339), next.End.UTC().Format(time.RFC3339), !next.Start.After(now))
+			}
+			return w.Flush()
+		},
+	}
+}
@@ -19,13 +19,26 @@ package server
 import (
 	"errors"
 	"flag"
+	"fmt"
+	"strings"
 	"time"
 
 	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
 	"kubeops.dev/supervisor/pkg/controllers"
+	"kubeops.dev/supervisor/pkg/digest"
+	"kubeops.dev/supervisor/pkg/execution"
+	"kubeops.dev/supervisor/pkg/features"
+	"kubeops.dev/supervisor/pkg/gitops"
+	"kubeops.dev/supervisor/pkg/precondition"
+	"kubeops.dev/supervisor/pkg/prometheus"
+	"kubeops.dev/supervisor/pkg/scheduler"
 	"kubeops.dev/supervisor/pkg/server"
+	"kubeops.dev/supervisor/pkg/sharding"
+	"kubeops.dev/supervisor/pkg/target"
+	"kubeops.dev/supervisor/pkg/webhooks"
 
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"kmodules.xyz/client-go/tools/clusterid"
 	"kmodules.xyz/webhook-runtime/builder"
@@ -35,15 +48,88 @@ type ExtraOptions struct {
 	QPS   float64
 	Burst int
 
-	ResyncPeriod           time.Duration
-	MaxConcurrentReconcile int // NumThreads
-	RequeueAfterDuration   time.Duration
-	MaxRetryOnFailure      int // MaxNumRequeues
-	RetryAfterDuration     time.Duration
-	BeforeDeadlineDuration time.Duration
+	ResyncPeriod            time.Duration
+	MaxConcurrentReconcile  int // NumThreads
+	RequeueAfterDuration    time.Duration
+	MaxRetryOnFailure       int // MaxNumRequeues
+	RetryAfterDuration      time.Duration
+	BeforeDeadlineDuration  time.Duration
+	MaxQueueDuration        time.Duration
+	MaxClusterParallelOps   int
+	MaxNamespaceParallelOps int
+	PriorityWeights         string
+	PriorityMinShares       string
+	Shards                  int
+	ShardIndex              int
+
+	MaintenanceWindowWorkers int
+	RateLimiterBaseDelay     time.Duration
+	RateLimiterMaxDelay      time.Duration
+	RateLimiterQPS           float64
+	RateLimiterBurst         int
+
+	WatchNamespaces   string
+	ExcludeNamespaces string
+
+	GracefulShutdownTimeout time.Duration
+
+	CircuitBreakerThreshold int
+	CircuitBreakerScope     string
+
+	TargetDriftAction string
+
+	EnableAutoRollback bool
+
+	MinDurationSamples int
+
+	AnnotateMaintenanceMode   bool
+	MaintenanceModeWebhookURL string
+
+	RequireReadyNodes   bool
+	RequireNoActiveOps  bool
+	PrometheusURL       string
+	PreconditionQueries string
+
+	EnableTargetApprovalAnnotation bool
+	DefaultTimezone                string
+	TwoPersonRuleNamespaceSelector string
+	MetricsBindAddress             string
+	EnableTestClock                bool
+	TracingEndpoint                string
+	TracingInsecure                bool
+	AuditSink                      string
+
+	SlackCallbackBindAddress string
+	SlackSigningSecretFile   string
+	SlackAllowedUsers        string
+
+	DashboardBindAddress string
+	DashboardTLSCertFile string
+	DashboardTLSKeyFile  string
+
+	DigestSchedule string
+
+	GitOpsRepository   string
+	GitOpsBranch       string
+	GitOpsPathTemplate string
+	GitOpsAPIURL       string
+	GitOpsTokenFile    string
+
+	ArgoCDNamespace      string
+	EnableFluxSuspension bool
+
+	EnableOCMHub bool
+	OCMHubUsers  string
+
+	KubeDBRecommenderKinds     string
+	EnableKubeDBCVERecommender bool
+	CertificateExpiryDays      int
 
 	EnableValidatingWebhook bool
 	EnableMutatingWebhook   bool
+
+	ConversionWebhookService string
+	ConversionWebhookCAFile  string
 }
 
 func NewExtraOptions() *ExtraOptions {
@@ -53,6 +139,24 @@ func NewExtraOptions() *ExtraOptions {
 		QPS:                    1e6,
 		Burst:                  1e6,
 		ResyncPeriod:           10 * time.Minute,
+		MetricsBindAddress:     ":8080",
+		GitOpsBranch:           "main",
+		GitOpsPathTemplate:     gitops.DefaultPathTemplate,
+		GitOpsAPIURL:           "https://api.github.com",
+
+		MaintenanceWindowWorkers: 1,
+		RateLimiterBaseDelay:     5 * time.Millisecond,
+		RateLimiterMaxDelay:      1000 * time.Second,
+		RateLimiterQPS:           10,
+		RateLimiterBurst:         100,
+
+		GracefulShutdownTimeout: 30 * time.Second,
+
+		CircuitBreakerScope: string(execution.CircuitBreakerScopeTarget),
+
+		TargetDriftAction: string(target.DriftActionReapprove),
+
+		MinDurationSamples: 3,
 	}
 }
 
@@ -63,26 +167,119 @@ func (s *ExtraOptions) AddGoFlags(fs *flag.FlagSet) {
 	fs.IntVar(&s.Burst, "burst", s.Burst, "The maximum burst for throttle")
 
 	fs.DurationVar(&s.ResyncPeriod, "resync-period", s.ResyncPeriod, "If non-zero, will re-list this often. Otherwise, re-list will be delayed aslong as possible (until the upstream source closes the watch or times out.")
-	fs.IntVar(&s.MaxConcurrentReconcile, "max-concurrent-reconcile", s.MaxConcurrentReconcile, "Maximum number of Recommendation object that will be reconciled concurrently")
-	fs.DurationVar(&s.RequeueAfterDuration, "requeue-after-duration", s.RequeueAfterDuration, "Duration after the Recommendation object will be requeue when it is waiting for MaintenanceWindow. The flag accepts a value acceptable to time.ParseDuration. Ref: https://pkg.go.dev/time#ParseDuration")
+	fs.StringVar(&s.WatchNamespaces, "watch-namespaces", s.WatchNamespaces, "Comma separated namespaces watched by the operator. The objects of the other namespaces are neither cached nor reconciled, so the admission webhooks should be scoped to the same namespaces. Empty means every namespace is watched")
+	fs.StringVar(&s.ExcludeNamespaces, "exclude-namespaces", s.ExcludeNamespaces, "Comma separated namespaces, e.g. `kube-system`, not watched by the operator. It can't be used along with --watch-namespaces")
+	fs.DurationVar(&s.GracefulShutdownTimeout, "graceful-shutdown-timeout", s.GracefulShutdownTimeout, "Duration the operator waits on shutdown for the in-flight reconciliations to finish. No new execution is started while shutting down, but the state of the executions already started is persisted for the next leader. It should be longer than 10s")
+	fs.IntVar(&s.MaxConcurrentReconcile, "recommendation-workers", s.MaxConcurrentReconcile, "Maximum number of Recommendation object that will be reconciled concurrently")
+	fs.IntVar(&s.MaxConcurrentReconcile, "max-concurrent-reconcile", s.MaxConcurrentReconcile, "Maximum number of Recommendation object that will be reconciled concurrently. Deprecated: use --recommendation-workers")
+	fs.IntVar(&s.MaintenanceWindowWorkers, "maintenance-window-workers", s.MaintenanceWindowWorkers, "Maximum number of MaintenanceWindow and ClusterMaintenanceWindow objects that will be reconciled concurrently")
+	fs.DurationVar(&s.RateLimiterBaseDelay, "rate-limiter-base-delay", s.RateLimiterBaseDelay, "Initial delay of the exponential per item backoff of the failed reconciliations")
+	fs.DurationVar(&s.RateLimiterMaxDelay, "rate-limiter-max-delay", s.RateLimiterMaxDelay, "Maximum delay of the exponential per item backoff of the failed reconciliations")
+	fs.Float64Var(&s.RateLimiterQPS, "rate-limiter-qps", s.RateLimiterQPS, "Overall number of requeues per second allowed by the rate limiter of the controller workqueues")
+	fs.IntVar(&s.RateLimiterBurst, "rate-limiter-burst", s.RateLimiterBurst, "Overall burst of requeues allowed by the rate limiter of the controller workqueues")
+	fs.DurationVar(&s.RequeueAfterDuration, "requeue-after-duration", s.RequeueAfterDuration, "Duration after the Recommendation object will be requeue when it is waiting for approval or for a MaintenanceWindow whose next occurrence is unknown. The Recommendations waiting for a known window are requeued when the window opens. The flag accepts a value acceptable to time.ParseDuration. Ref: https://pkg.go.dev/time#ParseDuration")
 	fs.IntVar(&s.MaxRetryOnFailure, "max-retry-on-failure", s.MaxRetryOnFailure, "Maximum number of retry on any kind of failure in Recommendation execution")
 	fs.DurationVar(&s.RetryAfterDuration, "retry-after-duration", s.RetryAfterDuration, "Duration after the failure events will be requeue again. The flag accepts a value acceptable to time.ParseDuration. Ref: https://pkg.go.dev/time#ParseDuration")
 	fs.DurationVar(&s.BeforeDeadlineDuration, "before-deadline-duration", s.BeforeDeadlineDuration, "When there is less time than `BeforeDeadlineDuration` before deadline, Recommendations are free to execute regardless of Parallelism")
 
+	fs.DurationVar(&s.MaxQueueDuration, "max-queue-duration", s.MaxQueueDuration, "SLA of the Recommendations. The SLABreached condition is set if a Recommendation is pending or waiting for longer than this duration. Zero(0) means no SLA. It can be overridden by the ApprovalPolicy")
+
+	fs.IntVar(&s.MaxClusterParallelOps, "max-cluster-parallel-ops", s.MaxClusterParallelOps, "Maximum number of Recommendations that can be executed at a time in the cluster, or in the namespaces of each shard if the reconciliation is sharded. Zero(0) means no limit")
+	fs.StringVar(&s.PriorityWeights, "priority-weights", s.PriorityWeights, "Comma separated weights of the priority classes, i.e. the severities, e.g. `Critical=8,High=4,Medium=2,Low=1`. If set, the capacity of --max-cluster-parallel-ops is allocated to the priority classes proportionally to their weights. Missing classes are weighted 1. Empty means the namespaces are served round-robin regardless of the priority")
+	fs.StringVar(&s.PriorityMinShares, "priority-min-shares", s.PriorityMinShares, "Comma separated minimum number of executions guaranteed to the priority classes before the capacity is allocated by the --priority-weights, e.g. `Low=1`")
+	fs.IntVar(&s.MaxNamespaceParallelOps, "max-namespace-parallel-ops", s.MaxNamespaceParallelOps, "Maximum number of Recommendations that can be executed at a time in a namespace. Zero(0) means no limit")
+
+	fs.IntVar(&s.CircuitBreakerThreshold, "circuit-breaker-threshold", s.CircuitBreakerThreshold, "Number of consecutive failed executions of the same target or namespace after which the executions there are paused until the circuit is reset by the `supervisor.appscode.com/reset-circuit=true` annotation of a paused Recommendation. Zero(0) means the circuit breaker is disabled")
+	fs.StringVar(&s.CircuitBreakerScope, "circuit-breaker-scope", s.CircuitBreakerScope, "Scope of the failures counted by the circuit breaker, either `Target` or `Namespace`")
+
+	fs.IntVar(&s.MinDurationSamples, "min-duration-samples", s.MinDurationSamples, "Minimum number of the previous successful executions of the same kind of operation on the targets of the same kind and size the duration of an operation is estimated from, before the operations estimated longer than the remaining time of the running window are deferred to the next window. Zero(0) means the executions are never deferred by the estimates")
+	fs.BoolVar(&s.AnnotateMaintenanceMode, "enable-maintenance-mode-annotation", s.AnnotateMaintenanceMode, "If true, the Services owned by the target of a Recommendation and the Ingresses routing to them are annotated with `supervisor.appscode.com/under-maintenance` during the execution, so that the front doors can show a maintenance page")
+	fs.StringVar(&s.MaintenanceModeWebhookURL, "maintenance-mode-webhook-url", s.MaintenanceModeWebhookURL, "URL to post a JSON event to when the execution of a Recommendation is started and completed, e.g. to switch the external load balancers to a maintenance page")
+	fs.BoolVar(&s.RequireReadyNodes, "require-ready-nodes", s.RequireReadyNodes, "If true, no execution is started while any node of the cluster is not Ready")
+	fs.BoolVar(&s.RequireNoActiveOps, "require-no-active-ops", s.RequireNoActiveOps, "If true, no execution is started while another operation of the same kind, e.g. a KubeDB OpsRequest created outside of the operator, is running on the target")
+	fs.StringVar(&s.PrometheusURL, "prometheus-url", s.PrometheusURL, "URL of the Prometheus server the PromQL preconditions are evaluated against")
+	fs.StringVar(&s.PreconditionQueries, "precondition-queries", s.PreconditionQueries, "Semicolon separated PromQL thresholds in `<query> <operator> <value>` format, e.g. `max(kube_node_status_condition{condition=\"MemoryPressure\",status=\"true\"}) < 1`, that must be satisfied by every sample of the result before any execution is started. It requires --prometheus-url")
+	fs.BoolVar(&s.EnableAutoRollback, "enable-auto-rollback", s.EnableAutoRollback, "If true, a Critical Recommendation rolling the target back to its previous version is created when the execution of a version upgrade Recommendation is failed and the target doesn't satisfy the readiness rule afterwards")
+	fs.StringVar(&s.TargetDriftAction, "target-drift-action", s.TargetDriftAction, "Action taken if the spec of the target is changed after the Recommendation is approved, either `Skip` to mark the Recommendation as Outdated or `Reapprove` to send it back for approval")
+
+	fs.IntVar(&s.Shards, "shards", s.Shards, "Number of replicas the reconciliation is sharded among by the hash of the namespaces. Each replica must run with a distinct --shard-index. The cluster scoped objects are reconciled by the shard 0. Zero(0) or one(1) means the sharding is disabled")
+	fs.IntVar(&s.ShardIndex, "shard-index", s.ShardIndex, "Index of the shard, from 0 to shards-1, reconciled by this replica. Note that --max-cluster-parallel-ops is enforced by each shard independently, counting only the Recommendations of its own namespaces")
+
+	fs.StringVar(&s.DefaultTimezone, "default-timezone", s.DefaultTimezone, "Timezone, e.g. `Asia/Dhaka`, set by the mutating webhook to the MaintenanceWindows and ClusterMaintenanceWindows created without any timezone. Empty means such windows are considered as UTC")
+	fs.BoolVar(&s.EnableTargetApprovalAnnotation, "enable-target-approval-annotation", s.EnableTargetApprovalAnnotation, "If true, Recommendations are approved by the `supervisor.appscode.com/approve-all` annotation of their target objects")
+
+	fs.StringVar(&s.TwoPersonRuleNamespaceSelector, "two-person-rule-namespace-selector", s.TwoPersonRuleNamespaceSelector, "Label selector of the namespaces where the creator of a Recommendation is not allowed to approve it. Empty means the rule is disabled")
+
+	fs.StringVar(&s.MetricsBindAddress, "metrics-bind-address", s.MetricsBindAddress, "The address the Prometheus metrics endpoint binds to. Use 0 to disable the metrics endpoint")
+	fs.BoolVar(&s.EnableTestClock, "enable-test-clock", s.EnableTestClock, "If true, the time of the operator can be set and shifted through the /debug/clock endpoint of the metrics server. Only for the e2e tests, never enable it in production")
+
+	fs.StringVar(&s.TracingEndpoint, "tracing-endpoint", s.TracingEndpoint, "The OTLP gRPC endpoint where the traces of the Recommendations are exported. Empty means tracing is disabled")
+	fs.BoolVar(&s.TracingInsecure, "tracing-insecure", s.TracingInsecure, "If true, the traces are exported without TLS")
+
+	fs.StringVar(&s.AuditSink, "audit-sink", s.AuditSink, "Destination of the audit records of the Recommendation transitions: stdout, file://<path> or an http(s) webhook url. Empty means auditing is disabled")
+
+	fs.StringVar(&s.SlackCallbackBindAddress, "slack-callback-bind-address", s.SlackCallbackBindAddress, "The address the callback server of the interactive Slack messages binds to. Empty means the server is disabled")
+	fs.StringVar(&s.SlackSigningSecretFile, "slack-signing-secret-file", s.SlackSigningSecretFile, "Path of the file holding the signing secret of the Slack app")
+	fs.StringVar(&s.SlackAllowedUsers, "slack-allowed-users", s.SlackAllowedUsers, "Comma separated Slack user ids or usernames allowed to approve or reject the Recommendations from Slack. Empty means every user of the channel")
+
+	fs.StringVar(&s.DigestSchedule, "digest-schedule", s.DigestSchedule, "Cron schedule, in the operator timezone, to send the digest of the pending Recommendations and the upcoming maintenance windows through the Notifiers. Empty means the digest is disabled")
+
+	fs.StringVar(&s.GitOpsRepository, "gitops-repository", s.GitOpsRepository, "GitHub repository, in owner/name format, to open the pull requests of the operations against instead of creating them directly. Empty means GitOps mode is disabled")
+	fs.StringVar(&s.GitOpsBranch, "gitops-branch", s.GitOpsBranch, "Base branch of the pull requests of the GitOps mode")
+	fs.StringVar(&s.GitOpsPathTemplate, "gitops-path-template", s.GitOpsPathTemplate, "Go template of the path of the operation manifests in the GitOps repository. The template is executed with the `.Namespace`, `.Recommendation`, `.Target` and `.Operation` fields")
+	fs.StringVar(&s.GitOpsAPIURL, "gitops-api-url", s.GitOpsAPIURL, "URL of the GitHub API of the GitOps repository")
+	fs.StringVar(&s.GitOpsTokenFile, "gitops-token-file", s.GitOpsTokenFile, "Path of the file holding the GitHub token to open the pull requests with")
+
+	fs.StringVar(&s.ArgoCDNamespace, "argocd-namespace", s.ArgoCDNamespace, "Namespace of the ArgoCD Applications. If set, the Recommendations of the objects managed by an ArgoCD Application are not executed while the syncs of the Application are blocked by its sync windows")
+
+	fs.BoolVar(&s.EnableFluxSuspension, "enable-flux-suspension", s.EnableFluxSuspension, "If true, the Flux Kustomization or HelmRelease managing the target of a Recommendation is suspended during the execution and resumed afterwards")
+
+	fs.BoolVar(&s.EnableOCMHub, "enable-ocm-hub", s.EnableOCMHub, "If true, the Recommendations and the MaintenanceWindows having a distribution are distributed to the Open Cluster Management managed clusters through ManifestWorks")
+	fs.StringVar(&s.OCMHubUsers, "ocm-hub-users", s.OCMHubUsers, "Comma separated usernames, e.g. the service account of the Open Cluster Management work agent, whose distributed Recommendations are Approved or Rejected by the decision of the hub cluster")
+
+	fs.StringVar(&s.KubeDBRecommenderKinds, "kubedb-recommender-kinds", s.KubeDBRecommenderKinds, "Comma separated KubeDB database kinds, e.g. `MongoDB,Postgres`, for which version upgrade Recommendations are created when the running version becomes deprecated or EOL. Empty means the recommender is disabled")
+
+	fs.BoolVar(&s.EnableKubeDBCVERecommender, "enable-kubedb-cve-recommender", s.EnableKubeDBCVERecommender, "If true, Critical version upgrade Recommendations are created for the KubeDB databases whose image has critical vulnerabilities reported by the Trivy operator")
+
+	fs.IntVar(&s.CertificateExpiryDays, "certificate-expiry-days", s.CertificateExpiryDays, "Number of days before the expiry of the cert-manager Certificates of the KubeDB databases when TLS certificate rotation Recommendations are created. Zero(0) means the recommender is disabled")
+
+	fs.StringVar(&s.DashboardBindAddress, "dashboard-bind-address", s.DashboardBindAddress, "The address the dashboard REST API binds to. Empty means the API is disabled")
+	fs.StringVar(&s.DashboardTLSCertFile, "dashboard-tls-cert-file", s.DashboardTLSCertFile, "Path to the TLS certificate of the dashboard REST API. Empty means the API is served over plain HTTP")
+	fs.StringVar(&s.DashboardTLSKeyFile, "dashboard-tls-key-file", s.DashboardTLSKeyFile, "Path to the TLS private key of the dashboard REST API")
+
 	fs.BoolVar(&s.EnableMutatingWebhook, "enable-mutating-webhook", s.EnableMutatingWebhook, "If true, enables mutating webhooks for Supervisor CRDs.")
 	fs.BoolVar(&s.EnableValidatingWebhook, "enable-validating-webhook", s.EnableValidatingWebhook, "If true, enables validating webhooks for Supervisor CRDs.")
+	fs.StringVar(&s.ConversionWebhookService, "conversion-webhook-service", s.ConversionWebhookService, "Namespace/name of the service of the operator, which serves the conversion webhook of the CRDs having multiple versions. Empty means the versions are converted without a webhook")
+	fs.StringVar(&s.ConversionWebhookCAFile, "conversion-webhook-ca-file", s.ConversionWebhookCAFile, "Path to the CA bundle verifying the serving certificate of the conversion webhook")
 }
 
 func (s *ExtraOptions) AddFlags(fs *pflag.FlagSet) {
 	pfs := flag.NewFlagSet("supervisor", flag.ExitOnError)
 	s.AddGoFlags(pfs)
 	fs.AddGoFlagSet(pfs)
+	_ = fs.MarkDeprecated("max-concurrent-reconcile", "use --recommendation-workers instead")
+	features.DefaultMutableFeatureGate.AddFlag(fs)
 }
 
 func (c *ExtraOptions) Validate() []error {
 	errs := make([]error, 0)
 	if c.MaxConcurrentReconcile <= 0 {
-		errs = append(errs, errors.New("max-concurrent-reconcile must be greater than 0"))
+		errs = append(errs, errors.New("recommendation-workers must be greater than 0"))
+	}
+	if c.WatchNamespaces != "" && c.ExcludeNamespaces != "" {
+		errs = append(errs, errors.New("watch-namespaces and exclude-namespaces can't be set together"))
+	}
+	if c.GracefulShutdownTimeout < 0 {
+		errs = append(errs, errors.New("graceful-shutdown-timeout must not be negative"))
+	}
+	if c.MaintenanceWindowWorkers <= 0 {
+		errs = append(errs, errors.New("maintenance-window-workers must be greater than 0"))
+	}
+	if c.RateLimiterBaseDelay <= 0 || c.RateLimiterMaxDelay < c.RateLimiterBaseDelay {
+		errs = append(errs, errors.New("rate-limiter-base-delay must be greater than 0 and not greater than rate-limiter-max-delay"))
+	}
+	if c.RateLimiterQPS <= 0 || c.RateLimiterBurst <= 0 {
+		errs = append(errs, errors.New("rate-limiter-qps and rate-limiter-burst must be greater than 0"))
 	}
 	if _, err := time.ParseDuration(c.RetryAfterDuration.String()); err != nil {
 		errs = append(errs, err)
@@ -93,11 +290,105 @@ func (c *ExtraOptions) Validate() []error {
 	if _, err := time.ParseDuration(c.BeforeDeadlineDuration.String()); err != nil {
 		errs = append(errs, err)
 	}
+	if c.MaxQueueDuration < 0 {
+		errs = append(errs, errors.New("max-queue-duration must not be negative"))
+	}
+	if c.SlackCallbackBindAddress != "" && c.SlackSigningSecretFile == "" {
+		errs = append(errs, errors.New("slack-signing-secret-file is required for the Slack callback server"))
+	}
+	if c.DigestSchedule != "" && !features.Enabled(features.Notifications) {
+		errs = append(errs, fmt.Errorf("digest-schedule requires the %s feature gate", features.Notifications))
+	}
+	if c.DigestSchedule != "" {
+		if _, err := digest.ParseSchedule(c.DigestSchedule); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if c.GitOpsRepository != "" && !features.Enabled(features.GitOpsMode) {
+		errs = append(errs, fmt.Errorf("gitops-repository requires the %s feature gate", features.GitOpsMode))
+	}
+	if c.GitOpsRepository != "" {
+		if _, err := gitops.NewRepository(c.GitOpsAPIURL, c.GitOpsRepository, c.GitOpsBranch, c.GitOpsPathTemplate, ""); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if c.DefaultTimezone != "" {
+		if _, err := time.LoadLocation(c.DefaultTimezone); err != nil {
+			errs = append(errs, fmt.Errorf("invalid default-timezone: %w", err))
+		}
+	}
+	if c.EnableTestClock && c.MetricsBindAddress == "0" {
+		errs = append(errs, errors.New("enable-test-clock requires the metrics endpoint"))
+	}
+	if c.ConversionWebhookService != "" {
+		if ns, name, found := strings.Cut(c.ConversionWebhookService, "/"); !found || ns == "" || name == "" {
+			errs = append(errs, errors.New("conversion-webhook-service must be in namespace/name format"))
+		}
+	}
+	if (c.KubeDBRecommenderKinds != "" || c.EnableKubeDBCVERecommender || c.CertificateExpiryDays > 0) && !features.Enabled(features.AutoRecommenders) {
+		errs = append(errs, fmt.Errorf("kubedb-recommender-kinds, enable-kubedb-cve-recommender and certificate-expiry-days require the %s feature gate", features.AutoRecommenders))
+	}
+	if c.CertificateExpiryDays < 0 {
+		errs = append(errs, errors.New("certificate-expiry-days must not be negative"))
+	}
+	if c.MaxClusterParallelOps < 0 {
+		errs = append(errs, errors.New("max-cluster-parallel-ops must not be negative"))
+	}
+	if c.MaxNamespaceParallelOps < 0 {
+		errs = append(errs, errors.New("max-namespace-parallel-ops must not be negative"))
+	}
+	if _, err := scheduler.ParseShares(c.PriorityWeights); err != nil {
+		errs = append(errs, fmt.Errorf("invalid priority-weights: %w", err))
+	}
+	if _, err := scheduler.ParseShares(c.PriorityMinShares); err != nil {
+		errs = append(errs, fmt.Errorf("invalid priority-min-shares: %w", err))
+	}
+	if c.CircuitBreakerThreshold < 0 {
+		errs = append(errs, errors.New("circuit-breaker-threshold must not be negative"))
+	}
+	if scope := execution.CircuitBreakerScope(c.CircuitBreakerScope); scope != execution.CircuitBreakerScopeTarget && scope != execution.CircuitBreakerScopeNamespace {
+		errs = append(errs, fmt.Errorf("circuit-breaker-scope must be either %s or %s", execution.CircuitBreakerScopeTarget, execution.CircuitBreakerScopeNamespace))
+	}
+	if c.MinDurationSamples < 0 {
+		errs = append(errs, errors.New("min-duration-samples must not be negative"))
+	}
+	if _, err := prometheus.ParseThresholds(c.PreconditionQueries); err != nil {
+		errs = append(errs, fmt.Errorf("invalid precondition-queries: %w", err))
+	}
+	if c.PreconditionQueries != "" && c.PrometheusURL == "" {
+		errs = append(errs, errors.New("precondition-queries requires prometheus-url"))
+	}
+	if c.PrometheusURL != "" {
+		if _, err := prometheus.NewClient(c.PrometheusURL); err != nil {
+			errs = append(errs, fmt.Errorf("invalid prometheus-url: %w", err))
+		}
+	}
+	if action := target.DriftAction(c.TargetDriftAction); action != target.DriftActionSkip && action != target.DriftActionReapprove {
+		errs = append(errs, fmt.Errorf("target-drift-action must be either %s or %s", target.DriftActionSkip, target.DriftActionReapprove))
+	}
+	if c.Shards < 0 {
+		errs = append(errs, errors.New("shards must not be negative"))
+	}
+	if c.Shards > 1 && (c.ShardIndex < 0 || c.ShardIndex >= c.Shards) {
+		errs = append(errs, fmt.Errorf("shard-index must be between 0 and %d", c.Shards-1))
+	}
+	if (c.DashboardTLSCertFile == "") != (c.DashboardTLSKeyFile == "") {
+		errs = append(errs, errors.New("dashboard-tls-cert-file and dashboard-tls-key-file must be set together"))
+	}
+	if _, err := labels.Parse(c.TwoPersonRuleNamespaceSelector); err != nil {
+		errs = append(errs, fmt.Errorf("invalid two-person-rule-namespace-selector: %w", err))
+	}
+	// the creator of a Recommendation is only recorded by the mutating webhook and the rule is enforced by the validating webhook.
+	// The clusters without the webhooks enforce the rule by the policies printed by the admission-policies command instead.
+	if c.TwoPersonRuleNamespaceSelector != "" && (!c.EnableMutatingWebhook || !c.EnableValidatingWebhook) {
+		errs = append(errs, errors.New("two-person-rule-namespace-selector requires both enable-mutating-webhook and enable-validating-webhook"))
+	}
 
 	return errs
 }
 
 func (s *ExtraOptions) ApplyTo(cfg *controllers.Config) error {
+	var err error
 	cfg.ClientConfig.QPS = float32(s.QPS)
 	cfg.ClientConfig.Burst = s.Burst
 
@@ -107,9 +398,85 @@ func (s *ExtraOptions) ApplyTo(cfg *controllers.Config) error {
 	cfg.MaxRetryOnFailure = s.MaxRetryOnFailure
 	cfg.RetryAfterDuration = s.RetryAfterDuration
 	cfg.BeforeDeadlineDuration = s.BeforeDeadlineDuration
+	cfg.MaxQueueDuration = s.MaxQueueDuration
+	cfg.MaxClusterParallelOps = s.MaxClusterParallelOps
+	cfg.MaxNamespaceParallelOps = s.MaxNamespaceParallelOps
+	if cfg.PriorityWeights, err = scheduler.ParseShares(s.PriorityWeights); err != nil {
+		return err
+	}
+	if cfg.PriorityMinShares, err = scheduler.ParseShares(s.PriorityMinShares); err != nil {
+		return err
+	}
+	cfg.Shard = sharding.Shard{Index: s.ShardIndex, Count: s.Shards}
+	cfg.MaintenanceWindowWorkers = s.MaintenanceWindowWorkers
+	cfg.RateLimiterBaseDelay = s.RateLimiterBaseDelay
+	cfg.RateLimiterMaxDelay = s.RateLimiterMaxDelay
+	cfg.RateLimiterQPS = s.RateLimiterQPS
+	cfg.RateLimiterBurst = s.RateLimiterBurst
+	if s.WatchNamespaces != "" {
+		cfg.WatchNamespaces = strings.Split(s.WatchNamespaces, ",")
+	}
+	if s.ExcludeNamespaces != "" {
+		cfg.ExcludeNamespaces = strings.Split(s.ExcludeNamespaces, ",")
+	}
+	cfg.GracefulShutdownTimeout = s.GracefulShutdownTimeout
+	cfg.CircuitBreakerThreshold = s.CircuitBreakerThreshold
+	cfg.CircuitBreakerScope = execution.CircuitBreakerScope(s.CircuitBreakerScope)
+	cfg.TargetDriftAction = target.DriftAction(s.TargetDriftAction)
+	cfg.EnableAutoRollback = s.EnableAutoRollback
+	cfg.MinDurationSamples = s.MinDurationSamples
+	cfg.AnnotateMaintenanceMode = s.AnnotateMaintenanceMode
+	cfg.MaintenanceModeWebhookURL = s.MaintenanceModeWebhookURL
+	cfg.Preconditions = precondition.Preconditions{
+		RequireReadyNodes:  s.RequireReadyNodes,
+		RequireNoActiveOps: s.RequireNoActiveOps,
+	}
+	if cfg.Preconditions.Queries, err = prometheus.ParseThresholds(s.PreconditionQueries); err != nil {
+		return err
+	}
+	cfg.PrometheusURL = s.PrometheusURL
+	cfg.EnableTargetApprovalAnnotation = s.EnableTargetApprovalAnnotation
+	cfg.DefaultTimezone = s.DefaultTimezone
+	cfg.MetricsBindAddress = s.MetricsBindAddress
+	cfg.EnableTestClock = s.EnableTestClock
+	cfg.TracingEndpoint = s.TracingEndpoint
+	cfg.TracingInsecure = s.TracingInsecure
+	cfg.AuditSink = s.AuditSink
+	cfg.SlackCallbackBindAddress = s.SlackCallbackBindAddress
+	cfg.SlackSigningSecretFile = s.SlackSigningSecretFile
+	cfg.DigestSchedule = s.DigestSchedule
+	cfg.GitOpsRepository = s.GitOpsRepository
+	cfg.GitOpsBranch = s.GitOpsBranch
+	cfg.GitOpsPathTemplate = s.GitOpsPathTemplate
+	cfg.GitOpsAPIURL = s.GitOpsAPIURL
+	cfg.GitOpsTokenFile = s.GitOpsTokenFile
+	cfg.ArgoCDNamespace = s.ArgoCDNamespace
+	cfg.EnableFluxSuspension = s.EnableFluxSuspension
+	cfg.EnableOCMHub = s.EnableOCMHub
+	if s.OCMHubUsers != "" {
+		cfg.OCMHubUsers = strings.Split(s.OCMHubUsers, ",")
+	}
+	cfg.EnableKubeDBCVERecommender = s.EnableKubeDBCVERecommender
+	cfg.CertificateBeforeExpiry = time.Duration(s.CertificateExpiryDays) * 24 * time.Hour
+	if s.KubeDBRecommenderKinds != "" {
+		cfg.KubeDBRecommenderKinds = strings.Split(s.KubeDBRecommenderKinds, ",")
+	}
+	if s.SlackAllowedUsers != "" {
+		cfg.SlackAllowedUsers = strings.Split(s.SlackAllowedUsers, ",")
+	}
+	cfg.DashboardBindAddress = s.DashboardBindAddress
+	cfg.DashboardTLSCertFile = s.DashboardTLSCertFile
+	cfg.DashboardTLSKeyFile = s.DashboardTLSKeyFile
+	if s.TwoPersonRuleNamespaceSelector != "" {
+		if cfg.TwoPersonRuleNamespaceSelector, err = labels.Parse(s.TwoPersonRuleNamespaceSelector); err != nil {
+			return err
+		}
+	}
 
 	cfg.EnableMutatingWebhook = s.EnableMutatingWebhook
 	cfg.EnableValidatingWebhook = s.EnableValidatingWebhook
+	cfg.ConversionWebhookService = s.ConversionWebhookService
+	cfg.ConversionWebhookCAFile = s.ConversionWebhookCAFile
 
 	apiTypes := []runtime.Object{
 		&api.ApprovalPolicy{},
@@ -131,5 +498,11 @@ func (s *ExtraOptions) ApplyTo(cfg *controllers.Config) error {
 			cfg.AdmissionHooks = append(cfg.AdmissionHooks, validator)
 		}
 	}
+	if s.EnableMutatingWebhook {
+		cfg.AdmissionHooks = append(cfg.AdmissionHooks, webhooks.NewRecommendationIdentityMutator())
+	}
+	if s.EnableValidatingWebhook {
+		cfg.AdmissionHooks = append(cfg.AdmissionHooks, webhooks.NewRecommendationApprovalValidator(cfg.TwoPersonRuleNamespaceSelector))
+	}
 	return nil
 }
@@ -73,6 +73,8 @@ func NewSupervisorOperatorOptions(out, errOut io.Writer) *SupervisorOperatorOpti
 	}
 	o.RecommendedOptions.Etcd = nil
 	o.RecommendedOptions.Admission = nil
+	// the kube-apiserver calls the conversion webhook without any RBAC permission on it
+	o.RecommendedOptions.Authorization.AlwaysAllowPaths = append(o.RecommendedOptions.Authorization.AlwaysAllowPaths, server.ConversionWebhookPath)
 
 	return o
 }
@@ -111,10 +113,12 @@ func (o SupervisorOperatorOptions) Config() (*server.SupervisorOperatorConfig, e
 
 		"/apis/mutators.supervisor.appscode.com/v1alpha1",
 		"/apis/mutators.supervisor.appscode.com/v1alpha1/recommendationwebhooks",
+		"/apis/mutators.supervisor.appscode.com/v1alpha1/recommendationidentitywebhooks",
 
 		"/apis/validators.supervisor.appscode.com/v1alpha1",
 		"/apis/validators.supervisor.appscode.com/v1alpha1/clustermaintenancewindowwebhooks",
 		"/apis/validators.supervisor.appscode.com/v1alpha1/maintenancewindowwebhooks",
+		"/apis/validators.supervisor.appscode.com/v1alpha1/recommendationapprovalwebhooks",
 		"/apis/validators.supervisor.appscode.com/v1alpha1/recommendationwebhooks",
 	}
 	serverConfig.OpenAPIConfig = genericapiserver.DefaultOpenAPIConfig(
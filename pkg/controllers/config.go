@@ -20,9 +20,17 @@ import (
 	"time"
 
 	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/execution"
+	"kubeops.dev/supervisor/pkg/precondition"
+	"kubeops.dev/supervisor/pkg/sharding"
+	"kubeops.dev/supervisor/pkg/target"
 
+	"golang.org/x/time/rate"
+	crdv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	crd_cs "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	"kmodules.xyz/client-go/apiextensions"
 	hooks "kmodules.xyz/webhook-runtime/admission/v1"
@@ -31,16 +39,86 @@ import (
 type Config struct {
 	ClientConfig *rest.Config
 
-	ResyncPeriod           time.Duration
-	MaxConcurrentReconcile int // NumThreads
-	RequeueAfterDuration   time.Duration
-	MaxRetryOnFailure      int // MaxNumRequeues
-	RetryAfterDuration     time.Duration
-	BeforeDeadlineDuration time.Duration
+	ResyncPeriod            time.Duration
+	MaxConcurrentReconcile  int // NumThreads
+	RequeueAfterDuration    time.Duration
+	MaxRetryOnFailure       int // MaxNumRequeues
+	RetryAfterDuration      time.Duration
+	BeforeDeadlineDuration  time.Duration
+	MaxQueueDuration        time.Duration
+	MaxClusterParallelOps   int
+	MaxNamespaceParallelOps int
+	PriorityWeights         map[api.Severity]int
+	PriorityMinShares       map[api.Severity]int
+	Shard                   sharding.Shard
+
+	MaintenanceWindowWorkers int
+	RateLimiterBaseDelay     time.Duration
+	RateLimiterMaxDelay      time.Duration
+	RateLimiterQPS           float64
+	RateLimiterBurst         int
+
+	WatchNamespaces   []string
+	ExcludeNamespaces []string
+
+	GracefulShutdownTimeout time.Duration
+
+	CircuitBreakerThreshold int
+	CircuitBreakerScope     execution.CircuitBreakerScope
+
+	TargetDriftAction target.DriftAction
+
+	EnableAutoRollback bool
+
+	MinDurationSamples int
+
+	AnnotateMaintenanceMode   bool
+	MaintenanceModeWebhookURL string
+
+	Preconditions precondition.Preconditions
+	PrometheusURL string
+
+	EnableTargetApprovalAnnotation bool
+	TwoPersonRuleNamespaceSelector labels.Selector
+	DefaultTimezone                string
+	MetricsBindAddress             string
+	EnableTestClock                bool
+	TracingEndpoint                string
+	TracingInsecure                bool
+	AuditSink                      string
+
+	SlackCallbackBindAddress string
+	SlackSigningSecretFile   string
+	SlackAllowedUsers        []string
+
+	DashboardBindAddress string
+	DashboardTLSCertFile string
+	DashboardTLSKeyFile  string
+
+	DigestSchedule string
+
+	GitOpsRepository   string
+	GitOpsBranch       string
+	GitOpsPathTemplate string
+	GitOpsAPIURL       string
+	GitOpsTokenFile    string
+
+	ArgoCDNamespace      string
+	EnableFluxSuspension bool
+
+	EnableOCMHub bool
+	OCMHubUsers  []string
+
+	KubeDBRecommenderKinds     []string
+	EnableKubeDBCVERecommender bool
+	CertificateBeforeExpiry    time.Duration
 
 	EnableValidatingWebhook bool
 	EnableMutatingWebhook   bool
 
+	ConversionWebhookService string
+	ConversionWebhookCAFile  string
+
 	AdmissionHooks []hooks.AdmissionHook
 }
 
@@ -50,13 +128,38 @@ func NewConfig(cfg *rest.Config) *Config {
 	}
 }
 
-func EnsureCustomResourceDefinitions(client crd_cs.Interface) error {
+// NewRateLimiter returns the rate limiter of a controller workqueue. It combines the exponential per item backoff
+// of the failed reconciliations with an overall token bucket, like the default rate limiter of controller-runtime.
+// A new rate limiter is returned on every call, as the per item backoff can't be shared among the controllers.
+func (c *Config) NewRateLimiter() workqueue.RateLimiter {
+	return workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(c.RateLimiterBaseDelay, c.RateLimiterMaxDelay),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(c.RateLimiterQPS), c.RateLimiterBurst)},
+	)
+}
+
+// EnsureCustomResourceDefinitions registers the CRDs. The given conversion, if any, is set to the CRDs having multiple versions.
+func EnsureCustomResourceDefinitions(client crd_cs.Interface, conversion *crdv1.CustomResourceConversion) error {
 	klog.Infoln("Ensuring CustomResourceDefinition...")
 	crds := []*apiextensions.CustomResourceDefinition{
 		api.ApprovalPolicy{}.CustomResourceDefinition(),
+		api.ClusterApprovalPolicy{}.CustomResourceDefinition(),
 		api.ClusterMaintenanceWindow{}.CustomResourceDefinition(),
 		api.MaintenanceWindow{}.CustomResourceDefinition(),
 		api.Recommendation{}.CustomResourceDefinition(),
+		api.RecommendationGroup{}.CustomResourceDefinition(),
+		api.MaintenanceExecution{}.CustomResourceDefinition(),
+		api.Notifier{}.CustomResourceDefinition(),
+		api.SupervisorReport{}.CustomResourceDefinition(),
+		api.MaintenanceFreeze{}.CustomResourceDefinition(),
+		api.DowntimeBudget{}.CustomResourceDefinition(),
+	}
+	if conversion != nil {
+		for _, crd := range crds {
+			if len(crd.V1.Spec.Versions) > 1 {
+				crd.V1.Spec.Conversion = conversion.DeepCopy()
+			}
+		}
 	}
 	return apiextensions.RegisterCRDs(client, crds)
 }
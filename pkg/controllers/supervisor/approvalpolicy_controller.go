@@ -20,6 +20,7 @@ import (
 	"context"
 
 	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/sharding"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -31,6 +32,8 @@ import (
 type ApprovalPolicyReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+	// Shard is the subset of namespaces reconciled by this replica.
+	Shard sharding.Shard
 }
 
 //+kubebuilder:rbac:groups=supervisor.appscode.com,resources=approvalpolicies,verbs=get;list;watch;create;update;patch;delete
@@ -58,5 +61,5 @@ func (r *ApprovalPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 func (r *ApprovalPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&api.ApprovalPolicy{}).
-		Complete(r)
+		Complete(r.Shard.Reconciler(r))
 }
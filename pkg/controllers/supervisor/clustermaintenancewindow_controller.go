@@ -20,19 +20,29 @@ import (
 	"context"
 
 	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/maintenance"
+	"kubeops.dev/supervisor/pkg/sharding"
 
+	"github.com/jonboulle/clockwork"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 	kmc "kmodules.xyz/client-go/client"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 // ClusterMaintenanceWindowReconciler reconciles a ClusterMaintenanceWindow object
 type ClusterMaintenanceWindowReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+	Clock  clockwork.Clock
+	// Shard is the subset of namespaces reconciled by this replica.
+	Shard sharding.Shard
 }
 
 //+kubebuilder:rbac:groups=supervisor.appscode.com,resources=clustermaintenancewindows,verbs=get;list;watch;create;update;patch;delete
@@ -70,12 +80,48 @@ func (r *ClusterMaintenanceWindowReconciler) Reconcile(ctx context.Context, req
 		}
 	}
 
-	return ctrl.Result{}, nil
+	// The utilization is refreshed when an execution is completed and on the transitions of the window.
+	mw := api.MaintenanceWindow{
+		ObjectMeta: clusterMW.ObjectMeta,
+		Spec:       clusterMW.Spec,
+	}
+	ut, err := maintenance.NewUtilizationReporter(ctx, r.Client, r.Clock).Report(mw)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	_, err = kmc.PatchStatus(ctx, r.Client, clusterMW, func(obj client.Object) client.Object {
+		in := obj.(*api.ClusterMaintenanceWindow)
+		in.Status.Utilization = ut
+		return in
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	requeueAfter, err := maintenance.UntilNextTransition(mw, r.Clock.Now())
+	return ctrl.Result{RequeueAfter: requeueAfter}, err
+}
+
+// mapExecutionToWindow returns the ClusterMaintenanceWindow in which the operation of the MaintenanceExecution is created.
+func (r *ClusterMaintenanceWindowReconciler) mapExecutionToWindow(_ context.Context, obj client.Object) []reconcile.Request {
+	me, ok := obj.(*api.MaintenanceExecution)
+	if !ok || me.Spec.MaintenanceWindow == nil || me.Spec.MaintenanceWindow.Kind != api.ResourceKindClusterMaintenanceWindow {
+		return nil
+	}
+	return []reconcile.Request{
+		{
+			NamespacedName: types.NamespacedName{
+				Namespace: me.Spec.MaintenanceWindow.Namespace,
+				Name:      me.Spec.MaintenanceWindow.Name,
+			},
+		},
+	}
 }
 
 // SetupWithManager sets up the controller with the Manager.
-func (r *ClusterMaintenanceWindowReconciler) SetupWithManager(mgr ctrl.Manager) error {
+func (r *ClusterMaintenanceWindowReconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&api.ClusterMaintenanceWindow{}).
-		Complete(r)
+		Watches(&api.MaintenanceExecution{}, handler.EnqueueRequestsFromMapFunc(r.mapExecutionToWindow)).
+		WithOptions(opts).
+		Complete(r.Shard.Reconciler(r))
 }
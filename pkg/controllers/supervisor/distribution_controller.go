@@ -0,0 +1,244 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supervisor
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/ocm"
+	"kubeops.dev/supervisor/pkg/sharding"
+
+	"github.com/jonboulle/clockwork"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	kmc "kmodules.xyz/client-go/client"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// annotations which are managed by the operator of each cluster and not distributed
+var localAnnotations = []string{
+	api.CreatedByKey,
+	api.ExecuteNowRequestedByKey,
+	api.TraceParentKey,
+	api.TraceIDKey,
+	api.DefaultMaintenanceWindowKey,
+	"kubectl.kubernetes.io/last-applied-configuration",
+}
+
+//+kubebuilder:rbac:groups=work.open-cluster-management.io,resources=manifestworks,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=cluster.open-cluster-management.io,resources=managedclusters,verbs=get;list;watch
+
+// RecommendationDistributionReconciler distributes the Recommendations of the Open Cluster Management hub
+// to the managed clusters and aggregates their status in the managed clusters back into the hub.
+type RecommendationDistributionReconciler struct {
+	client.Client
+	Scheme               *runtime.Scheme
+	RequeueAfterDuration time.Duration
+	Clock                clockwork.Clock
+	// Shard is the subset of namespaces reconciled by this replica.
+	Shard sharding.Shard
+}
+
+func (r *RecommendationDistributionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	key := req.NamespacedName
+	distributor := ocm.NewDistributor(ctx, r.Client, r.Clock)
+
+	rcmd := &api.Recommendation{}
+	if err := r.Client.Get(ctx, key, rcmd); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, err
+		}
+		klog.Infof("Recommendation %q doesn't exist anymore, deleting its ManifestWorks", key.String())
+		return ctrl.Result{}, distributor.Cleanup(api.ResourceKindRecommendation, key.Namespace, key.Name)
+	}
+	if rcmd.Spec.Distribution == nil {
+		return ctrl.Result{}, distributor.Cleanup(api.ResourceKindRecommendation, key.Namespace, key.Name)
+	}
+
+	statuses, halted, wait, err := distributor.Distribute(rcmd, rcmd.Spec.Distribution, recommendationManifest(rcmd), rcmd.Status.Clusters)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.Clusters = statuses
+		in.Status.Phase = aggregateClusterPhase(statuses)
+		in.Status.Reason = ""
+		if halted {
+			in.Status.Reason = api.RolloutHalted
+		} else if wait > 0 {
+			in.Status.Reason = api.WaitingForRolloutWave
+		}
+		in.Status.ObservedGeneration = in.Generation
+		return in
+	})
+	if err != nil || wait == 0 {
+		return ctrl.Result{}, err
+	}
+	if r.RequeueAfterDuration > 0 && r.RequeueAfterDuration < wait {
+		wait = r.RequeueAfterDuration
+	}
+	return ctrl.Result{RequeueAfter: wait}, nil
+}
+
+// recommendationManifest returns the Recommendation to apply in the managed clusters.
+// The approval decision of the hub is passed through the HubApprovalKey annotation.
+func recommendationManifest(rcmd *api.Recommendation) *api.Recommendation {
+	m := &api.Recommendation{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: api.GroupVersion.String(),
+			Kind:       api.ResourceKindRecommendation,
+		},
+		ObjectMeta: distributedObjectMeta(rcmd),
+		Spec:       *rcmd.Spec.DeepCopy(),
+	}
+	m.Spec.Distribution = nil
+	m.Spec.GroupRef = nil
+	if rcmd.Status.ApprovalStatus == api.ApprovalApproved || rcmd.Status.ApprovalStatus == api.ApprovalRejected {
+		if m.Annotations == nil {
+			m.Annotations = map[string]string{}
+		}
+		m.Annotations[api.HubApprovalKey] = string(rcmd.Status.ApprovalStatus)
+	}
+	return m
+}
+
+// aggregateClusterPhase aggregates the phases of the distributed Recommendation in the managed clusters
+// the same way as the phases of the members of a RecommendationGroup.
+func aggregateClusterPhase(statuses []api.ClusterStatus) api.RecommendationPhase {
+	members := make([]api.Recommendation, 0, len(statuses))
+	for _, s := range statuses {
+		members = append(members, api.Recommendation{Status: api.RecommendationStatus{Phase: s.Phase}})
+	}
+	return aggregatePhase(members)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RecommendationDistributionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	mw := &unstructured.Unstructured{}
+	mw.SetGroupVersionKind(ocm.ManifestWorkGVK)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("recommendation-distribution").
+		For(&api.Recommendation{}).
+		Watches(mw, handler.EnqueueRequestsFromMapFunc(mapManifestWorkTo(api.ResourceKindRecommendation))).
+		Complete(r.Shard.Reconciler(r))
+}
+
+// MaintenanceWindowDistributionReconciler distributes the MaintenanceWindows of the Open Cluster Management hub
+// to the managed clusters and reports back whether they are applied.
+type MaintenanceWindowDistributionReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Clock  clockwork.Clock
+	// Shard is the subset of namespaces reconciled by this replica.
+	Shard sharding.Shard
+}
+
+func (r *MaintenanceWindowDistributionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	key := req.NamespacedName
+	distributor := ocm.NewDistributor(ctx, r.Client, r.Clock)
+
+	mw := &api.MaintenanceWindow{}
+	if err := r.Client.Get(ctx, key, mw); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, err
+		}
+		klog.Infof("MaintenanceWindow %q doesn't exist anymore, deleting its ManifestWorks", key.String())
+		return ctrl.Result{}, distributor.Cleanup(api.ResourceKindMaintenanceWindow, key.Namespace, key.Name)
+	}
+	if mw.Spec.Distribution == nil {
+		return ctrl.Result{}, distributor.Cleanup(api.ResourceKindMaintenanceWindow, key.Namespace, key.Name)
+	}
+
+	manifest := &api.MaintenanceWindow{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: api.GroupVersion.String(),
+			Kind:       api.ResourceKindMaintenanceWindow,
+		},
+		ObjectMeta: distributedObjectMeta(mw),
+		Spec:       *mw.Spec.DeepCopy(),
+	}
+	manifest.Spec.Distribution = nil
+
+	dist := mw.Spec.Distribution.DeepCopy()
+	dist.Rollout = nil
+	statuses, _, _, err := distributor.Distribute(mw, dist, manifest, mw.Status.Clusters)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	_, err = kmc.PatchStatus(ctx, r.Client, mw, func(obj client.Object) client.Object {
+		in := obj.(*api.MaintenanceWindow)
+		in.Status.Clusters = statuses
+		return in
+	})
+	return ctrl.Result{}, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *MaintenanceWindowDistributionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	mw := &unstructured.Unstructured{}
+	mw.SetGroupVersionKind(ocm.ManifestWorkGVK)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("maintenancewindow-distribution").
+		For(&api.MaintenanceWindow{}).
+		Watches(mw, handler.EnqueueRequestsFromMapFunc(mapManifestWorkTo(api.ResourceKindMaintenanceWindow))).
+		Complete(r.Shard.Reconciler(r))
+}
+
+func distributedObjectMeta(obj client.Object) metav1.ObjectMeta {
+	meta := metav1.ObjectMeta{
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+		Labels:    obj.GetLabels(),
+	}
+	for k, v := range obj.GetAnnotations() {
+		if !slices.Contains(localAnnotations, k) {
+			if meta.Annotations == nil {
+				meta.Annotations = map[string]string{}
+			}
+			meta.Annotations[k] = v
+		}
+	}
+	return meta
+}
+
+// mapManifestWorkTo maps the ManifestWorks to the distributed objects of the given kind.
+func mapManifestWorkTo(kind string) handler.MapFunc {
+	return func(_ context.Context, obj client.Object) []reconcile.Request {
+		labels := obj.GetLabels()
+		if labels[ocm.DistributedKindKey] != kind {
+			return nil
+		}
+		return []reconcile.Request{
+			{
+				NamespacedName: types.NamespacedName{
+					Namespace: labels[ocm.DistributedNamespaceKey],
+					Name:      labels[ocm.DistributedNameKey],
+				},
+			},
+		}
+	}
+}
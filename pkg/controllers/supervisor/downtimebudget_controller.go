@@ -0,0 +1,115 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supervisor
+
+import (
+	"context"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/execution"
+	"kubeops.dev/supervisor/pkg/sharding"
+
+	"github.com/jonboulle/clockwork"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	kmc "kmodules.xyz/client-go/client"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DowntimeBudgetReconciler reconciles a DowntimeBudget object
+type DowntimeBudgetReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Clock  clockwork.Clock
+	// Shard is the subset of namespaces reconciled by this replica.
+	Shard sharding.Shard
+}
+
+//+kubebuilder:rbac:groups=supervisor.appscode.com,resources=downtimebudgets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=supervisor.appscode.com,resources=downtimebudgets/status,verbs=get;update;patch
+
+// Reconcile sums the disruption of the executions of the current period into the DowntimeBudget status.
+func (r *DowntimeBudgetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	key := req.NamespacedName
+	klog.Info("got event for DowntimeBudget: ", key.String())
+
+	db := &api.DowntimeBudget{}
+	if err := r.Client.Get(ctx, key, db); err != nil {
+		klog.Infof("DowntimeBudget %q doesn't exist anymore", key.String())
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	execList := &api.MaintenanceExecutionList{}
+	if err := r.Client.List(ctx, execList, client.InNamespace(db.Namespace)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	now := r.Clock.Now()
+	usage := execution.Usage(db, execList.Items, now)
+	_, err := kmc.PatchStatus(ctx, r.Client, db, func(obj client.Object) client.Object {
+		in := obj.(*api.DowntimeBudget)
+		in.Status.PeriodStart = &metav1.Time{Time: usage.PeriodStart}
+		in.Status.PeriodEnd = &metav1.Time{Time: usage.PeriodEnd}
+		in.Status.Executions = usage.Executions
+		in.Status.UsedMinutes = usage.UsedMinutes()
+		in.Status.RemainingMinutes = usage.RemainingMinutes()
+		in.Status.ObservedGeneration = in.Generation
+		return in
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// requeue to reset the budget at the start of the next period
+	return ctrl.Result{RequeueAfter: usage.PeriodEnd.Sub(now) + time.Second}, nil
+}
+
+// mapExecutionToBudgets enqueues the DowntimeBudgets of the namespace covering the target of the MaintenanceExecution.
+func (r *DowntimeBudgetReconciler) mapExecutionToBudgets(ctx context.Context, obj client.Object) []reconcile.Request {
+	me, ok := obj.(*api.MaintenanceExecution)
+	if !ok {
+		return nil
+	}
+	budgetList := &api.DowntimeBudgetList{}
+	if err := r.Client.List(ctx, budgetList, client.InNamespace(me.Namespace)); err != nil {
+		klog.Errorf("failed to list DowntimeBudgets of namespace %s: %v", me.Namespace, err)
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, db := range budgetList.Items {
+		if execution.IsCoveredByBudget(&db, me.Spec.Target) {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: db.Namespace, Name: db.Name},
+			})
+		}
+	}
+	return reqs
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DowntimeBudgetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&api.DowntimeBudget{}).
+		Watches(&api.MaintenanceExecution{}, handler.EnqueueRequestsFromMapFunc(r.mapExecutionToBudgets)).
+		Complete(r.Shard.Reconciler(r))
+}
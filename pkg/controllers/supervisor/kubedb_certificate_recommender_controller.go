@@ -0,0 +1,107 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supervisor
+
+import (
+	"context"
+	"time"
+
+	"kubeops.dev/supervisor/pkg/recommender"
+	"kubeops.dev/supervisor/pkg/sharding"
+
+	"github.com/jonboulle/clockwork"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	kubedbapi "kubedb.dev/apimachinery/apis/kubedb/v1alpha2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+//+kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch
+
+// KubeDBCertificateRecommender watches the cert-manager Certificates of the KubeDB databases,
+// and creates a TLS certificate rotation Recommendation the given duration before a certificate expires.
+type KubeDBCertificateRecommender struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Clock  clockwork.Clock
+	// BeforeExpiry is the duration before the expiry of a certificate when its rotation is recommended.
+	BeforeExpiry time.Duration
+	// Shard is the subset of namespaces reconciled by this replica.
+	Shard sharding.Shard
+}
+
+func (r *KubeDBCertificateRecommender) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	key := req.NamespacedName
+
+	cert := &unstructured.Unstructured{}
+	cert.SetGroupVersionKind(recommender.CertificateGVK)
+	if err := r.Client.Get(ctx, key, cert); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	owner := metav1.GetControllerOf(cert)
+	if owner == nil {
+		return ctrl.Result{}, nil
+	}
+	if gv, err := schema.ParseGroupVersion(owner.APIVersion); err != nil || gv.Group != kubedbapi.SchemeGroupVersion.Group {
+		return ctrl.Result{}, nil
+	}
+	expiry, ok := recommender.CertificateExpiry(cert)
+	if !ok {
+		return ctrl.Result{}, nil
+	}
+	if remaining := expiry.Add(-r.BeforeExpiry).Sub(r.Clock.Now()); remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	db := &unstructured.Unstructured{}
+	db.SetAPIVersion(owner.APIVersion)
+	db.SetKind(owner.Kind)
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: cert.GetNamespace(), Name: owner.Name}, db); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if db.GetDeletionTimestamp() != nil {
+		return ctrl.Result{}, nil
+	}
+	pending, err := hasPendingRecommendation(ctx, r.Client, db, recommender.KubeDBCertificateRecommenderName)
+	if err != nil || pending {
+		return ctrl.Result{}, err
+	}
+
+	rcmd, err := recommender.NewCertificateRecommendation(db, cert.GetName(), expiry)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err = r.Client.Create(ctx, rcmd); err != nil {
+		return ctrl.Result{}, client.IgnoreAlreadyExists(err)
+	}
+	klog.Infof("created Recommendation %s/%s to rotate the certificates of %s %s/%s", rcmd.Namespace, rcmd.Name, db.GetKind(), db.GetNamespace(), db.GetName())
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KubeDBCertificateRecommender) SetupWithManager(mgr ctrl.Manager) error {
+	cert := &unstructured.Unstructured{}
+	cert.SetGroupVersionKind(recommender.CertificateGVK)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("kubedb-certificate-recommender").
+		For(cert).
+		Complete(r.Shard.Reconciler(r))
+}
@@ -0,0 +1,145 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supervisor
+
+import (
+	"context"
+	"strings"
+
+	"kubeops.dev/supervisor/pkg/recommender"
+	"kubeops.dev/supervisor/pkg/sharding"
+
+	"github.com/jonboulle/clockwork"
+	apps "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	meta_util "kmodules.xyz/client-go/meta"
+	kubedbapi "kubedb.dev/apimachinery/apis/kubedb/v1alpha2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+//+kubebuilder:rbac:groups=aquasecurity.github.io,resources=vulnerabilityreports,verbs=get;list;watch
+
+// KubeDBCVERecommender watches the Trivy VulnerabilityReports of the KubeDB database workloads,
+// and creates a Critical version upgrade Recommendation when the image of a database has critical CVEs.
+type KubeDBCVERecommender struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Clock  clockwork.Clock
+	// Shard is the subset of namespaces reconciled by this replica.
+	Shard sharding.Shard
+}
+
+func (r *KubeDBCVERecommender) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	key := req.NamespacedName
+
+	report := &unstructured.Unstructured{}
+	report.SetGroupVersionKind(recommender.VulnerabilityReportGVK)
+	if err := r.Client.Get(ctx, key, report); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	vulnerabilities := recommender.CriticalVulnerabilities(report)
+	if len(vulnerabilities) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	db, err := r.getDatabase(ctx, report)
+	if err != nil || db == nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	name, _, _ := unstructured.NestedString(db.Object, "spec", "version")
+	if name == "" {
+		return ctrl.Result{}, nil
+	}
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(recommender.CatalogVersionGVK(db.GetKind()))
+	if err = r.Client.Get(ctx, client.ObjectKey{Name: name}, current); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	pending, err := hasPendingRecommendation(ctx, r.Client, db, recommender.KubeDBVersionRecommenderName, recommender.KubeDBCVERecommenderName)
+	if err != nil || pending {
+		return ctrl.Result{}, err
+	}
+	versions, err := listCatalogVersions(ctx, r.Client, db.GetKind())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	target, err := recommender.UpgradeTarget(current, versions, r.Clock.Now())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if target == "" {
+		klog.Infof("no upgrade target found for %s %s/%s running version %s with critical vulnerabilities", db.GetKind(), db.GetNamespace(), db.GetName(), name)
+		return ctrl.Result{}, nil
+	}
+
+	rcmd, err := recommender.NewCVERecommendation(db, name, target, vulnerabilities)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err = r.Client.Create(ctx, rcmd); err != nil {
+		return ctrl.Result{}, client.IgnoreAlreadyExists(err)
+	}
+	klog.Infof("created Recommendation %s/%s to fix the critical vulnerabilities of %s %s/%s", rcmd.Namespace, rcmd.Name, db.GetKind(), db.GetNamespace(), db.GetName())
+	return ctrl.Result{}, nil
+}
+
+// getDatabase returns the KubeDB database managing the StatefulSet scanned by the VulnerabilityReport.
+// nil is returned if the scanned workload is not managed by KubeDB.
+func (r *KubeDBCVERecommender) getDatabase(ctx context.Context, report *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	labels := report.GetLabels()
+	if labels[recommender.TrivyResourceKindKey] != "StatefulSet" {
+		return nil, nil
+	}
+	ns := labels[recommender.TrivyResourceNamespaceKey]
+	if ns == "" {
+		ns = report.GetNamespace()
+	}
+	sts := &apps.StatefulSet{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: ns, Name: labels[recommender.TrivyResourceNameKey]}, sts); err != nil {
+		return nil, err
+	}
+	if sts.Labels[meta_util.ManagedByLabelKey] != kubedbapi.SchemeGroupVersion.Group {
+		return nil, nil
+	}
+	// the name label of the KubeDB workloads holds the resource of the database, e.g. `mongodbs.kubedb.com`
+	resource := strings.TrimSuffix(sts.Labels[meta_util.NameLabelKey], "."+kubedbapi.SchemeGroupVersion.Group)
+	gvk, err := r.Client.RESTMapper().KindFor(kubedbapi.SchemeGroupVersion.WithResource(resource))
+	if err != nil {
+		return nil, err
+	}
+
+	db := &unstructured.Unstructured{}
+	db.SetGroupVersionKind(gvk)
+	if err = r.Client.Get(ctx, client.ObjectKey{Namespace: ns, Name: sts.Labels[meta_util.InstanceLabelKey]}, db); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KubeDBCVERecommender) SetupWithManager(mgr ctrl.Manager) error {
+	report := &unstructured.Unstructured{}
+	report.SetGroupVersionKind(recommender.VulnerabilityReportGVK)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("kubedb-cve-recommender").
+		For(report).
+		Complete(r.Shard.Reconciler(r))
+}
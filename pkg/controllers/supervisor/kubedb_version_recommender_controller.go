@@ -0,0 +1,176 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supervisor
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/recommender"
+	"kubeops.dev/supervisor/pkg/sharding"
+
+	"github.com/jonboulle/clockwork"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+//+kubebuilder:rbac:groups=kubedb.com,resources=*,verbs=get;list;watch
+//+kubebuilder:rbac:groups=catalog.kubedb.com,resources=*,verbs=get;list;watch
+
+// KubeDBVersionRecommender watches the KubeDB databases of a kind and their catalog versions,
+// and creates a version upgrade Recommendation when the running version of a database becomes deprecated or EOL.
+type KubeDBVersionRecommender struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Clock  clockwork.Clock
+	// Kind is the kind of the KubeDB databases, e.g. `MongoDB`.
+	Kind string
+	// Shard is the subset of namespaces reconciled by this replica.
+	Shard sharding.Shard
+}
+
+func (r *KubeDBVersionRecommender) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	key := req.NamespacedName
+
+	db := &unstructured.Unstructured{}
+	db.SetGroupVersionKind(recommender.DatabaseGVK(r.Kind))
+	if err := r.Client.Get(ctx, key, db); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if db.GetDeletionTimestamp() != nil {
+		return ctrl.Result{}, nil
+	}
+	name, _, _ := unstructured.NestedString(db.Object, "spec", "version")
+	if name == "" {
+		return ctrl.Result{}, nil
+	}
+
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(recommender.CatalogVersionGVK(r.Kind))
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: name}, current); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	now := r.Clock.Now()
+	retired, eol := recommender.IsRetired(current, now)
+	if !retired {
+		return ctrl.Result{RequeueAfter: recommender.TimeUntilEndOfLife(current, now)}, nil
+	}
+
+	pending, err := hasPendingRecommendation(ctx, r.Client, db, recommender.KubeDBVersionRecommenderName, recommender.KubeDBCVERecommenderName)
+	if err != nil || pending {
+		return ctrl.Result{}, err
+	}
+
+	versions, err := listCatalogVersions(ctx, r.Client, r.Kind)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	target, err := recommender.UpgradeTarget(current, versions, now)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if target == "" {
+		klog.Infof("no upgrade target found for %s %s running version %s", r.Kind, key.String(), name)
+		return ctrl.Result{}, nil
+	}
+
+	rcmd, err := recommender.NewUpgradeRecommendation(db, name, target, eol)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err = r.Client.Create(ctx, rcmd); err != nil {
+		return ctrl.Result{}, client.IgnoreAlreadyExists(err)
+	}
+	klog.Infof("created Recommendation %s/%s to update the version of %s %s to %s", rcmd.Namespace, rcmd.Name, r.Kind, key.String(), target)
+	return ctrl.Result{}, nil
+}
+
+// hasPendingRecommendation returns true if a Recommendation of the database by any of the given recommenders
+// is not completed yet, so that the same change is not recommended again while another one is under review or execution.
+func hasPendingRecommendation(ctx context.Context, kc client.Client, db *unstructured.Unstructured, recommenders ...string) (bool, error) {
+	rcmdList := &api.RecommendationList{}
+	key := api.TargetIndexKey(db.GroupVersionKind().Group, db.GetKind(), db.GetName())
+	if err := kc.List(ctx, rcmdList, client.InNamespace(db.GetNamespace()), client.MatchingFields{api.RecommendationTargetIndex: key}); err != nil {
+		return false, err
+	}
+	for _, rc := range rcmdList.Items {
+		if !slices.Contains(recommenders, rc.Spec.Recommender.Name) {
+			continue
+		}
+		if rc.Spec.Target.Kind != db.GetKind() || rc.Spec.Target.Name != db.GetName() {
+			continue
+		}
+		switch rc.Status.Phase {
+		case api.Succeeded, api.Failed, api.Skipped:
+		default:
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// listCatalogVersions returns the catalog versions of the given database kind.
+func listCatalogVersions(ctx context.Context, kc client.Client, kind string) ([]unstructured.Unstructured, error) {
+	gvk := recommender.CatalogVersionGVK(kind)
+	gvk.Kind += "List"
+	versions := &unstructured.UnstructuredList{}
+	versions.SetGroupVersionKind(gvk)
+	if err := kc.List(ctx, versions); err != nil {
+		return nil, err
+	}
+	return versions.Items, nil
+}
+
+// mapVersionToDatabases enqueues the databases running the catalog version.
+func (r *KubeDBVersionRecommender) mapVersionToDatabases(ctx context.Context, obj client.Object) []reconcile.Request {
+	dbList := &unstructured.UnstructuredList{}
+	dbList.SetGroupVersionKind(recommender.DatabaseGVK(r.Kind + "List"))
+	if err := r.Client.List(ctx, dbList); err != nil {
+		klog.Errorf("failed to list %s: %v", r.Kind, err)
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, db := range dbList.Items {
+		if version, _, _ := unstructured.NestedString(db.Object, "spec", "version"); version == obj.GetName() {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: db.GetNamespace(), Name: db.GetName()},
+			})
+		}
+	}
+	return reqs
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KubeDBVersionRecommender) SetupWithManager(mgr ctrl.Manager) error {
+	db := &unstructured.Unstructured{}
+	db.SetGroupVersionKind(recommender.DatabaseGVK(r.Kind))
+	version := &unstructured.Unstructured{}
+	version.SetGroupVersionKind(recommender.CatalogVersionGVK(r.Kind))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("kubedb-version-recommender-"+strings.ToLower(r.Kind)).
+		For(db).
+		Watches(version, handler.EnqueueRequestsFromMapFunc(r.mapVersionToDatabases)).
+		Complete(r.Shard.Reconciler(r))
+}
@@ -19,37 +19,70 @@ package supervisor
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/argocd"
+	"kubeops.dev/supervisor/pkg/backup"
+	"kubeops.dev/supervisor/pkg/changerequest"
 	deadline_manager "kubeops.dev/supervisor/pkg/deadline-manager"
-	"kubeops.dev/supervisor/pkg/evaluator"
+	"kubeops.dev/supervisor/pkg/dependency"
+	"kubeops.dev/supervisor/pkg/execution"
+	"kubeops.dev/supervisor/pkg/executor"
+	"kubeops.dev/supervisor/pkg/features"
+	"kubeops.dev/supervisor/pkg/flux"
+	"kubeops.dev/supervisor/pkg/group"
+	"kubeops.dev/supervisor/pkg/jobrunner"
 	"kubeops.dev/supervisor/pkg/maintenance"
+	"kubeops.dev/supervisor/pkg/maintenancemode"
+	"kubeops.dev/supervisor/pkg/metrics"
+	"kubeops.dev/supervisor/pkg/notifier"
 	"kubeops.dev/supervisor/pkg/parallelism"
 	"kubeops.dev/supervisor/pkg/policy"
-	"kubeops.dev/supervisor/pkg/shared"
+	"kubeops.dev/supervisor/pkg/precondition"
+	"kubeops.dev/supervisor/pkg/prometheus"
+	"kubeops.dev/supervisor/pkg/recommender"
+	"kubeops.dev/supervisor/pkg/scheduler"
+	"kubeops.dev/supervisor/pkg/sharding"
+	"kubeops.dev/supervisor/pkg/target"
+	"kubeops.dev/supervisor/pkg/tracing"
+	"kubeops.dev/supervisor/pkg/verification"
 
 	"github.com/jonboulle/clockwork"
 	"gomodules.xyz/pointer"
-	"gomodules.xyz/x/crypto/rand"
 	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	kmapi "kmodules.xyz/client-go/api/v1"
 	kmc "kmodules.xyz/client-go/client"
 	cutil "kmodules.xyz/client-go/conditions"
 	meta_util "kmodules.xyz/client-go/meta"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+const preExecutionHookSuffix = "pre-execution"
+
+// executionPersistTimeout is the time allowed to persist the state of an execution started
+// right before the shutdown of the operator.
+const executionPersistTimeout = 10 * time.Second
+
 // RecommendationReconciler reconciles a Recommendation object
 type RecommendationReconciler struct {
 	client.Client
@@ -58,12 +91,86 @@ type RecommendationReconciler struct {
 	RequeueAfterDuration   time.Duration
 	RetryAfterDuration     time.Duration
 	BeforeDeadlineDuration time.Duration
-	Clock                  clockwork.Clock
+	// MaxQueueDuration is the SLA of the Recommendations, unless it is overridden by the ApprovalPolicy.
+	MaxQueueDuration time.Duration
+	// MaxClusterParallelOps and MaxNamespaceParallelOps limit the number of Recommendations
+	// executing at a time in the cluster and in a namespace respectively. Zero(0) means no limit.
+	MaxClusterParallelOps   int
+	MaxNamespaceParallelOps int
+	// Scheduler orders the Recommendations waiting for the cluster limit by the weights of their priority classes.
+	// If it is nil, the namespaces waiting for the cluster limit are served round-robin.
+	Scheduler *scheduler.Scheduler
+	// EnableTargetApprovalAnnotation enables the approval of Recommendations by the annotation of their target objects.
+	EnableTargetApprovalAnnotation bool
+	// ArgoCDNamespace is the namespace of the ArgoCD Applications whose sync windows are respected
+	// before executing the Recommendations of their managed objects. Empty means the sync windows are ignored.
+	ArgoCDNamespace string
+	// EnableFluxSuspension enables suspending the Flux Kustomization or HelmRelease managing the target
+	// during the execution of a Recommendation.
+	EnableFluxSuspension bool
+	// OCMHubUsers are the usernames, e.g. the service account of the Open Cluster Management work agent,
+	// whose distributed Recommendations are Approved or Rejected by the decision of the hub cluster.
+	OCMHubUsers []string
+	// AnnotateMaintenanceMode enables annotating the Services of the target and the Ingresses
+	// routing to them as under maintenance during the execution of a Recommendation.
+	AnnotateMaintenanceMode bool
+	// MaintenanceModeWebhookURL is the url notified when the execution of a Recommendation is started and completed.
+	MaintenanceModeWebhookURL string
+	// Preconditions are the cluster level preconditions that must pass before any execution is started.
+	Preconditions precondition.Preconditions
+	// Prometheus is the client of the Prometheus server the PromQL preconditions are evaluated against, if any.
+	Prometheus *prometheus.Client
+	// Notifications sends the Events of the Recommendations through the Notifiers in the background.
+	Notifications *notifier.Queue
+	// MinDurationSamples is the minimum number of the previous executions the duration of an operation
+	// is estimated from, before the estimate is used to defer the operations which don't fit in the remaining
+	// time of the running window. Zero(0) means the executions are never deferred by the estimates.
+	MinDurationSamples int
+	// TargetDriftAction specifies how the Recommendation is handled if its target is drifted after the approval.
+	TargetDriftAction target.DriftAction
+	// EnableAutoRollback enables creating a Recommendation rolling the target back to its previous version
+	// when the execution of a version upgrade is failed and the target is degraded.
+	EnableAutoRollback bool
+	// EnableExecuteNow enables the execute-now annotation. It must only be enabled when the webhooks
+	// authorize the requesters of the annotation and record their identity.
+	EnableExecuteNow bool
+	// CircuitBreakerThreshold is the number of consecutive failed executions in the CircuitBreakerScope
+	// of a Recommendation after which its execution is paused until the circuit is reset. Zero(0) means
+	// the circuit breaker is disabled.
+	CircuitBreakerThreshold int
+	CircuitBreakerScope     execution.CircuitBreakerScope
+	Clock                   clockwork.Clock
+	Recorder                record.EventRecorder
+	// Shard is the subset of namespaces reconciled by this replica.
+	Shard sharding.Shard
 }
 
 //+kubebuilder:rbac:groups=supervisor.appscode.com,resources=recommendations,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=supervisor.appscode.com,resources=recommendations/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=supervisor.appscode.com,resources=recommendations/finalizers,verbs=update
+//+kubebuilder:rbac:groups=supervisor.appscode.com,resources=recommendations,verbs=approve
+//+kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+//+kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
+//+kubebuilder:rbac:groups=supervisor.appscode.com,resources=clusterapprovalpolicies,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups=supervisor.appscode.com,resources=maintenanceexecutions,verbs=get;list;watch;create;update;patch
+//+kubebuilder:rbac:groups=supervisor.appscode.com,resources=maintenancefreezes,verbs=get;list;watch
+//+kubebuilder:rbac:groups=supervisor.appscode.com,resources=downtimebudgets,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups=supervisor.appscode.com,resources=notifiers,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get
+//+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;patch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=pods/eviction,verbs=create
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=apps,resources=deployments;statefulsets;daemonsets,verbs=get;list;watch;patch
+//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
+//+kubebuilder:rbac:groups=stash.appscode.com;core.kubestash.com,resources=backupsessions,verbs=get;create;delete
+//+kubebuilder:rbac:groups=argoproj.io,resources=applications;appprojects,verbs=get;list;watch
+//+kubebuilder:rbac:groups=kustomize.toolkit.fluxcd.io,resources=kustomizations,verbs=get;list;watch;patch
+//+kubebuilder:rbac:groups=helm.toolkit.fluxcd.io,resources=helmreleases,verbs=get;list;watch;patch
+//+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;patch
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -83,8 +190,32 @@ func (r *RecommendationReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 	obj = obj.DeepCopy()
 
+	if obj.DeletionTimestamp != nil {
+		return r.finalize(ctx, obj)
+	}
+
+	// The distributed Recommendations are executed in the managed clusters.
+	if obj.Spec.Distribution != nil {
+		return ctrl.Result{}, nil
+	}
+
+	ctx, span := tracing.StartRecommendationSpan(ctx, obj)
+	defer span.End()
+	if err := r.setTraceParent(ctx, obj); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Skipped outdated Recommendation
 	if obj.Status.Outdated {
+		if obj.Status.Phase != api.Skipped {
+			r.recordEvent(ctx, obj, core.EventTypeNormal, api.EventReasonSkipped, "Recommendation is outdated")
+		}
+		if err := r.resumeFlux(ctx, obj); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.clearMaintenanceMode(ctx, obj); err != nil {
+			return ctrl.Result{}, err
+		}
 		_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
 			in := obj.(*api.Recommendation)
 			in.Status.ObservedGeneration = in.Generation
@@ -96,8 +227,18 @@ func (r *RecommendationReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
-	// Ignore any update in the recommendation object if the recommendation is already succeeded
-	if obj.Status.Phase == api.Succeeded {
+	// Ignore any update in the recommendation object if the recommendation is already succeeded or terminally failed.
+	// The recommendations exceeding the BackoffLimit are handled below, as the BackoffLimit can be raised to retry them.
+	if obj.Status.Phase == api.Succeeded || (obj.IsTerminallyFailed() && obj.Status.Reason != api.BackoffLimitExceeded) {
+		if err := r.resumeFlux(ctx, obj); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.clearMaintenanceMode(ctx, obj); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.recommendRollback(ctx, obj); err != nil {
+			return ctrl.Result{}, err
+		}
 		_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
 			in := obj.(*api.Recommendation)
 			in.Status.ObservedGeneration = in.Generation
@@ -107,6 +248,22 @@ func (r *RecommendationReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	if obj.Status.FailedAttempt > pointer.Int32(obj.Spec.BackoffLimit) {
+		if obj.Status.Reason != api.BackoffLimitExceeded {
+			r.recordEvent(ctx, obj, core.EventTypeWarning, api.EventReasonExecutionFailed,
+				fmt.Sprintf("Execution is failed %d times, backoff limit is exceeded", obj.Status.FailedAttempt))
+			if err := execution.NewExecutionRecorder(ctx, r.Client, obj, r.Clock).Record(api.Failed, api.BackoffLimitExceeded); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		if err := r.resumeFlux(ctx, obj); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.clearMaintenanceMode(ctx, obj); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.recommendRollback(ctx, obj); err != nil {
+			return ctrl.Result{}, err
+		}
 		_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
 			in := obj.(*api.Recommendation)
 			in.Status.ObservedGeneration = in.Generation
@@ -118,22 +275,222 @@ func (r *RecommendationReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	if obj.Status.Phase == "" {
-		_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+		targetGeneration, err := target.NewRevalidator(ctx, r.Client, obj).CurrentGeneration()
+		if err != nil {
+			klog.Errorf("failed to get the target of Recommendation %s/%s: %v", obj.Namespace, obj.Name, err)
+		}
+		_, err = kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
 			in := obj.(*api.Recommendation)
 			in.Status.Phase = api.Pending
 			in.Status.Reason = api.WaitingForApproval
+			in.Status.ObservedTargetGeneration = targetGeneration
 			return in
 		})
 		if err != nil {
 			return ctrl.Result{}, err
 		}
+		r.recordEvent(ctx, obj, core.EventTypeNormal, api.EventReasonAwaitingApproval, "Recommendation is waiting for approval")
+	}
+
+	if err := r.checkSLA(ctx, obj); err != nil {
+		return ctrl.Result{}, err
 	}
 
 	if obj.Status.ApprovalStatus == api.ApprovalApproved {
+		if obj.Status.Phase == api.InProgress && obj.Status.Reason == api.CreatingOperation {
+			return r.createRemoteOperation(ctx, obj)
+		}
 		if obj.Status.Phase == api.InProgress && obj.Status.CreatedOperationRef != nil {
 			return r.checkOpsRequestStatus(ctx, obj)
 		}
 
+		quorumMet, err := r.isApprovalQuorumMet(ctx, obj)
+		if err != nil {
+			return r.handleErr(ctx, obj, err, api.Pending)
+		}
+		if !quorumMet {
+			_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.ApprovalStatus = api.ApprovalPending
+				in.Status.Phase = api.Pending
+				in.Status.Reason = api.WaitingForApprovals
+				return in
+			})
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+		}
+		if obj.Status.Phase == api.Pending && (obj.Status.Reason == api.WaitingForApproval || obj.Status.Reason == api.WaitingForApprovals) {
+			metrics.RecordApproval(obj, api.ApprovalApproved)
+			r.recordEvent(ctx, obj, core.EventTypeNormal, api.EventReasonApproved, approvalMessage(obj))
+			if err := r.recordApprovedTarget(ctx, obj); err != nil {
+				return ctrl.Result{}, err
+			}
+			if err := r.validateApprovedOperation(ctx, obj); err != nil {
+				return ctrl.Result{}, err
+			}
+		} else if _, cond := cutil.GetCondition(obj.Status.Conditions, api.ValidationFailed); cond != nil && cond.ObservedGeneration != obj.Generation {
+			if err := r.validateApprovedOperation(ctx, obj); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+
+		groupMgr := group.NewGroupManager(ctx, r.Client, obj, r.Clock)
+		allApproved, rejected, err := groupMgr.IsEveryMemberApproved()
+		if err != nil {
+			return r.handleErr(ctx, obj, err, api.Pending)
+		}
+		if rejected {
+			_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Phase = api.Skipped
+				in.Status.Reason = api.GroupMemberRejected
+				in.Status.ObservedGeneration = in.Generation
+				return in
+			})
+			return ctrl.Result{}, err
+		}
+		if !allApproved {
+			_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Phase = api.Waiting
+				in.Status.Reason = api.WaitingForGroupApproval
+				return in
+			})
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+		}
+
+		canaryVerified, halted, wait, err := groupMgr.IsCanaryVerified()
+		if err != nil {
+			return r.handleErr(ctx, obj, err, api.Pending)
+		}
+		if halted {
+			_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Phase = api.Skipped
+				in.Status.Reason = api.CanaryFailed
+				in.Status.ObservedGeneration = in.Generation
+				return in
+			})
+			return ctrl.Result{}, err
+		}
+		if !canaryVerified {
+			_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Phase = api.Waiting
+				in.Status.Reason = api.WaitingForCanaryVerification
+				return in
+			})
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if wait > 0 && wait < r.RequeueAfterDuration {
+				return ctrl.Result{RequeueAfter: wait}, nil
+			}
+			return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+		}
+
+		rolloutAllowed, halted, wait, err := groupMgr.IsRolloutAllowed()
+		if err != nil {
+			return r.handleErr(ctx, obj, err, api.Pending)
+		}
+		if !rolloutAllowed && halted {
+			_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Phase = api.Skipped
+				in.Status.Reason = api.RolloutHalted
+				in.Status.ObservedGeneration = in.Generation
+				return in
+			})
+			return ctrl.Result{}, err
+		}
+		if !rolloutAllowed {
+			_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Phase = api.Waiting
+				in.Status.Reason = api.WaitingForRolloutWave
+				return in
+			})
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if wait > 0 && wait < r.RequeueAfterDuration {
+				return ctrl.Result{RequeueAfter: wait}, nil
+			}
+			return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+		}
+
+		depChecker := dependency.NewDependencyChecker(ctx, r.Client, obj)
+		satisfied, msg, err := depChecker.IsDependencySatisfied()
+		if errors.Is(err, dependency.ErrDependencyCycle) {
+			cycle := err.Error()
+			r.recordEvent(ctx, obj, core.EventTypeWarning, api.DependencyCycleDetected, cycle)
+			_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Phase = api.Skipped
+				in.Status.Reason = api.DependencyCycleDetected
+				in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+					Type:               api.WaitingForDependency,
+					Status:             metav1.ConditionFalse,
+					LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+					Reason:             api.DependencyCycleDetected,
+					Message:            cycle,
+				})
+				in.Status.ObservedGeneration = in.Generation
+				return in
+			})
+			return ctrl.Result{}, err
+		} else if errors.Is(err, dependency.ErrDependencyNotSucceeded) {
+			_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Phase = api.Skipped
+				in.Status.Reason = api.DependencyNotSucceeded
+				in.Status.Conditions = cutil.RemoveCondition(in.Status.Conditions, api.WaitingForDependency)
+				in.Status.ObservedGeneration = in.Generation
+				return in
+			})
+			return ctrl.Result{}, err
+		} else if err != nil {
+			return r.handleErr(ctx, obj, err, api.Pending)
+		}
+		if !satisfied {
+			_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Phase = api.Waiting
+				in.Status.Reason = api.WaitingForDependency
+				in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+					Type:               api.WaitingForDependency,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+					Reason:             api.WaitingForDependency,
+					Message:            msg,
+				})
+				return in
+			})
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+		}
+		if _, cond := cutil.GetCondition(obj.Status.Conditions, api.WaitingForDependency); cond != nil {
+			_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Conditions = cutil.RemoveCondition(in.Status.Conditions, api.WaitingForDependency)
+				return in
+			})
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+
+		if r.isExecuteNowRequested(obj) {
+			return r.runForcedMaintenanceWork(ctx, obj)
+		}
+
 		rcmdMaintenance := maintenance.NewRecommendationMaintenance(ctx, r.Client, obj, r.Clock)
 		isMaintenanceTime, err := rcmdMaintenance.IsMaintenanceTime()
 		if err != nil {
@@ -141,22 +498,36 @@ func (r *RecommendationReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 
 		if !isMaintenanceTime {
-			if obj.Status.Phase == api.Pending {
+			scheduledWindow, err := rcmdMaintenance.NextScheduledWindow()
+			if err != nil {
+				klog.Errorf("failed to get the next scheduled window of Recommendation %s/%s: %v", obj.Namespace, obj.Name, err)
+			}
+			if obj.Status.Phase == api.Pending || !reflect.DeepEqual(obj.Status.ScheduledWindow, scheduledWindow) {
 				_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
 					in := obj.(*api.Recommendation)
-					in.Status.Phase = api.Waiting
-					in.Status.Reason = api.WaitingForMaintenanceWindow
+					if in.Status.Phase == api.Pending {
+						in.Status.Phase = api.Waiting
+						in.Status.Reason = api.WaitingForMaintenanceWindow
+					}
+					in.Status.ScheduledWindow = scheduledWindow
 					return in
 				})
 				if err != nil {
 					return ctrl.Result{}, err
 				}
 			}
-			return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+			return ctrl.Result{RequeueAfter: r.untilWindowOpens(scheduledWindow)}, nil
 		}
 
+		if obj.Status.Phase == api.Waiting && obj.Status.Reason == api.WaitingForMaintenanceWindow {
+			r.recordEvent(ctx, obj, core.EventTypeNormal, api.EventReasonWindowOpened, "MaintenanceWindow is open")
+		}
 		return r.runMaintenanceWork(ctx, obj)
 	} else if obj.Status.ApprovalStatus == api.ApprovalRejected {
+		if obj.Status.Phase != api.Skipped {
+			metrics.RecordApproval(obj, api.ApprovalRejected)
+			r.recordEvent(ctx, obj, core.EventTypeNormal, api.EventReasonRejected, approvalMessage(obj))
+		}
 		_, err := kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
 			in := obj.(*api.Recommendation)
 			in.Status.Phase = api.Skipped
@@ -168,24 +539,82 @@ func (r *RecommendationReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
-	if !obj.Spec.RequireExplicitApproval {
-		policyFinder := policy.NewApprovalPolicyFinder(ctx, r.Client, obj)
-		approvalPolicy, err := policyFinder.FindApprovalPolicy()
+	policyFinder := policy.NewApprovalPolicyFinder(ctx, r.Client, obj)
+	approvalPolicy, err := policyFinder.FindActiveApprovalPolicy(r.Clock.Now())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if approvalPolicy != nil && approvalPolicy.Effect == api.DenyEffect {
+		_, err = kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.ApprovalStatus = api.ApprovalRejected
+			in.Status.RejectionReason = api.RejectionReasonPolicyDenied
+			in.Status.Comments = fmt.Sprintf("Denied by ApprovalPolicy %s", approvalPolicy.Name)
+			if approvalPolicy.DenyReason != "" {
+				in.Status.Comments = fmt.Sprintf("%s: %s", in.Status.Comments, approvalPolicy.DenyReason)
+			}
+			in.Status.ReviewTimestamp = &metav1.Time{Time: r.Clock.Now().UTC()}
+			return in
+		})
 		if err != nil {
 			return ctrl.Result{}, err
 		}
-		if approvalPolicy != nil {
-			_, err = kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
-				in := obj.(*api.Recommendation)
-				in.Status.ApprovalStatus = api.ApprovalApproved
-				in.Status.ApprovedWindow = &api.ApprovedWindow{
-					MaintenanceWindow: &approvalPolicy.MaintenanceWindowRef,
-				}
-				return in
-			})
-			if err != nil {
-				return ctrl.Result{}, err
+	} else if approvalPolicy != nil && !approvalPolicy.IsQuorumRequired() &&
+		approvalPolicy.IsSeverityAllowed(obj.Spec.Severity) && approvalPolicy.IsImpactAllowed(obj.Spec.Impact) && !obj.Spec.RequireExplicitApproval {
+		_, err = kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.ApprovalStatus = api.ApprovalApproved
+			in.Status.ApprovedWindow = &api.ApprovedWindow{
+				MaintenanceWindow: &approvalPolicy.MaintenanceWindowRef,
+			}
+			return in
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else if approved, err := r.isApprovedByTargetAnnotation(ctx, obj); err != nil {
+		return ctrl.Result{}, err
+	} else if approved {
+		_, err = kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.ApprovalStatus = api.ApprovalApproved
+			in.Status.Comments = fmt.Sprintf("Approved by the %s annotation of the target", api.ApproveAllKey)
+			return in
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else if hubApproval := r.hubApproval(obj); hubApproval != "" {
+		_, err = kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.ApprovalStatus = hubApproval
+			if hubApproval == api.ApprovalRejected {
+				in.Status.RejectionReason = api.RejectionReasonOther
 			}
+			in.Status.Comments = fmt.Sprintf("%s in the hub cluster", hubApproval)
+			in.Status.ReviewTimestamp = &metav1.Time{Time: r.Clock.Now().UTC()}
+			return in
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else if r.isStale(obj, approvalPolicy) {
+		_, err = kmc.PatchStatus(ctx, r.Client, obj, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.ApprovalStatus = api.ApprovalRejected
+			in.Status.RejectionReason = api.RejectionReasonStale
+			in.Status.Comments = fmt.Sprintf("Pending for more than %s", approvalPolicy.AutoRejectAfter.Duration)
+			in.Status.ReviewTimestamp = &metav1.Time{Time: r.Clock.Now().UTC()}
+			return in
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		r.recordEvent(ctx, obj, core.EventTypeWarning, api.EventReasonExpired,
+			fmt.Sprintf("Recommendation is pending for approval for more than %s", approvalPolicy.AutoRejectAfter.Duration))
+	} else if approvalPolicy != nil && approvalPolicy.ServiceNow != nil {
+		if err = r.syncChangeRequest(ctx, obj, approvalPolicy); err != nil {
+			return ctrl.Result{}, err
 		}
 	}
 
@@ -193,46 +622,86 @@ func (r *RecommendationReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 }
 
 func (r *RecommendationReconciler) checkOpsRequestStatus(ctx context.Context, rcmd *api.Recommendation) (ctrl.Result, error) {
-	gvk, err := shared.GetGVK(rcmd.Spec.Operation)
-	if err != nil {
-		return ctrl.Result{RequeueAfter: r.RetryAfterDuration}, err
-	}
-	obj := &unstructured.Unstructured{}
-	obj.SetGroupVersionKind(gvk)
-
-	key := client.ObjectKey{Name: rcmd.Status.CreatedOperationRef.Name, Namespace: rcmd.Namespace}
-	err = r.Client.Get(ctx, key, obj)
+	exec, err := executor.New(ctx, r.Client, rcmd)
 	if err != nil {
 		return ctrl.Result{RequeueAfter: r.RetryAfterDuration}, err
 	}
-
-	eval := evaluator.New(obj, rcmd.Spec.Rules)
-	success, err := eval.EvaluateSuccessfulOperation()
+	start := time.Now()
+	success, err := exec.CheckStatus(rcmd.Status.CreatedOperationRef.Name)
+	metrics.ObserveExecutor(rcmd, metrics.ExecutorCheckStatus, start)
 	if err != nil {
 		return ctrl.Result{RequeueAfter: r.RetryAfterDuration}, err
 	}
 
 	if success == nil {
+		if r.isActiveDeadlineExceeded(rcmd) {
+			return r.markStalled(ctx, rcmd, exec)
+		}
+		passed, msg, err := precondition.NewGateChecker(ctx, r.Client, rcmd, r.Prometheus, r.Clock).Check()
+		if err != nil {
+			return r.handleErr(ctx, rcmd, err, api.InProgress)
+		}
+		if !passed {
+			return r.abortOnBreachedGate(ctx, rcmd, exec, msg)
+		}
 		return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
 	}
 
 	if pointer.Bool(success) {
+		verified, output, err := verification.NewVerifier(ctx, r.Client, rcmd).Verify()
+		if err != nil {
+			return r.handleErr(ctx, rcmd, err, api.InProgress)
+		}
+		if verified == nil {
+			_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Reason = api.VerifyingOperation
+				return in
+			})
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+		}
+		if !pointer.Bool(verified) {
+			return r.recordFailedVerification(ctx, rcmd, output)
+		}
+
+		// The execution is recorded before the terminal phase is set, as the terminal Recommendations are never
+		// reconciled again to retry a failed record.
+		if err := execution.NewExecutionRecorder(ctx, r.Client, rcmd, r.Clock).Record(api.Succeeded, api.SuccessfullyExecutedOperation); err != nil {
+			return ctrl.Result{}, err
+		}
+
 		_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
 			in := obj.(*api.Recommendation)
 			in.Status.Phase = api.Succeeded
+			in.Status.VerificationOutput = output
 			in.Status.Reason = api.SuccessfullyExecutedOperation
 			in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
 				Type:               api.SuccessfullyExecutedOperation,
 				Status:             metav1.ConditionTrue,
-				LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+				LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
 				Reason:             api.SuccessfullyExecutedOperation,
 				Message:            "OpsRequest is successfully executed",
 			})
 			in.Status.ObservedGeneration = in.Generation
 			return in
 		})
-		return ctrl.Result{}, err
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		metrics.RecordExecution(rcmd, metrics.ExecutionSucceeded, r.Clock.Now())
+		if _, cond := cutil.GetCondition(rcmd.Status.Conditions, api.SuccessfullyCreatedOperation); cond != nil {
+			metrics.ObserveExecutionDuration(rcmd, r.Clock.Since(cond.LastTransitionTime.Time))
+		}
+		r.recordEvent(ctx, rcmd, core.EventTypeNormal, api.EventReasonExecutionSucceeded,
+			fmt.Sprintf("Operation %s is successfully executed", rcmd.Status.CreatedOperationRef.Name))
+		return ctrl.Result{}, nil
 	} else {
+		if err := executor.Release(exec, rcmd.Status.CreatedOperationRef.Name); err != nil {
+			return ctrl.Result{}, err
+		}
 		return r.recordFailedAttempt(ctx, rcmd, errors.New("operation has been failed"))
 	}
 }
@@ -241,59 +710,1286 @@ func (r *RecommendationReconciler) runMaintenanceWork(ctx context.Context, rcmd
 	r.Mutex.Lock()
 	defer r.Mutex.Unlock()
 
-	runner := parallelism.NewParallelRunner(ctx, r.Client, rcmd)
-	maintainParallelism, err := runner.MaintainParallelism()
+	freeze, err := maintenance.NewFreezeChecker(ctx, r.Client, rcmd, r.Clock).ActiveFreeze()
 	if err != nil {
-		return ctrl.Result{}, err
+		return r.handleErr(ctx, rcmd, err, api.Waiting)
 	}
-
-	deadlineMgr := deadline_manager.NewManager(rcmd, r.Clock)
-	deadlineKnocking := deadlineMgr.IsDeadlineLessThan(r.BeforeDeadlineDuration)
-
-	if !(maintainParallelism || deadlineKnocking) {
+	if freeze != nil {
+		msg := fmt.Sprintf("Execution is held by MaintenanceFreeze %s until %s", freeze.Name, freeze.Spec.End.UTC().Format(time.RFC3339))
+		if freeze.Spec.Reason != "" {
+			msg += ": " + freeze.Spec.Reason
+		}
 		_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
 			in := obj.(*api.Recommendation)
 			in.Status.Phase = api.Waiting
-			in.Status.Reason = api.WaitingForExecution
+			in.Status.Reason = api.MaintenanceFrozen
+			in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+				Type:               api.MaintenanceFrozen,
+				Status:             metav1.ConditionTrue,
+				LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+				Reason:             api.MaintenanceFrozen,
+				Message:            msg,
+			})
 			return in
 		})
 		if err != nil {
 			return ctrl.Result{}, err
 		}
-		return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+		return ctrl.Result{RequeueAfter: freeze.Spec.End.Sub(r.Clock.Now()) + time.Second}, nil
 	}
-
-	// Creating OpsRequest from given raw object
-	opsReqName := rand.WithUniqSuffix("supervisor")
-	unObj, err := shared.GetUnstructuredObj(rcmd.Spec.Operation)
-	if err != nil {
-		return r.handleErr(ctx, rcmd, err, api.Failed)
+	if _, cond := cutil.GetCondition(rcmd.Status.Conditions, api.MaintenanceFrozen); cond != nil {
+		_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.Conditions = cutil.RemoveCondition(in.Status.Conditions, api.MaintenanceFrozen)
+			return in
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
 	}
-	unObj.SetName(opsReqName)
 
-	err = r.Client.Create(ctx, unObj)
+	fits, wait, err := r.fitsInWindow(ctx, rcmd)
 	if err != nil {
-		return r.handleErr(ctx, rcmd, err, api.Failed)
+		return r.handleErr(ctx, rcmd, err, api.Waiting)
 	}
-
-	_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
-		in := obj.(*api.Recommendation)
-		in.Status.Phase = api.InProgress
-		in.Status.Reason = api.StartedExecutingOperation
-		in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
-			Type:               api.SuccessfullyCreatedOperation,
-			Status:             metav1.ConditionTrue,
-			LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
-			Reason:             api.SuccessfullyCreatedOperation,
-			Message:            "OpsRequest is successfully created",
+	if !fits {
+		_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.Phase = api.Waiting
+			in.Status.Reason = api.InsufficientWindowTime
+			return in
 		})
-		in.Status.CreatedOperationRef = &core.LocalObjectReference{Name: opsReqName}
-		return in
-	})
-	return ctrl.Result{}, err
-}
-
-func (r *RecommendationReconciler) handleErr(ctx context.Context, rcmd *api.Recommendation, err error, phase api.RecommendationPhase) (ctrl.Result, error) {
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	if !r.isExecuteNowRequested(rcmd) {
+		budget, usage, err := execution.NewBudgetChecker(ctx, r.Client, rcmd, r.Clock).ExceededBudget()
+		if err != nil {
+			return r.handleErr(ctx, rcmd, err, api.Waiting)
+		}
+		if budget != nil {
+			return r.deferForDowntimeBudget(ctx, rcmd, budget, usage)
+		}
+	}
+	if _, cond := cutil.GetCondition(rcmd.Status.Conditions, api.DowntimeBudgetExceeded); cond != nil {
+		_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.Conditions = cutil.RemoveCondition(in.Status.Conditions, api.DowntimeBudgetExceeded)
+			return in
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	runner := parallelism.NewParallelRunner(ctx, r.Client, rcmd)
+	running, err := runner.InProgressForSameTarget()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(running) > 0 {
+		concurrencyPolicy, err := runner.ConcurrencyPolicy()
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		switch concurrencyPolicy {
+		case api.ForbidConcurrent:
+			_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Phase = api.Skipped
+				in.Status.Reason = api.ConcurrentExecutionForbidden
+				in.Status.ObservedGeneration = in.Generation
+				return in
+			})
+			return ctrl.Result{}, err
+		case api.ReplaceConcurrent:
+			for i := range running {
+				if err := r.replaceRecommendation(ctx, &running[i], rcmd); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+		default:
+			_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Phase = api.Waiting
+				in.Status.Reason = api.WaitingForExecution
+				return in
+			})
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+		}
+	}
+
+	withinLimit, err := runner.IsWithinExecutionLimit(r.MaxClusterParallelOps, r.MaxNamespaceParallelOps, r.Scheduler, r.Shard)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !withinLimit {
+		_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.Phase = api.Waiting
+			in.Status.Reason = api.WaitingForExecutionLimit
+			return in
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+	}
+
+	maintainParallelism, err := runner.MaintainParallelism()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	deadlineMgr := deadline_manager.NewManager(rcmd, r.Clock)
+	deadlineKnocking := deadlineMgr.IsDeadlineLessThan(r.BeforeDeadlineDuration)
+
+	if !(maintainParallelism || deadlineKnocking || rcmd.IsCritical()) {
+		_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.Phase = api.Waiting
+			in.Status.Reason = api.WaitingForExecution
+			return in
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+	}
+
+	targetChanged, err := target.NewRevalidator(ctx, r.Client, rcmd).IsTargetChanged()
+	if err != nil {
+		return r.handleErr(ctx, rcmd, err, api.Pending)
+	}
+	if targetChanged {
+		r.recordEvent(ctx, rcmd, core.EventTypeNormal, api.EventReasonSkipped, "Target is changed after the Recommendation is generated")
+		_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.Outdated = true
+			in.Status.Phase = api.Skipped
+			in.Status.Reason = api.RecommendationOutdated
+			in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+				Type:               api.TargetChanged,
+				Status:             metav1.ConditionTrue,
+				LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+				Reason:             api.TargetChanged,
+				Message:            "Target is changed after the Recommendation is generated",
+			})
+			in.Status.ObservedGeneration = in.Generation
+			return in
+		})
+		return ctrl.Result{}, err
+	}
+
+	healthy, err := target.NewHealthChecker(ctx, r.Client, rcmd).IsHealthy()
+	if err != nil {
+		// An unresolvable target is counted as a failed attempt, so that the backoff limit applies
+		// instead of waiting for the target forever.
+		return r.recordFailedAttempt(ctx, rcmd, fmt.Errorf("failed to check the health of the target: %w", err))
+	}
+	if !healthy {
+		_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.Phase = api.Waiting
+			in.Status.Reason = api.TargetNotHealthy
+			in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+				Type:               api.TargetNotHealthy,
+				Status:             metav1.ConditionTrue,
+				LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+				Reason:             api.TargetNotHealthy,
+				Message:            "Target doesn't satisfy the readiness rule",
+			})
+			return in
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+	}
+
+	pdbs, err := target.NewPDBChecker(ctx, r.Client, rcmd).BlockingPDBs()
+	if err != nil {
+		return r.handleErr(ctx, rcmd, err, api.Waiting)
+	}
+	if len(pdbs) > 0 {
+		names := make([]string, 0, len(pdbs))
+		for _, pdb := range pdbs {
+			names = append(names, pdb.Name)
+		}
+		_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.Phase = api.Waiting
+			in.Status.Reason = api.BlockedByPDB
+			in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+				Type:               api.BlockedByPDB,
+				Status:             metav1.ConditionTrue,
+				LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+				Reason:             api.BlockedByPDB,
+				Message:            fmt.Sprintf("PodDisruptionBudgets %s allow no disruption of the target pods", strings.Join(names, ", ")),
+			})
+			return in
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+	}
+
+	if !r.Preconditions.IsEmpty() {
+		passed, msg, err := precondition.NewClusterChecker(ctx, r.Client, rcmd, r.Preconditions, r.Prometheus).Check()
+		if err != nil {
+			return r.handleErr(ctx, rcmd, err, api.Waiting)
+		}
+		if !passed {
+			_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Phase = api.Waiting
+				in.Status.Reason = api.ClusterPreconditionFailed
+				in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+					Type:               api.ClusterPreconditionFailed,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+					Reason:             api.ClusterPreconditionFailed,
+					Message:            msg,
+				})
+				return in
+			})
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+		}
+	}
+
+	passed, msg, err := precondition.NewGateChecker(ctx, r.Client, rcmd, r.Prometheus, r.Clock).Check()
+	if err != nil {
+		return r.handleErr(ctx, rcmd, err, api.Waiting)
+	}
+	if !passed {
+		_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.Phase = api.Waiting
+			in.Status.Reason = api.PrometheusGateBreached
+			in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+				Type:               api.PrometheusGateBreached,
+				Status:             metav1.ConditionTrue,
+				LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+				Reason:             api.PrometheusGateBreached,
+				Message:            msg,
+			})
+			return in
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+	}
+
+	if r.CircuitBreakerThreshold > 0 {
+		open, err := r.isCircuitOpen(ctx, rcmd)
+		if err != nil {
+			return r.handleErr(ctx, rcmd, err, api.Waiting)
+		}
+		if open {
+			return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+		}
+	}
+
+	if r.ArgoCDNamespace != "" {
+		blocked, msg, err := argocd.NewSyncWindowChecker(ctx, r.Client, rcmd, r.ArgoCDNamespace, r.Clock).IsSyncBlocked()
+		if err != nil {
+			return r.handleErr(ctx, rcmd, err, api.Waiting)
+		}
+		if blocked {
+			_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Phase = api.Waiting
+				in.Status.Reason = api.WaitingForSyncWindow
+				in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+					Type:               api.WaitingForSyncWindow,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+					Reason:             api.WaitingForSyncWindow,
+					Message:            msg,
+				})
+				return in
+			})
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+		}
+	}
+
+	// The operation is validated and the drift of the target is checked before any side effect of the execution,
+	// e.g. the pre-execution hook, the Flux suspension or the maintenance mode signal, so that nothing is left
+	// behind when the operation is rejected.
+	// Creating OpsRequest from given raw object. The name is deterministic, so that the operation
+	// created before a restart of the operator is adopted instead of creating a duplicate one.
+	opsReqName := executor.OperationName(rcmd)
+	exec, err := executor.New(ctx, r.Client, rcmd)
+	if err != nil {
+		return r.handleErr(ctx, rcmd, err, api.Failed)
+	}
+	if v, ok := exec.(executor.Validator); ok {
+		start := time.Now()
+		err := v.Validate(opsReqName)
+		metrics.ObserveExecutor(rcmd, metrics.ExecutorValidate, start)
+		if err != nil {
+			return r.recordInvalidOperation(ctx, rcmd, err)
+		}
+	}
+	drifted, err := target.NewRevalidator(ctx, r.Client, rcmd).IsTargetDrifted()
+	if err != nil {
+		return r.handleErr(ctx, rcmd, err, api.Waiting)
+	}
+	if drifted {
+		return r.handleDriftedTarget(ctx, rcmd)
+	}
+
+	if rcmd.Spec.PreExecutionHook != nil {
+		hookRunner := jobrunner.NewJobRunner(ctx, r.Client, rcmd)
+		completed, output, err := hookRunner.Run(preExecutionHookSuffix, &rcmd.Spec.PreExecutionHook.Job)
+		if err != nil {
+			return r.handleErr(ctx, rcmd, err, api.Waiting)
+		}
+		if completed == nil {
+			_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Phase = api.Waiting
+				in.Status.Reason = api.RunningPreExecutionHook
+				return in
+			})
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+		}
+		if err := hookRunner.Cleanup(preExecutionHookSuffix); err != nil {
+			return ctrl.Result{}, err
+		}
+		if !pointer.Bool(completed) {
+			return r.recordFailedAttempt(ctx, rcmd, fmt.Errorf("pre-execution hook is failed: %s", output))
+		}
+	}
+
+	backupMgr := backup.NewBackupManager(ctx, r.Client, rcmd)
+	backupTrigger, err := backupMgr.GetBackupTrigger()
+	if err != nil {
+		return r.handleErr(ctx, rcmd, err, api.Waiting)
+	}
+	if backupTrigger != nil {
+		succeeded, output, err := backupMgr.TriggerAndWait(backupTrigger)
+		if err != nil {
+			return r.handleErr(ctx, rcmd, err, api.Waiting)
+		}
+		if succeeded == nil {
+			_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+				in := obj.(*api.Recommendation)
+				in.Status.Phase = api.Waiting
+				in.Status.Reason = api.WaitingForBackup
+				return in
+			})
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+		}
+		if err := backupMgr.Cleanup(backupTrigger); err != nil {
+			return ctrl.Result{}, err
+		}
+		if !pointer.Bool(succeeded) {
+			return r.recordFailedAttempt(ctx, rcmd, fmt.Errorf("backup before execution is failed: %s", output))
+		}
+	}
+
+	// No new execution is started once the operator is shutting down, it is left to the next leader.
+	// An execution already started is carried on until its state is persisted in the Recommendation status,
+	// so that the next leader resumes monitoring the operation instead of creating it again.
+	if ctx.Err() != nil {
+		klog.Infof("execution of Recommendation %s/%s is deferred as the operator is shutting down", rcmd.Namespace, rcmd.Name)
+		return ctrl.Result{}, nil
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), executionPersistTimeout)
+	defer cancel()
+
+	// The Executor is recreated with the context of the execution, which outlives the shutdown of the operator.
+	exec, err = executor.New(ctx, r.Client, rcmd)
+	if err != nil {
+		return r.handleErr(ctx, rcmd, err, api.Failed)
+	}
+	// The version before the first attempt is recorded, so that a failed upgrade can be rolled back to it.
+	var previousVersion string
+	if rcmd.Spec.RecommendedVersion != "" && rcmd.Status.PreviousVersion == "" {
+		if previousVersion, err = target.CurrentVersion(ctx, r.Client, rcmd); err != nil {
+			klog.Errorf("failed to get the version of the target of Recommendation %s/%s: %v", rcmd.Namespace, rcmd.Name, err)
+		}
+	}
+	// The spec of the target is snapshotted before the first attempt, so that it can be diffed after the execution.
+	var snapshot *api.TargetSnapshot
+	if rcmd.Status.TargetSnapshot == nil {
+		if snapshot, err = target.TakeSnapshot(ctx, r.Client, rcmd, r.Clock.Now()); err != nil {
+			klog.Errorf("failed to take the snapshot of the target of Recommendation %s/%s: %v", rcmd.Namespace, rcmd.Name, err)
+		}
+	}
+	// The finalizer is added before creating the operation, so that the operation is never left behind
+	// if the Recommendation is deleted in the meantime.
+	if err := r.addFinalizer(ctx, rcmd); err != nil {
+		return ctrl.Result{}, err
+	}
+	// The Flux object is suspended and the maintenance mode is signaled only once the operation is going to be created.
+	if r.EnableFluxSuspension {
+		if err := flux.NewSuspender(ctx, r.Client, rcmd).Suspend(); err != nil {
+			return r.handleErr(ctx, rcmd, err, api.Waiting)
+		}
+	}
+	if err := r.signalMaintenanceMode(ctx, rcmd); err != nil {
+		return r.handleErr(ctx, rcmd, err, api.Waiting)
+	}
+	// The running window is recorded, so that the execution is accounted to the utilization of its MaintenanceWindow.
+	window, err := maintenance.NewRecommendationMaintenance(ctx, r.Client, rcmd, r.Clock).NextScheduledWindow()
+	if err != nil {
+		klog.Errorf("failed to get the running window of Recommendation %s/%s: %v", rcmd.Namespace, rcmd.Name, err)
+	}
+	startExecution := func(in *api.Recommendation) {
+		if window != nil && !window.Start.After(r.Clock.Now()) {
+			in.Status.ScheduledWindow = window
+		}
+		in.Status.Phase = api.InProgress
+		in.Status.Conditions = cutil.RemoveCondition(in.Status.Conditions, api.TargetNotHealthy)
+		in.Status.Conditions = cutil.RemoveCondition(in.Status.Conditions, api.BlockedByPDB)
+		in.Status.Conditions = cutil.RemoveCondition(in.Status.Conditions, api.ClusterPreconditionFailed)
+		in.Status.Conditions = cutil.RemoveCondition(in.Status.Conditions, api.PrometheusGateBreached)
+		in.Status.Conditions = cutil.RemoveCondition(in.Status.Conditions, api.ValidationFailed)
+		in.Status.CreatedOperationRef = &core.LocalObjectReference{Name: opsReqName}
+		if in.Status.PreviousVersion == "" {
+			in.Status.PreviousVersion = previousVersion
+		}
+		if in.Status.TargetSnapshot == nil {
+			in.Status.TargetSnapshot = snapshot
+		}
+	}
+
+	// The operation created in a remote system, e.g. the pull request of the GitOps mode, only reserves its execution
+	// slot here. It is created by createRemoteOperation without holding the lock of the execution limits.
+	if executor.CreatesRemotely(exec) {
+		_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			startExecution(in)
+			in.Status.Reason = api.CreatingOperation
+			return in
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	start := time.Now()
+	err = exec.CreateObject(opsReqName)
+	metrics.ObserveExecutor(rcmd, metrics.ExecutorCreate, start)
+	if err != nil {
+		return r.handleErr(ctx, rcmd, err, api.Failed)
+	}
+	metrics.RecordExecution(rcmd, metrics.ExecutionStarted, r.Clock.Now())
+	r.recordEvent(ctx, rcmd, core.EventTypeNormal, api.EventReasonExecutionStarted, fmt.Sprintf("Operation %s is created", opsReqName))
+
+	_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		startExecution(in)
+		r.setOperationCreated(in)
+		return in
+	})
+	return ctrl.Result{}, err
+}
+
+// createRemoteOperation creates the operation whose execution slot is reserved by runMaintenanceWork.
+// It runs outside the lock of the execution limits, as creating the operation in the remote system may take long.
+// If the operator is restarted meanwhile, the operation is created again with the same name.
+func (r *RecommendationReconciler) createRemoteOperation(ctx context.Context, rcmd *api.Recommendation) (ctrl.Result, error) {
+	exec, err := executor.New(ctx, r.Client, rcmd)
+	if err != nil {
+		return r.handleErr(ctx, rcmd, err, api.Failed)
+	}
+	opsReqName := rcmd.Status.CreatedOperationRef.Name
+	start := time.Now()
+	err = exec.CreateObject(opsReqName)
+	metrics.ObserveExecutor(rcmd, metrics.ExecutorCreate, start)
+	if err != nil {
+		// The reserved slot is given back, the same way as an operation failed to be created in the cluster.
+		_, pErr := kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.Phase = api.Failed
+			in.Status.Reason = err.Error()
+			in.Status.CreatedOperationRef = nil
+			return in
+		})
+		return ctrl.Result{RequeueAfter: r.RetryAfterDuration}, pErr
+	}
+	metrics.RecordExecution(rcmd, metrics.ExecutionStarted, r.Clock.Now())
+	r.recordEvent(ctx, rcmd, core.EventTypeNormal, api.EventReasonExecutionStarted, fmt.Sprintf("Operation %s is created", opsReqName))
+
+	_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		r.setOperationCreated(in)
+		return in
+	})
+	return ctrl.Result{}, err
+}
+
+// setOperationCreated records the start of the execution of the created operation.
+func (r *RecommendationReconciler) setOperationCreated(in *api.Recommendation) {
+	in.Status.Reason = api.StartedExecutingOperation
+	in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+		Type:               api.SuccessfullyCreatedOperation,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+		Reason:             api.SuccessfullyCreatedOperation,
+		Message:            "OpsRequest is successfully created",
+	})
+}
+
+// checkSLA sets the SLABreached condition when the Recommendation is pending or waiting
+// for longer than its maximum queue duration.
+func (r *RecommendationReconciler) checkSLA(ctx context.Context, rcmd *api.Recommendation) error {
+	if rcmd.Status.Phase != api.Pending && rcmd.Status.Phase != api.Waiting {
+		return nil
+	}
+	if cutil.HasCondition(rcmd.Status.Conditions, api.SLABreached) {
+		return nil
+	}
+
+	maxQueueDuration := r.MaxQueueDuration
+	approvalPolicy, err := policy.NewApprovalPolicyFinder(ctx, r.Client, rcmd).FindActiveApprovalPolicy(r.Clock.Now())
+	if err != nil {
+		return err
+	}
+	if approvalPolicy != nil && approvalPolicy.MaxQueueDuration != nil {
+		maxQueueDuration = approvalPolicy.MaxQueueDuration.Duration
+	}
+	if maxQueueDuration <= 0 || r.Clock.Since(rcmd.CreationTimestamp.Time) <= maxQueueDuration {
+		return nil
+	}
+
+	msg := fmt.Sprintf("Recommendation is queued for more than %s", maxQueueDuration)
+	_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+			Type:               api.SLABreached,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+			Reason:             api.SLABreached,
+			Message:            msg,
+		})
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	metrics.RecordSLABreach(rcmd)
+	r.recordEvent(ctx, rcmd, core.EventTypeWarning, api.SLABreached, msg)
+	return nil
+}
+
+// isApprovedByTargetAnnotation returns true if the opt-in target approval annotation approves the Recommendation.
+func (r *RecommendationReconciler) isApprovedByTargetAnnotation(ctx context.Context, rcmd *api.Recommendation) (bool, error) {
+	if !r.EnableTargetApprovalAnnotation || rcmd.Spec.RequireExplicitApproval {
+		return false, nil
+	}
+	return policy.IsApprovedByTargetAnnotation(ctx, r.Client, rcmd)
+}
+
+// syncChangeRequest creates the ServiceNow change request of the Recommendation, if it is not created yet,
+// and Approves or Rejects the Recommendation once the change request is approved or rejected in ServiceNow.
+func (r *RecommendationReconciler) syncChangeRequest(ctx context.Context, rcmd *api.Recommendation, approvalPolicy *api.ApprovalPolicy) error {
+	created := rcmd.Status.ChangeRequest == nil
+	cr, err := changerequest.NewServiceNowManager(ctx, r.Client, rcmd, approvalPolicy.ServiceNow, r.Clock).Sync()
+	if err != nil {
+		return err
+	}
+	_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.ChangeRequest = cr
+		switch cr.Approval {
+		case changerequest.ServiceNowApprovalApproved:
+			in.Status.ApprovalStatus = api.ApprovalApproved
+			in.Status.ApprovedWindow = &api.ApprovedWindow{
+				MaintenanceWindow: &approvalPolicy.MaintenanceWindowRef,
+			}
+			in.Status.Comments = fmt.Sprintf("Change request %s is approved in ServiceNow", cr.Number)
+			in.Status.ReviewTimestamp = &metav1.Time{Time: r.Clock.Now().UTC()}
+		case changerequest.ServiceNowApprovalRejected:
+			in.Status.ApprovalStatus = api.ApprovalRejected
+			in.Status.RejectionReason = api.RejectionReasonChangeRequest
+			in.Status.Comments = fmt.Sprintf("Change request %s is rejected in ServiceNow", cr.Number)
+			in.Status.ReviewTimestamp = &metav1.Time{Time: r.Clock.Now().UTC()}
+		}
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	if created {
+		r.recordEvent(ctx, rcmd, core.EventTypeNormal, api.EventReasonChangeRequestCreated,
+			fmt.Sprintf("Created ServiceNow change request %s", cr.Number))
+	}
+	return nil
+}
+
+// resumeFlux resumes the Flux object suspended during the execution of the Recommendation.
+// The Suspender resumes only the Flux object annotated as suspended by the Recommendation,
+// so that it is resumed even if the Recommendation failed before recording the created operation.
+func (r *RecommendationReconciler) resumeFlux(ctx context.Context, rcmd *api.Recommendation) error {
+	if !r.EnableFluxSuspension {
+		return nil
+	}
+	return flux.NewSuspender(ctx, r.Client, rcmd).Resume()
+}
+
+// signalMaintenanceMode signals the front doors of the target that the target is under maintenance,
+// if the maintenance mode signal is enabled. The UnderMaintenance condition is set until the signal is cleared.
+func (r *RecommendationReconciler) signalMaintenanceMode(ctx context.Context, rcmd *api.Recommendation) error {
+	if !r.AnnotateMaintenanceMode && r.MaintenanceModeWebhookURL == "" {
+		return nil
+	}
+	if _, cond := cutil.GetCondition(rcmd.Status.Conditions, api.UnderMaintenance); cond != nil {
+		return nil
+	}
+	if err := maintenancemode.NewSignaler(ctx, r.Client, rcmd, r.AnnotateMaintenanceMode, r.MaintenanceModeWebhookURL).Signal(); err != nil {
+		return err
+	}
+	_, err := kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+			Type:               api.UnderMaintenance,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+			Reason:             api.UnderMaintenance,
+			Message:            "Target is signaled as under maintenance",
+		})
+		return in
+	})
+	return err
+}
+
+// clearMaintenanceMode clears the maintenance mode signal of the Recommendation, if it is signaled.
+func (r *RecommendationReconciler) clearMaintenanceMode(ctx context.Context, rcmd *api.Recommendation) error {
+	if _, cond := cutil.GetCondition(rcmd.Status.Conditions, api.UnderMaintenance); cond == nil {
+		return nil
+	}
+	if err := maintenancemode.NewSignaler(ctx, r.Client, rcmd, r.AnnotateMaintenanceMode, r.MaintenanceModeWebhookURL).Clear(); err != nil {
+		return err
+	}
+	if rcmd.DeletionTimestamp != nil {
+		return nil
+	}
+	_, err := kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.Conditions = cutil.RemoveCondition(in.Status.Conditions, api.UnderMaintenance)
+		return in
+	})
+	return err
+}
+
+// hubApproval returns the approval decision of the Open Cluster Management hub for the Recommendation,
+// if it is distributed by one of the trusted hub users.
+func (r *RecommendationReconciler) hubApproval(rcmd *api.Recommendation) api.ApprovalStatus {
+	if len(r.OCMHubUsers) == 0 || !slices.Contains(r.OCMHubUsers, rcmd.Annotations[api.CreatedByKey]) {
+		return ""
+	}
+	switch status := api.ApprovalStatus(rcmd.Annotations[api.HubApprovalKey]); status {
+	case api.ApprovalApproved, api.ApprovalRejected:
+		return status
+	default:
+		return ""
+	}
+}
+
+// isStale returns true if the Recommendation is pending for approval for longer than
+// the AutoRejectAfter duration of the matched ApprovalPolicy.
+func (r *RecommendationReconciler) isStale(rcmd *api.Recommendation, approvalPolicy *api.ApprovalPolicy) bool {
+	if approvalPolicy == nil || approvalPolicy.AutoRejectAfter == nil {
+		return false
+	}
+	return r.Clock.Since(rcmd.CreationTimestamp.Time) > approvalPolicy.AutoRejectAfter.Duration
+}
+
+// isApprovalQuorumMet returns true if the Recommendation has gathered the number of approvals
+// required by the matched ApprovalPolicy.
+func (r *RecommendationReconciler) isApprovalQuorumMet(ctx context.Context, rcmd *api.Recommendation) (bool, error) {
+	if !rcmd.IsAwaitingRecommendation() {
+		return true, nil
+	}
+	approvalPolicy, err := policy.NewApprovalPolicyFinder(ctx, r.Client, rcmd).FindApprovalPolicy()
+	if err != nil {
+		return false, err
+	}
+	if approvalPolicy == nil || !approvalPolicy.IsQuorumRequired() {
+		return true, nil
+	}
+	return int32(len(rcmd.Status.Approvals)) >= *approvalPolicy.RequiredApprovals, nil
+}
+
+func (r *RecommendationReconciler) isActiveDeadlineExceeded(rcmd *api.Recommendation) bool {
+	if rcmd.Spec.ActiveDeadlineSeconds == nil {
+		return false
+	}
+	_, cond := cutil.GetCondition(rcmd.Status.Conditions, api.SuccessfullyCreatedOperation)
+	if cond == nil {
+		return false
+	}
+	deadline := cond.LastTransitionTime.Add(time.Duration(*rcmd.Spec.ActiveDeadlineSeconds) * time.Second)
+	return r.Clock.Now().After(deadline)
+}
+
+// maxDeletionWait bounds the wait for the running operation of a deleted Recommendation without any ActiveDeadlineSeconds,
+// e.g. the pull request of the GitOps mode which is never merged.
+const maxDeletionWait = time.Hour
+
+// isDeletionWaitExceeded returns true if the running operation of the deleted Recommendation is not waited for anymore.
+// The wait is bounded by the ActiveDeadlineSeconds of the operation, or by the maxDeletionWait after the deletion.
+func (r *RecommendationReconciler) isDeletionWaitExceeded(rcmd *api.Recommendation) bool {
+	if rcmd.Spec.ActiveDeadlineSeconds != nil {
+		return r.isActiveDeadlineExceeded(rcmd)
+	}
+	if rcmd.DeletionTimestamp == nil {
+		return false
+	}
+	return r.Clock.Now().After(rcmd.DeletionTimestamp.Add(maxDeletionWait))
+}
+
+func (r *RecommendationReconciler) addFinalizer(ctx context.Context, rcmd *api.Recommendation) error {
+	if controllerutil.ContainsFinalizer(rcmd, api.OperationCleanupFinalizer) {
+		return nil
+	}
+	_, err := kmc.CreateOrPatch(ctx, r.Client, rcmd, func(obj client.Object, createOp bool) client.Object {
+		controllerutil.AddFinalizer(obj, api.OperationCleanupFinalizer)
+		return obj
+	})
+	return err
+}
+
+// finalize handles the created operation of a deleted Recommendation according to its PropagationPolicy.
+// With the `Wait` policy, the finalizer is kept until the running operation is completed, or until the wait
+// is exceeded. Then the operation is deleted if DeleteStalledOperation is set, otherwise it is left running.
+// With the `Delete` policy, the running operation is deleted immediately.
+func (r *RecommendationReconciler) finalize(ctx context.Context, rcmd *api.Recommendation) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(rcmd, api.OperationCleanupFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if rcmd.Status.Phase == api.InProgress && rcmd.Status.CreatedOperationRef != nil {
+		exec, err := executor.New(ctx, r.Client, rcmd)
+		if err != nil {
+			return ctrl.Result{RequeueAfter: r.RetryAfterDuration}, err
+		}
+		name := rcmd.Status.CreatedOperationRef.Name
+		if rcmd.Spec.PropagationPolicy == api.OperationPropagationDelete {
+			if err := exec.Cleanup(name); err != nil {
+				return ctrl.Result{}, err
+			}
+			r.recordEvent(ctx, rcmd, core.EventTypeNormal, api.EventReasonOperationDeleted,
+				fmt.Sprintf("Operation %s is deleted along with the Recommendation", name))
+		} else {
+			success, err := exec.CheckStatus(name)
+			if err != nil && !kerr.IsNotFound(err) {
+				return ctrl.Result{RequeueAfter: r.RetryAfterDuration}, err
+			}
+			if err == nil && success == nil && !r.isDeletionWaitExceeded(rcmd) {
+				klog.Infof("waiting for operation %s of the deleted Recommendation %s/%s to complete", name, rcmd.Namespace, rcmd.Name)
+				return ctrl.Result{RequeueAfter: r.RequeueAfterDuration}, nil
+			}
+			if err == nil && success == nil && rcmd.Spec.DeleteStalledOperation {
+				if err := exec.Cleanup(name); err != nil {
+					return ctrl.Result{}, err
+				}
+				r.recordEvent(ctx, rcmd, core.EventTypeWarning, api.EventReasonOperationDeleted,
+					fmt.Sprintf("Operation %s is deleted as it is not completed before the Recommendation is removed", name))
+			} else {
+				if err == nil && success == nil {
+					klog.Warningf("stopped waiting for operation %s of the deleted Recommendation %s/%s to complete", name, rcmd.Namespace, rcmd.Name)
+				}
+				if err := executor.Release(exec, name); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+		}
+	}
+
+	if err := r.resumeFlux(ctx, rcmd); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.clearMaintenanceMode(ctx, rcmd); err != nil {
+		return ctrl.Result{}, err
+	}
+	_, err := kmc.CreateOrPatch(ctx, r.Client, rcmd, func(obj client.Object, createOp bool) client.Object {
+		controllerutil.RemoveFinalizer(obj, api.OperationCleanupFinalizer)
+		return obj
+	})
+	return ctrl.Result{}, client.IgnoreNotFound(err)
+}
+
+// isCircuitOpen returns true if the execution of the Recommendation is paused by the circuit breaker.
+// The CircuitOpen condition is set while the circuit is open. The circuit is reset by the
+// `supervisor.appscode.com/reset-circuit` annotation of the Recommendation.
+func (r *RecommendationReconciler) isCircuitOpen(ctx context.Context, rcmd *api.Recommendation) (bool, error) {
+	breaker := execution.NewCircuitBreaker(ctx, r.Client, rcmd, r.CircuitBreakerThreshold, r.CircuitBreakerScope)
+	if rcmd.IsCircuitResetRequested() {
+		if err := breaker.Reset(); err != nil {
+			return false, err
+		}
+		klog.Infof("circuit of Recommendation %s/%s is reset", rcmd.Namespace, rcmd.Name)
+		_, err := kmc.CreateOrPatch(ctx, r.Client, rcmd, func(obj client.Object, createOp bool) client.Object {
+			delete(obj.GetAnnotations(), api.ResetCircuitKey)
+			return obj
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	open, err := breaker.IsOpen()
+	if err != nil {
+		return false, err
+	}
+	if !open {
+		if _, cond := cutil.GetCondition(rcmd.Status.Conditions, api.CircuitOpen); cond == nil {
+			return false, nil
+		}
+		_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.Conditions = cutil.RemoveCondition(in.Status.Conditions, api.CircuitOpen)
+			return in
+		})
+		return false, err
+	}
+
+	msg := fmt.Sprintf("Execution is paused as the last %d executions of the %s are failed. Set the %q annotation to %q to resume",
+		r.CircuitBreakerThreshold, strings.ToLower(string(r.CircuitBreakerScope)), api.ResetCircuitKey, "true")
+	if _, cond := cutil.GetCondition(rcmd.Status.Conditions, api.CircuitOpen); cond == nil {
+		r.recordEvent(ctx, rcmd, core.EventTypeWarning, api.EventReasonCircuitOpened, msg)
+	}
+	_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.Phase = api.Waiting
+		in.Status.Reason = api.CircuitOpen
+		in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+			Type:               api.CircuitOpen,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+			Reason:             api.CircuitOpen,
+			Message:            msg,
+		})
+		return in
+	})
+	return true, err
+}
+
+// untilWindowOpens returns the duration until the given scheduled window opens, so that the Recommendation is
+// reconciled as soon as the window opens instead of polling every RequeueAfterDuration. The delayed requeues
+// of all the Recommendations are held by the shared delaying workqueue of the controller, and the changes of
+// the windows are watched, so RequeueAfterDuration is only used when the next window is unknown.
+func (r *RecommendationReconciler) untilWindowOpens(w *api.ScheduledWindow) time.Duration {
+	if w == nil {
+		return r.RequeueAfterDuration
+	}
+	d := w.Start.Sub(r.Clock.Now())
+	if d <= 0 {
+		return r.RequeueAfterDuration
+	}
+	// The slack makes sure the window is open when the Recommendation is reconciled.
+	return d + time.Second
+}
+
+// markStalled marks the Recommendation as Stalled and deletes the running operation if it is asked.
+// Otherwise, the target held by the running operation is released.
+func (r *RecommendationReconciler) markStalled(ctx context.Context, rcmd *api.Recommendation, exec executor.Executor) (ctrl.Result, error) {
+	name := rcmd.Status.CreatedOperationRef.Name
+	msg := fmt.Sprintf("Operation %s is not completed within %d seconds", name, *rcmd.Spec.ActiveDeadlineSeconds)
+	if rcmd.Spec.DeleteStalledOperation {
+		if err := exec.Cleanup(name); err != nil {
+			return ctrl.Result{}, err
+		}
+		msg += ", the operation is deleted"
+	} else if err := executor.Release(exec, name); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.recordEvent(ctx, rcmd, core.EventTypeWarning, api.ActiveDeadlineExceeded, msg)
+
+	if err := execution.NewExecutionRecorder(ctx, r.Client, rcmd, r.Clock).Record(api.Stalled, api.ActiveDeadlineExceeded); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	_, err := kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.Phase = api.Stalled
+		in.Status.Reason = api.ActiveDeadlineExceeded
+		in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+			Type:               api.SuccessfullyExecutedOperation,
+			Status:             metav1.ConditionFalse,
+			LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+			Reason:             api.ActiveDeadlineExceeded,
+			Message:            msg,
+		})
+		in.Status.ObservedGeneration = in.Generation
+		return in
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	metrics.RecordExecution(rcmd, metrics.ExecutionFailed, r.Clock.Now())
+	return ctrl.Result{}, nil
+}
+
+// recommendRollback creates a Critical Recommendation rolling the target back to its previous version, if the
+// failed Recommendation was upgrading the version of the target and the target is degraded afterwards.
+// The rollback Recommendation goes through the same approval and execution pipeline as any other Recommendation.
+func (r *RecommendationReconciler) recommendRollback(ctx context.Context, rcmd *api.Recommendation) error {
+	if !r.EnableAutoRollback || rcmd.Status.Phase == api.Succeeded || rcmd.Status.RollbackRef != nil || rcmd.Spec.RollbackOf != nil ||
+		rcmd.Status.CreatedOperationRef == nil || rcmd.Spec.RecommendedVersion == "" {
+		return nil
+	}
+	if version := recommender.PreviousVersion(rcmd); version == "" || version == rcmd.Spec.RecommendedVersion {
+		return nil
+	}
+	healthy, err := target.NewHealthChecker(ctx, r.Client, rcmd).IsHealthy()
+	if err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if healthy {
+		return nil
+	}
+
+	rollback, err := recommender.NewRollbackRecommendation(rcmd)
+	if err != nil {
+		klog.Errorf("failed to build the rollback of Recommendation %s/%s: %v", rcmd.Namespace, rcmd.Name, err)
+		return nil
+	}
+	if err := r.Client.Create(ctx, rollback); client.IgnoreAlreadyExists(err) != nil {
+		return err
+	}
+	r.recordEvent(ctx, rcmd, core.EventTypeWarning, api.EventReasonRollbackRecommended,
+		fmt.Sprintf("Target is degraded, Recommendation %s is created to roll back the version to %s", rollback.Name, rollback.Spec.RecommendedVersion))
+	_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.RollbackRef = &core.LocalObjectReference{Name: rollback.Name}
+		return in
+	})
+	return err
+}
+
+// abortOnBreachedGate deletes the in progress operation of the Recommendation whose PrometheusGate is breached
+// and marks the Recommendation as Failed. The aborted execution is not retried.
+func (r *RecommendationReconciler) abortOnBreachedGate(ctx context.Context, rcmd *api.Recommendation, exec executor.Executor, breach string) (ctrl.Result, error) {
+	name := rcmd.Status.CreatedOperationRef.Name
+	if err := exec.Cleanup(name); err != nil {
+		return ctrl.Result{}, err
+	}
+	msg := fmt.Sprintf("Operation %s is aborted as %s", name, breach)
+	r.recordEvent(ctx, rcmd, core.EventTypeWarning, api.PrometheusGateBreached, msg)
+
+	if err := execution.NewExecutionRecorder(ctx, r.Client, rcmd, r.Clock).Record(api.Failed, api.PrometheusGateBreached); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	_, err := kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.Phase = api.Failed
+		in.Status.Reason = api.PrometheusGateBreached
+		in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+			Type:               api.SuccessfullyExecutedOperation,
+			Status:             metav1.ConditionFalse,
+			LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+			Reason:             api.PrometheusGateBreached,
+			Message:            msg,
+		})
+		in.Status.ObservedGeneration = in.Generation
+		return in
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	metrics.RecordExecution(rcmd, metrics.ExecutionFailed, r.Clock.Now())
+	return ctrl.Result{}, nil
+}
+
+// fitsInWindow estimates the duration of the operation from the previous executions and returns false if
+// the estimate is longer than the remaining time of the running window. The estimate is recorded in the
+// Recommendation status, but it is used only if it is made from at least MinDurationSamples executions.
+// wait holds the duration until the running window is closed.
+func (r *RecommendationReconciler) fitsInWindow(ctx context.Context, rcmd *api.Recommendation) (fits bool, wait time.Duration, err error) {
+	est, err := execution.NewDurationEstimator(ctx, r.Client, rcmd).Estimate()
+	if err != nil {
+		return false, 0, err
+	}
+	_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.EstimatedDuration = est
+		return in
+	})
+	if err != nil {
+		return false, 0, err
+	}
+	if r.MinDurationSamples == 0 || int(est.Samples) < r.MinDurationSamples || r.isExecuteNowRequested(rcmd) {
+		return true, 0, nil
+	}
+
+	window, err := maintenance.NewRecommendationMaintenance(ctx, r.Client, rcmd, r.Clock).NextScheduledWindow()
+	if err != nil || window == nil || window.End.IsZero() {
+		return true, 0, err
+	}
+	now := r.Clock.Now()
+	if window.Start.After(now) {
+		return true, 0, nil
+	}
+	remaining := window.End.Sub(now)
+	if est.Duration.Duration <= remaining {
+		return true, 0, nil
+	}
+	return false, remaining + time.Second, nil
+}
+
+// deferForDowntimeBudget defers the execution of the Recommendation until the period of the exceeded
+// DowntimeBudget is over. The Recommendation is flagged with the DowntimeBudgetExceeded condition.
+func (r *RecommendationReconciler) deferForDowntimeBudget(ctx context.Context, rcmd *api.Recommendation, budget *api.DowntimeBudget, usage *execution.BudgetUsage) (ctrl.Result, error) {
+	msg := fmt.Sprintf("Expected downtime %s exceeds the remaining %d minutes of DowntimeBudget %s until %s",
+		execution.ExpectedDowntime(rcmd), usage.RemainingMinutes(), budget.Name, usage.PeriodEnd.Format(time.RFC3339))
+	if _, cond := cutil.GetCondition(rcmd.Status.Conditions, api.DowntimeBudgetExceeded); cond == nil {
+		r.recordEvent(ctx, rcmd, core.EventTypeWarning, api.DowntimeBudgetExceeded, msg)
+	}
+	_, err := kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.Phase = api.Waiting
+		in.Status.Reason = api.DowntimeBudgetExceeded
+		in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+			Type:               api.DowntimeBudgetExceeded,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+			Reason:             api.DowntimeBudgetExceeded,
+			Message:            msg,
+		})
+		return in
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: usage.PeriodEnd.Sub(r.Clock.Now()) + time.Second}, nil
+}
+
+// recordApprovedTarget records the hash of the spec of the target when the Recommendation is approved,
+// so that the drift of the target is detected before creating the operation.
+func (r *RecommendationReconciler) recordApprovedTarget(ctx context.Context, rcmd *api.Recommendation) error {
+	hash, err := target.NewRevalidator(ctx, r.Client, rcmd).CurrentSpecHash()
+	if err != nil {
+		klog.Errorf("failed to get the target of Recommendation %s/%s: %v", rcmd.Namespace, rcmd.Name, err)
+		return nil
+	}
+	_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.ApprovedTargetSpecHash = hash
+		return in
+	})
+	return err
+}
+
+// handleDriftedTarget handles the Recommendation whose target is drifted after the approval according to
+// the TargetDriftAction. The Recommendation is either skipped as Outdated or sent back for approval.
+func (r *RecommendationReconciler) handleDriftedTarget(ctx context.Context, rcmd *api.Recommendation) (ctrl.Result, error) {
+	msg := "Target is changed after the Recommendation is approved"
+	r.recordEvent(ctx, rcmd, core.EventTypeWarning, api.TargetDrifted, msg)
+	_, err := kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		if r.TargetDriftAction == target.DriftActionReapprove {
+			in.Status.ApprovalStatus = api.ApprovalPending
+			in.Status.Phase = api.Pending
+			in.Status.Reason = api.WaitingForApproval
+			in.Status.ApprovedBy = nil
+			in.Status.Approvals = nil
+			in.Status.ApprovedTargetSpecHash = ""
+		} else {
+			in.Status.Outdated = true
+			in.Status.Phase = api.Skipped
+			in.Status.Reason = api.RecommendationOutdated
+			in.Status.ObservedGeneration = in.Generation
+		}
+		in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+			Type:               api.TargetDrifted,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+			Reason:             api.TargetDrifted,
+			Message:            msg,
+		})
+		return in
+	})
+	return ctrl.Result{}, err
+}
+
+// validateApprovedOperation validates the operation of the approved Recommendation using server-side dry-run,
+// so that the invalid operations are reported long before the MaintenanceWindow opens. The failure is recorded
+// as the ValidationFailed condition and the Recommendation keeps waiting, as the operation is validated again
+// before creating it. The condition is removed once the operation is valid.
+func (r *RecommendationReconciler) validateApprovedOperation(ctx context.Context, rcmd *api.Recommendation) error {
+	exec, err := executor.New(ctx, r.Client, rcmd)
+	if err != nil {
+		return err
+	}
+	v, ok := exec.(executor.Validator)
+	if !ok {
+		return nil
+	}
+	start := time.Now()
+	vErr := v.Validate(executor.OperationName(rcmd))
+	metrics.ObserveExecutor(rcmd, metrics.ExecutorValidate, start)
+	if vErr != nil {
+		r.recordEvent(ctx, rcmd, core.EventTypeWarning, api.ValidationFailed, vErr.Error())
+	}
+	_, err = kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		if vErr == nil {
+			in.Status.Conditions = cutil.RemoveCondition(in.Status.Conditions, api.ValidationFailed)
+			return in
+		}
+		in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+			Type:               api.ValidationFailed,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: in.Generation,
+			LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+			Reason:             api.ValidationFailed,
+			Message:            vErr.Error(),
+		})
+		return in
+	})
+	return err
+}
+
+// recordInvalidOperation marks the Recommendation as terminally Failed when the operation is rejected by the
+// server-side dry-run, as retrying the same operation won't make it valid.
+func (r *RecommendationReconciler) recordInvalidOperation(ctx context.Context, rcmd *api.Recommendation, err error) (ctrl.Result, error) {
+	if rErr := execution.NewExecutionRecorder(ctx, r.Client, rcmd, r.Clock).Record(api.Failed, api.OperationValidationFailed); rErr != nil {
+		return ctrl.Result{}, rErr
+	}
+
+	_, pErr := kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.Phase = api.Failed
+		in.Status.Reason = api.OperationValidationFailed
+		in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+			Type:               api.SuccessfullyCreatedOperation,
+			Status:             metav1.ConditionFalse,
+			LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+			Reason:             api.OperationValidationFailed,
+			Message:            err.Error(),
+		})
+		in.Status.ObservedGeneration = in.Generation
+		return in
+	})
+	if pErr != nil {
+		return ctrl.Result{}, pErr
+	}
+	metrics.RecordExecution(rcmd, metrics.ExecutionFailed, r.Clock.Now())
+	r.recordEvent(ctx, rcmd, core.EventTypeWarning, api.OperationValidationFailed, err.Error())
+	return ctrl.Result{}, nil
+}
+
+func (r *RecommendationReconciler) recordFailedVerification(ctx context.Context, rcmd *api.Recommendation, output string) (ctrl.Result, error) {
+	if err := execution.NewExecutionRecorder(ctx, r.Client, rcmd, r.Clock).Record(api.Failed, api.VerificationFailed); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	_, err := kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.Phase = api.Failed
+		in.Status.Reason = api.VerificationFailed
+		in.Status.VerificationOutput = output
+		in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+			Type:               api.SuccessfullyExecutedOperation,
+			Status:             metav1.ConditionFalse,
+			LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+			Reason:             api.VerificationFailed,
+			Message:            output,
+		})
+		in.Status.ObservedGeneration = in.Generation
+		return in
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	metrics.RecordExecution(rcmd, metrics.ExecutionFailed, r.Clock.Now())
+	r.recordEvent(ctx, rcmd, core.EventTypeWarning, api.VerificationFailed, output)
+	return ctrl.Result{}, nil
+}
+
+// replaceRecommendation deletes the created operation of the old Recommendation and marks it as Skipped,
+// so that the new Recommendation of the same target can be executed.
+func (r *RecommendationReconciler) replaceRecommendation(ctx context.Context, old, rcmd *api.Recommendation) error {
+	if old.Status.CreatedOperationRef != nil {
+		exec, err := executor.New(ctx, r.Client, old)
+		if err != nil {
+			return err
+		}
+		if err := exec.Cleanup(old.Status.CreatedOperationRef.Name); err != nil {
+			return err
+		}
+	}
+	_, err := kmc.PatchStatus(ctx, r.Client, old, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.Phase = api.Skipped
+		in.Status.Reason = api.ReplacedByRecommendation
+		in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+			Type:               api.ReplacedByRecommendation,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+			Reason:             api.ReplacedByRecommendation,
+			Message:            fmt.Sprintf("Replaced by Recommendation %s", rcmd.Name),
+		})
+		in.Status.ObservedGeneration = in.Generation
+		return in
+	})
+	return err
+}
+
+// isExecuteNowRequested returns true if the execution of the Recommendation bypassing the MaintenanceWindow
+// is requested. The request is ignored unless the webhooks authorize the requester and record their identity.
+func (r *RecommendationReconciler) isExecuteNowRequested(rcmd *api.Recommendation) bool {
+	return r.EnableExecuteNow && rcmd.IsExecuteNowRequested()
+}
+
+// runForcedMaintenanceWork executes the Recommendation bypassing the MaintenanceWindow
+// and records the user who requested the emergency execution.
+func (r *RecommendationReconciler) runForcedMaintenanceWork(ctx context.Context, rcmd *api.Recommendation) (ctrl.Result, error) {
+	if rcmd.Status.ForcedExecution == nil {
+		klog.Infof("Recommendation %s/%s is forced to execute bypassing the MaintenanceWindow", rcmd.Namespace, rcmd.Name)
+		_, err := kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+			in := obj.(*api.Recommendation)
+			in.Status.ForcedExecution = &api.ForcedExecution{
+				RequestedBy: in.Annotations[api.ExecuteNowRequestedByKey],
+				Timestamp:   metav1.Time{Time: r.Clock.Now().UTC()},
+			}
+			in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
+				Type:               api.ForcedExecutionStarted,
+				Status:             metav1.ConditionTrue,
+				LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
+				Reason:             api.ForcedExecutionStarted,
+				Message:            fmt.Sprintf("Execution is forced by %q bypassing the MaintenanceWindow", in.Annotations[api.ExecuteNowRequestedByKey]),
+			})
+			return in
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		r.recordEvent(ctx, rcmd, core.EventTypeWarning, api.ForcedExecutionStarted,
+			fmt.Sprintf("Execution is forced by %q bypassing the MaintenanceWindow", rcmd.Annotations[api.ExecuteNowRequestedByKey]))
+	}
+	return r.runMaintenanceWork(ctx, rcmd)
+}
+
+func (r *RecommendationReconciler) handleErr(ctx context.Context, rcmd *api.Recommendation, err error, phase api.RecommendationPhase) (ctrl.Result, error) {
 	_, pErr := kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
 		in := obj.(*api.Recommendation)
 		in.Status.Phase = phase
@@ -312,28 +2008,155 @@ func (r *RecommendationReconciler) recordFailedAttempt(ctx context.Context, obj
 		in.Status.Conditions = cutil.SetCondition(in.Status.Conditions, kmapi.Condition{
 			Type:               api.SuccessfullyExecutedOperation,
 			Status:             metav1.ConditionFalse,
-			LastTransitionTime: metav1.Time{Time: time.Now().UTC()},
+			LastTransitionTime: metav1.Time{Time: r.Clock.Now().UTC()},
 			Reason:             err.Error(),
 			Message:            err.Error(),
 		})
 		in.Status.FailedAttempt += 1
 		return in
 	})
+	if pErr == nil {
+		metrics.RecordExecution(obj, metrics.ExecutionFailed, r.Clock.Now())
+		r.recordEvent(ctx, obj, core.EventTypeWarning, api.EventReasonExecutionFailed, err.Error())
+	}
 	return ctrl.Result{RequeueAfter: r.RetryAfterDuration}, pErr
 }
 
+// setTraceParent stores the trace context of the first reconciliation in the Recommendation,
+// so that the following reconciliations and the created operation join the same trace.
+func (r *RecommendationReconciler) setTraceParent(ctx context.Context, rcmd *api.Recommendation) error {
+	if _, ok := rcmd.Annotations[api.TraceParentKey]; ok {
+		return nil
+	}
+	traceParent := tracing.TraceParent(ctx)
+	if traceParent == "" {
+		return nil
+	}
+	_, err := kmc.CreateOrPatch(ctx, r.Client, rcmd, func(obj client.Object, createOp bool) client.Object {
+		in := obj.(*api.Recommendation)
+		if in.Annotations == nil {
+			in.Annotations = map[string]string{}
+		}
+		in.Annotations[api.TraceParentKey] = traceParent
+		return in
+	})
+	return err
+}
+
+// recordEvent emits the Event on the Recommendation and its target object,
+// so that the lifecycle of the Recommendation is visible from both of them.
+// The Event is also sent through the Notifiers subscribed to its reason, if the Notifications feature is enabled.
+func (r *RecommendationReconciler) recordEvent(ctx context.Context, rcmd *api.Recommendation, eventType, reason, msg string) {
+	r.Recorder.Event(rcmd, eventType, reason, msg)
+	if features.Enabled(features.Notifications) && r.Notifications != nil {
+		r.Notifications.Enqueue(notifier.NewMessage(ctx, r.Client, rcmd, reason, msg))
+	}
+	obj, err := target.GetTarget(ctx, r.Client, rcmd)
+	if err != nil {
+		klog.Errorf("failed to get the target of Recommendation %s/%s: %v", rcmd.Namespace, rcmd.Name, err)
+		return
+	}
+	r.Recorder.Eventf(obj, eventType, reason, "Recommendation %s: %s", rcmd.Name, msg)
+}
+
+func approvalMessage(rcmd *api.Recommendation) string {
+	msg := fmt.Sprintf("Recommendation is %s", rcmd.Status.ApprovalStatus)
+	if rcmd.Status.Reviewer != nil {
+		msg += fmt.Sprintf(" by %s", rcmd.Status.Reviewer.Name)
+	}
+	if rcmd.Status.Comments != "" {
+		msg += ": " + rcmd.Status.Comments
+	}
+	return msg
+}
+
+// mapWindowToWaitingRecommendations enqueues the Recommendations waiting for a window when a MaintenanceWindow
+// of their namespace or a ClusterMaintenanceWindow is changed, as their wake-up time may be changed.
+func (r *RecommendationReconciler) mapWindowToWaitingRecommendations(ctx context.Context, obj client.Object) []reconcile.Request {
+	rcmdList := &api.RecommendationList{}
+	if err := r.Client.List(ctx, rcmdList, client.InNamespace(obj.GetNamespace()), client.MatchingFields{api.RecommendationPhaseIndex: string(api.Waiting)}); err != nil {
+		klog.Errorf("failed to list Recommendations of namespace %q: %v", obj.GetNamespace(), err)
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, rc := range rcmdList.Items {
+		if rc.Status.Reason == api.WaitingForMaintenanceWindow {
+			reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&rc)})
+		}
+	}
+	return reqs
+}
+
+// mapFreezeToFrozenRecommendations enqueues the Recommendations held by a MaintenanceFreeze,
+// so that they are released as soon as the MaintenanceFreeze is changed or deleted.
+func (r *RecommendationReconciler) mapFreezeToFrozenRecommendations(ctx context.Context, _ client.Object) []reconcile.Request {
+	rcmdList := &api.RecommendationList{}
+	if err := r.Client.List(ctx, rcmdList, client.MatchingFields{api.RecommendationPhaseIndex: string(api.Waiting)}); err != nil {
+		klog.Errorf("failed to list Recommendations: %v", err)
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, rc := range rcmdList.Items {
+		if rc.Status.Reason == api.MaintenanceFrozen {
+			reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&rc)})
+		}
+	}
+	return reqs
+}
+
+// mapBudgetToDeferredRecommendations enqueues the Recommendations of the namespace deferred by a DowntimeBudget,
+// so that they are released as soon as the DowntimeBudget is raised or deleted.
+func (r *RecommendationReconciler) mapBudgetToDeferredRecommendations(ctx context.Context, obj client.Object) []reconcile.Request {
+	rcmdList := &api.RecommendationList{}
+	if err := r.Client.List(ctx, rcmdList, client.InNamespace(obj.GetNamespace()), client.MatchingFields{api.RecommendationPhaseIndex: string(api.Waiting)}); err != nil {
+		klog.Errorf("failed to list Recommendations: %v", err)
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, rc := range rcmdList.Items {
+		if rc.Status.Reason == api.DowntimeBudgetExceeded {
+			reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&rc)})
+		}
+	}
+	return reqs
+}
+
+// mapDependencyToDependents enqueues the Recommendations waiting for a Recommendation they depend on,
+// so that they proceed as soon as it is created, completed or deleted.
+func (r *RecommendationReconciler) mapDependencyToDependents(ctx context.Context, obj client.Object) []reconcile.Request {
+	rcmdList := &api.RecommendationList{}
+	if err := r.Client.List(ctx, rcmdList, client.MatchingFields{api.RecommendationDependencyIndex: client.ObjectKeyFromObject(obj).String()}); err != nil {
+		klog.Errorf("failed to list the dependents of Recommendation %s/%s: %v", obj.GetNamespace(), obj.GetName(), err)
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, rc := range rcmdList.Items {
+		if rc.Status.Phase == api.Waiting && rc.Status.Reason == api.WaitingForDependency {
+			reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&rc)})
+		}
+	}
+	return reqs
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *RecommendationReconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&api.Recommendation{}).
-		WithEventFilter(predicate.Funcs{
+		For(&api.Recommendation{}, builder.WithPredicates(predicate.Funcs{
 			CreateFunc: func(e event.CreateEvent) bool {
 				return !meta_util.MustAlreadyReconciled(e.Object)
 			},
 			UpdateFunc: func(e event.UpdateEvent) bool {
-				return !meta_util.MustAlreadyReconciled(e.ObjectNew)
+				if e.ObjectNew.GetAnnotations()[api.ResetCircuitKey] == "true" {
+					return true
+				}
+				return e.ObjectNew.GetDeletionTimestamp() != nil || !meta_util.MustAlreadyReconciled(e.ObjectNew)
 			},
-		}).
+		})).
+		Watches(&api.MaintenanceWindow{}, handler.EnqueueRequestsFromMapFunc(r.mapWindowToWaitingRecommendations)).
+		Watches(&api.ClusterMaintenanceWindow{}, handler.EnqueueRequestsFromMapFunc(r.mapWindowToWaitingRecommendations)).
+		Watches(&api.MaintenanceFreeze{}, handler.EnqueueRequestsFromMapFunc(r.mapFreezeToFrozenRecommendations)).
+		Watches(&api.DowntimeBudget{}, handler.EnqueueRequestsFromMapFunc(r.mapBudgetToDeferredRecommendations)).
+		Watches(&api.Recommendation{}, handler.EnqueueRequestsFromMapFunc(r.mapDependencyToDependents)).
 		WithOptions(opts).
-		Complete(r)
+		Complete(r.Shard.Reconciler(r))
 }
@@ -0,0 +1,184 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/group"
+	"kubeops.dev/supervisor/pkg/sharding"
+
+	"github.com/jonboulle/clockwork"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	kmc "kmodules.xyz/client-go/client"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// RecommendationGroupReconciler reconciles a RecommendationGroup object
+type RecommendationGroupReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Clock  clockwork.Clock
+	// Shard is the subset of namespaces reconciled by this replica.
+	Shard sharding.Shard
+}
+
+//+kubebuilder:rbac:groups=supervisor.appscode.com,resources=recommendationgroups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=supervisor.appscode.com,resources=recommendationgroups/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=supervisor.appscode.com,resources=recommendationgroups/finalizers,verbs=update
+
+// Reconcile propagates the approval decision of a RecommendationGroup to its member Recommendations
+// and aggregates the phase of the members into the RecommendationGroup status.
+func (r *RecommendationGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	key := req.NamespacedName
+	klog.Info("got event for RecommendationGroup: ", key.String())
+
+	rg := &api.RecommendationGroup{}
+	if err := r.Client.Get(ctx, key, rg); err != nil {
+		klog.Infof("RecommendationGroup %q doesn't exist anymore", key.String())
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	members, err := group.ListMembers(ctx, r.Client, rg.Namespace, rg.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if rg.Status.ApprovalStatus == api.ApprovalApproved || rg.Status.ApprovalStatus == api.ApprovalRejected {
+		for i := range members {
+			if err := r.propagateApproval(ctx, rg, &members[i]); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	_, err = kmc.PatchStatus(ctx, r.Client, rg, func(obj client.Object) client.Object {
+		in := obj.(*api.RecommendationGroup)
+		in.Status.Members = int32(len(members))
+		in.Status.CanaryMembers = nil
+		if in.Spec.Canary != nil {
+			for _, rc := range group.CanaryMembers(members, in.Spec.Canary.Replicas) {
+				in.Status.CanaryMembers = append(in.Status.CanaryMembers, rc.Name)
+			}
+		}
+		in.Status.Phase = aggregatePhase(members)
+		in.Status.ObservedGeneration = in.Generation
+		return in
+	})
+	return ctrl.Result{}, err
+}
+
+// propagateApproval records the decision of the reviewer of the RecommendationGroup into the Pending member.
+// The reviewer of the group is recorded as the reviewer of the member, so that the admission webhooks
+// enforce the two-person rule and record the approval on behalf of that user instead of the operator.
+func (r *RecommendationGroupReconciler) propagateApproval(ctx context.Context, rg *api.RecommendationGroup, rcmd *api.Recommendation) error {
+	if rcmd.Status.ApprovalStatus != api.ApprovalPending && rcmd.Status.ApprovalStatus != "" {
+		return nil
+	}
+	_, err := kmc.PatchStatus(ctx, r.Client, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		in.Status.ApprovalStatus = rg.Status.ApprovalStatus
+		if rg.Status.ApprovalStatus == api.ApprovalApproved && rg.Status.ApprovedWindow != nil {
+			in.Status.ApprovedWindow = rg.Status.ApprovedWindow.DeepCopy()
+		}
+		if rg.Status.ApprovalStatus == api.ApprovalApproved && rg.Status.ApprovedBy != nil {
+			in.Status.ApprovedBy = rg.Status.ApprovedBy.DeepCopy()
+		}
+		if rg.Status.ApprovalStatus == api.ApprovalRejected {
+			in.Status.RejectionReason = api.RejectionReasonGroupRejected
+			in.Status.Comments = fmt.Sprintf("RecommendationGroup %s is Rejected", rg.Name)
+		}
+		if rg.Status.Reviewer != nil {
+			in.Status.Reviewer = rg.Status.Reviewer.DeepCopy()
+		}
+		in.Status.ReviewTimestamp = &metav1.Time{Time: r.Clock.Now().UTC()}
+		return in
+	})
+	return err
+}
+
+// aggregatePhase returns Failed if any of the members is Failed or Stalled, InProgress if any of the members is InProgress,
+// Succeeded if all of the members are Succeeded. Otherwise, Waiting is returned if any of the members is Waiting
+// and Pending if none of them is.
+func aggregatePhase(members []api.Recommendation) api.RecommendationPhase {
+	if len(members) == 0 {
+		return api.Pending
+	}
+
+	var failed, inProgress, waiting bool
+	succeeded := true
+	for _, rc := range members {
+		switch rc.Status.Phase {
+		case api.Failed, api.Stalled:
+			failed = true
+		case api.InProgress:
+			inProgress = true
+		case api.Waiting:
+			waiting = true
+		}
+		if rc.Status.Phase != api.Succeeded {
+			succeeded = false
+		}
+	}
+
+	switch {
+	case failed:
+		return api.Failed
+	case inProgress:
+		return api.InProgress
+	case succeeded:
+		return api.Succeeded
+	case waiting:
+		return api.Waiting
+	default:
+		return api.Pending
+	}
+}
+
+func (r *RecommendationGroupReconciler) mapRecommendationToGroup(_ context.Context, obj client.Object) []reconcile.Request {
+	rcmd, ok := obj.(*api.Recommendation)
+	if !ok || rcmd.Spec.GroupRef == nil {
+		return nil
+	}
+	return []reconcile.Request{
+		{
+			NamespacedName: types.NamespacedName{
+				Namespace: rcmd.Namespace,
+				Name:      rcmd.Spec.GroupRef.Name,
+			},
+		},
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RecommendationGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&api.RecommendationGroup{}).
+		Watches(&api.Recommendation{}, handler.EnqueueRequestsFromMapFunc(r.mapRecommendationToGroup)).
+		Complete(r.Shard.Reconciler(r))
+}
@@ -0,0 +1,199 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supervisor
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/sharding"
+
+	"github.com/jonboulle/clockwork"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	kmc "kmodules.xyz/client-go/client"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	defaultReportFailurePeriod = 24 * time.Hour
+	defaultReportMaxItems      = 10
+)
+
+// SupervisorReportReconciler reconciles a SupervisorReport object
+type SupervisorReportReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Clock  clockwork.Clock
+	// Shard is the subset of namespaces reconciled by this replica.
+	Shard sharding.Shard
+}
+
+//+kubebuilder:rbac:groups=supervisor.appscode.com,resources=supervisorreports,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=supervisor.appscode.com,resources=supervisorreports/status,verbs=get;update;patch
+
+// Reconcile summarizes the Recommendations and the MaintenanceExecutions of the namespace into the SupervisorReport status.
+func (r *SupervisorReportReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	key := req.NamespacedName
+	klog.Info("got event for SupervisorReport: ", key.String())
+
+	report := &api.SupervisorReport{}
+	if err := r.Client.Get(ctx, key, report); err != nil {
+		klog.Infof("SupervisorReport %q doesn't exist anymore", key.String())
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	period := report.Spec.FailurePeriod.Duration
+	if period <= 0 {
+		period = defaultReportFailurePeriod
+	}
+	maxItems := int(report.Spec.MaxItems)
+	if maxItems <= 0 {
+		maxItems = defaultReportMaxItems
+	}
+
+	rcmdList := &api.RecommendationList{}
+	if err := r.Client.List(ctx, rcmdList, client.InNamespace(report.Namespace)); err != nil {
+		return ctrl.Result{}, err
+	}
+	execList := &api.MaintenanceExecutionList{}
+	if err := r.Client.List(ctx, execList, client.InNamespace(report.Namespace)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	now := r.Clock.Now()
+	status := summarizeRecommendations(rcmdList.Items, maxItems)
+	status.RecentFailures = recentFailures(execList.Items, now.Add(-period), maxItems)
+
+	_, err := kmc.PatchStatus(ctx, r.Client, report, func(obj client.Object) client.Object {
+		in := obj.(*api.SupervisorReport)
+		in.Status.Total = status.Total
+		in.Status.PendingApproval = status.PendingApproval
+		in.Status.Phases = status.Phases
+		in.Status.UpcomingExecutions = status.UpcomingExecutions
+		in.Status.RecentFailures = status.RecentFailures
+		in.Status.LastUpdateTime = &metav1.Time{Time: now.UTC()}
+		in.Status.ObservedGeneration = in.Generation
+		return in
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// requeue when the oldest reported failure falls out of the failure period
+	if n := len(status.RecentFailures); n > 0 {
+		return ctrl.Result{RequeueAfter: status.RecentFailures[n-1].CompletionTime.Add(period).Sub(now)}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// summarizeRecommendations counts the Recommendations per phase and returns the first maxItems approved
+// Recommendations waiting for their windows.
+func summarizeRecommendations(rcmds []api.Recommendation, maxItems int) api.SupervisorReportStatus {
+	status := api.SupervisorReportStatus{Total: int32(len(rcmds))}
+	counts := map[api.RecommendationPhase]int32{}
+	for _, rcmd := range rcmds {
+		phase := rcmd.Status.Phase
+		if phase == "" {
+			phase = api.Pending
+		}
+		counts[phase]++
+		if rcmd.Status.ApprovalStatus == "" || rcmd.Status.ApprovalStatus == api.ApprovalPending {
+			status.PendingApproval++
+		}
+		if rcmd.Status.ApprovalStatus == api.ApprovalApproved && rcmd.Status.ScheduledWindow != nil &&
+			(phase == api.Pending || phase == api.Waiting) {
+			status.UpcomingExecutions = append(status.UpcomingExecutions, api.UpcomingExecution{
+				Recommendation: rcmd.Name,
+				Target:         rcmd.Spec.Target,
+				Window:         *rcmd.Status.ScheduledWindow.DeepCopy(),
+			})
+		}
+	}
+	for _, phase := range []api.RecommendationPhase{api.Pending, api.Waiting, api.InProgress, api.Succeeded, api.Failed, api.Stalled, api.Skipped} {
+		if counts[phase] > 0 {
+			status.Phases = append(status.Phases, api.PhaseCount{Phase: phase, Count: counts[phase]})
+		}
+	}
+
+	sort.SliceStable(status.UpcomingExecutions, func(i, j int) bool {
+		return status.UpcomingExecutions[i].Window.Start.Before(&status.UpcomingExecutions[j].Window.Start)
+	})
+	if len(status.UpcomingExecutions) > maxItems {
+		status.UpcomingExecutions = status.UpcomingExecutions[:maxItems]
+	}
+	return status
+}
+
+// recentFailures returns the latest maxItems executions failed after the given time, latest first.
+func recentFailures(execs []api.MaintenanceExecution, after time.Time, maxItems int) []api.ExecutionFailure {
+	var failures []api.ExecutionFailure
+	for _, exec := range execs {
+		if exec.Spec.Result != api.Failed && exec.Spec.Result != api.Stalled {
+			continue
+		}
+		if exec.Spec.CompletionTime == nil || !exec.Spec.CompletionTime.After(after) {
+			continue
+		}
+		failures = append(failures, api.ExecutionFailure{
+			Recommendation: exec.Spec.Recommendation.Name,
+			Target:         exec.Spec.Target,
+			Result:         exec.Spec.Result,
+			Reason:         exec.Spec.Reason,
+			CompletionTime: exec.Spec.CompletionTime.DeepCopy(),
+		})
+	}
+	sort.SliceStable(failures, func(i, j int) bool {
+		return failures[j].CompletionTime.Before(failures[i].CompletionTime)
+	})
+	if len(failures) > maxItems {
+		failures = failures[:maxItems]
+	}
+	return failures
+}
+
+// mapToReports enqueues every SupervisorReport of the namespace of the object.
+func (r *SupervisorReportReconciler) mapToReports(ctx context.Context, obj client.Object) []reconcile.Request {
+	reportList := &api.SupervisorReportList{}
+	if err := r.Client.List(ctx, reportList, client.InNamespace(obj.GetNamespace())); err != nil {
+		klog.Errorf("failed to list SupervisorReports of namespace %s: %v", obj.GetNamespace(), err)
+		return nil
+	}
+	reqs := make([]reconcile.Request, 0, len(reportList.Items))
+	for _, report := range reportList.Items {
+		reqs = append(reqs, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: report.Namespace, Name: report.Name},
+		})
+	}
+	return reqs
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SupervisorReportReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&api.SupervisorReport{}).
+		Watches(&api.Recommendation{}, handler.EnqueueRequestsFromMapFunc(r.mapToReports)).
+		Watches(&api.MaintenanceExecution{}, handler.EnqueueRequestsFromMapFunc(r.mapToReports)).
+		Complete(r.Shard.Reconciler(r))
+}
@@ -0,0 +1,74 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	authentication "k8s.io/api/authentication/v1"
+	authorization "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// authenticate returns the user of the bearer token of the request through a TokenReview.
+// As the calendar clients can't set the Authorization header of their subscriptions,
+// the calendar feed also accepts the token as the `token` query parameter.
+func (s *Server) authenticate(req *http.Request) (*authentication.UserInfo, error) {
+	token, found := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !found && strings.HasSuffix(req.URL.Path, "/"+calendarFeed) {
+		token = req.URL.Query().Get("token")
+		found = true
+	}
+	if !found || token == "" {
+		return nil, errors.New("bearer token is required")
+	}
+	review, err := s.kubeClient.AuthenticationV1().TokenReviews().Create(req.Context(), &authentication.TokenReview{
+		Spec: authentication.TokenReviewSpec{Token: token},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !review.Status.Authenticated {
+		return nil, errors.New("invalid bearer token")
+	}
+	return &review.Status.User, nil
+}
+
+// authorize returns true if the user is allowed to perform the verb on the Supervisor resource through a SubjectAccessReview.
+func (s *Server) authorize(ctx context.Context, user *authentication.UserInfo, attrs authorization.ResourceAttributes) (bool, error) {
+	extra := make(map[string]authorization.ExtraValue, len(user.Extra))
+	for k, val := range user.Extra {
+		extra[k] = authorization.ExtraValue(val)
+	}
+	sar := &authorization.SubjectAccessReview{
+		Spec: authorization.SubjectAccessReviewSpec{
+			ResourceAttributes: &attrs,
+			User:               user.Username,
+			Groups:             user.Groups,
+			Extra:              extra,
+			UID:                user.UID,
+		},
+	}
+	result, err := s.kubeClient.AuthorizationV1().SubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return result.Status.Allowed, nil
+}
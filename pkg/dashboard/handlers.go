@@ -0,0 +1,287 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/maintenance"
+	"kubeops.dev/supervisor/pkg/shared"
+	"kubeops.dev/supervisor/pkg/webhooks"
+
+	authentication "k8s.io/api/authentication/v1"
+	authorization "k8s.io/api/authorization/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// defaultCalendarPeriod is the period of the window calendar if the `to` parameter is not given.
+const defaultCalendarPeriod = 7 * 24 * time.Hour
+
+// RecommendationSummary is the dashboard view of a Recommendation.
+type RecommendationSummary struct {
+	Namespace         string                         `json:"namespace"`
+	Name              string                         `json:"name"`
+	Description       string                         `json:"description,omitempty"`
+	Target            core.TypedLocalObjectReference `json:"target"`
+	Severity          api.Severity                   `json:"severity,omitempty"`
+	ApprovalStatus    api.ApprovalStatus             `json:"approvalStatus,omitempty"`
+	Phase             api.RecommendationPhase        `json:"phase,omitempty"`
+	Reason            string                         `json:"reason,omitempty"`
+	Deadline          *metav1.Time                   `json:"deadline,omitempty"`
+	ScheduledWindow   *api.ScheduledWindow           `json:"scheduledWindow,omitempty"`
+	CreationTimestamp metav1.Time                    `json:"creationTimestamp"`
+}
+
+// ReviewRequest is the body of the approve and reject requests.
+type ReviewRequest struct {
+	Comments string `json:"comments,omitempty"`
+	// RejectionReason defaults to `Other` for the reject requests.
+	RejectionReason api.RejectionReason `json:"rejectionReason,omitempty"`
+}
+
+func (s *Server) listRecommendations(w http.ResponseWriter, req *http.Request, user *authentication.UserInfo) {
+	ns := req.URL.Query().Get("namespace")
+	if !s.allowed(w, req, user, authorization.ResourceAttributes{
+		Namespace: ns,
+		Verb:      "list",
+		Group:     api.GroupVersion.Group,
+		Resource:  api.ResourceRecommendations,
+	}) {
+		return
+	}
+
+	rcmdList := &api.RecommendationList{}
+	if err := s.kc.List(req.Context(), rcmdList, client.InNamespace(ns)); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	onlyPending := req.URL.Query().Get("pending") == "true"
+	items := make([]RecommendationSummary, 0, len(rcmdList.Items))
+	for _, rcmd := range rcmdList.Items {
+		if onlyPending && rcmd.Status.ApprovalStatus != "" && rcmd.Status.ApprovalStatus != api.ApprovalPending {
+			continue
+		}
+		items = append(items, RecommendationSummary{
+			Namespace:         rcmd.Namespace,
+			Name:              rcmd.Name,
+			Description:       rcmd.Spec.Description,
+			Target:            rcmd.Spec.Target,
+			Severity:          rcmd.Spec.Severity,
+			ApprovalStatus:    rcmd.Status.ApprovalStatus,
+			Phase:             rcmd.Status.Phase,
+			Reason:            rcmd.Status.Reason,
+			Deadline:          rcmd.Spec.Deadline,
+			ScheduledWindow:   rcmd.Status.ScheduledWindow,
+			CreationTimestamp: rcmd.CreationTimestamp,
+		})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].CreationTimestamp.Before(&items[j].CreationTimestamp)
+	})
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) review(w http.ResponseWriter, req *http.Request, user *authentication.UserInfo, ns, name, action string) {
+	if !s.allowed(w, req, user, authorization.ResourceAttributes{
+		Namespace: ns,
+		Verb:      webhooks.ApproveVerb,
+		Group:     api.GroupVersion.Group,
+		Resource:  api.ResourceRecommendations,
+		Name:      name,
+	}) {
+		return
+	}
+	body := ReviewRequest{}
+	if data, err := io.ReadAll(io.LimitReader(req.Body, 1<<20)); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	} else if len(data) > 0 {
+		if err = json.Unmarshal(data, &body); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	rcmd := &api.Recommendation{}
+	if err := s.kc.Get(req.Context(), client.ObjectKey{Namespace: ns, Name: name}, rcmd); err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if rcmd.Status.ApprovalStatus != api.ApprovalPending && rcmd.Status.ApprovalStatus != "" {
+		writeError(w, http.StatusConflict, fmt.Errorf("recommendation %s/%s is already %s", ns, name, rcmd.Status.ApprovalStatus))
+		return
+	}
+
+	status := api.ApprovalApproved
+	if action == "reject" {
+		status = api.ApprovalRejected
+		if body.RejectionReason == "" {
+			body.RejectionReason = api.RejectionReasonOther
+		}
+	} else {
+		forbidden, err := s.isTwoPersonRuleViolated(req, rcmd, user.Username)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		if forbidden {
+			writeError(w, http.StatusForbidden, fmt.Errorf("user %s created the recommendation and can't approve it", user.Username))
+			return
+		}
+	}
+	reviewer := api.Approval{Username: user.Username, Groups: user.Groups}
+	if err := shared.Review(req.Context(), s.kc, rcmd, status, reviewer, body.Comments, body.RejectionReason); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"namespace": ns, "name": name, "approvalStatus": string(status)})
+}
+
+// isTwoPersonRuleViolated returns true if the user created the Recommendation in a namespace where the two-person rule is enforced.
+func (s *Server) isTwoPersonRuleViolated(req *http.Request, rcmd *api.Recommendation, username string) (bool, error) {
+	if s.twoPersonRuleSelector == nil || s.twoPersonRuleSelector.Empty() || rcmd.Annotations[api.CreatedByKey] != username {
+		return false, nil
+	}
+	ns := &core.Namespace{}
+	if err := s.kc.Get(req.Context(), client.ObjectKey{Name: rcmd.Namespace}, ns); err != nil {
+		return false, err
+	}
+	return s.twoPersonRuleSelector.Matches(labels.Set(ns.Labels)), nil
+}
+
+// CalendarEntry is a window of a MaintenanceWindow or ClusterMaintenanceWindow in the window calendar.
+type CalendarEntry struct {
+	Kind      string      `json:"kind"`
+	Namespace string      `json:"namespace,omitempty"`
+	Name      string      `json:"name"`
+	Default   bool        `json:"default,omitempty"`
+	Start     metav1.Time `json:"start"`
+	End       metav1.Time `json:"end"`
+}
+
+func (s *Server) listWindows(w http.ResponseWriter, req *http.Request, user *authentication.UserInfo) {
+	entries, ok := s.calendar(w, req, user, defaultCalendarPeriod)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, entries)
+}
+
+// calendar returns the windows of the MaintenanceWindows of the requested namespace and the ClusterMaintenanceWindows
+// between the `from` and `to` parameters, sorted by their start time. If `to` is not given, the windows of the
+// defaultPeriod are returned. It writes the error response and returns false if the request fails.
+func (s *Server) calendar(w http.ResponseWriter, req *http.Request, user *authentication.UserInfo, defaultPeriod time.Duration) ([]CalendarEntry, bool) {
+	query := req.URL.Query()
+	ns := query.Get("namespace")
+	from, to := s.clock.Now(), time.Time{}
+	var err error
+	if v := query.Get("from"); v != "" {
+		if from, err = time.Parse(time.RFC3339, v); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid from: %w", err))
+			return nil, false
+		}
+	}
+	if v := query.Get("to"); v != "" {
+		if to, err = time.Parse(time.RFC3339, v); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid to: %w", err))
+			return nil, false
+		}
+	} else {
+		to = from.Add(defaultPeriod)
+	}
+
+	mwList := &api.MaintenanceWindowList{}
+	if ns != "" {
+		if !s.allowed(w, req, user, authorization.ResourceAttributes{
+			Namespace: ns,
+			Verb:      "list",
+			Group:     api.GroupVersion.Group,
+			Resource:  api.ResourceMaintenanceWindows,
+		}) {
+			return nil, false
+		}
+		if err = s.kc.List(req.Context(), mwList, client.InNamespace(ns)); err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return nil, false
+		}
+	}
+	if !s.allowed(w, req, user, authorization.ResourceAttributes{
+		Verb:     "list",
+		Group:    api.GroupVersion.Group,
+		Resource: api.ResourceClusterMaintenanceWindows,
+	}) {
+		return nil, false
+	}
+	cmwList := &api.ClusterMaintenanceWindowList{}
+	if err = s.kc.List(req.Context(), cmwList); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return nil, false
+	}
+	for _, cmw := range cmwList.Items {
+		mwList.Items = append(mwList.Items, api.MaintenanceWindow{ObjectMeta: cmw.ObjectMeta, Spec: cmw.Spec})
+	}
+
+	entries := make([]CalendarEntry, 0)
+	for _, mw := range mwList.Items {
+		windows, err := maintenance.Occurrences(mw, from, to)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, fmt.Errorf("failed to get the windows of %s: %w", mw.Name, err))
+			return nil, false
+		}
+		kind, isDefault := api.ResourceKindMaintenanceWindow, mw.Annotations[api.DefaultMaintenanceWindowKey] == "true"
+		if mw.Namespace == "" {
+			kind, isDefault = api.ResourceKindClusterMaintenanceWindow, mw.Annotations[api.DefaultClusterMaintenanceWindowKey] == "true"
+		}
+		for _, sw := range windows {
+			entries = append(entries, CalendarEntry{
+				Kind:      kind,
+				Namespace: mw.Namespace,
+				Name:      mw.Name,
+				Default:   isDefault,
+				Start:     sw.Start,
+				End:       sw.End,
+			})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Start.Before(&entries[j].Start)
+	})
+	return entries, true
+}
+
+// allowed writes the error response and returns false if the user isn't allowed to access the resource.
+func (s *Server) allowed(w http.ResponseWriter, req *http.Request, user *authentication.UserInfo, attrs authorization.ResourceAttributes) bool {
+	ok, err := s.authorize(req.Context(), user, attrs)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return false
+	}
+	if !ok {
+		writeError(w, http.StatusForbidden, fmt.Errorf("user %s can't %s %s", user.Username, attrs.Verb, attrs.Resource))
+		return false
+	}
+	return true
+}
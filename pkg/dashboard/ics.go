@@ -0,0 +1,115 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	authentication "k8s.io/api/authentication/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// calendarFeed is the path of the maintenance windows calendar in the iCalendar (RFC 5545) format.
+	calendarFeed = "windows.ics"
+	// defaultFeedPeriod is the period of the calendar feed if the `to` parameter is not given.
+	defaultFeedPeriod = 30 * 24 * time.Hour
+
+	icsTimeFormat = "20060102T150405Z"
+	icsLineLimit  = 75
+)
+
+// exportCalendar serves the maintenance windows as an iCalendar feed, so that the teams can subscribe to it
+// from their calendars and know when the changes may occur.
+func (s *Server) exportCalendar(w http.ResponseWriter, req *http.Request, user *authentication.UserInfo) {
+	entries, ok := s.calendar(w, req, user, defaultFeedPeriod)
+	if !ok {
+		return
+	}
+
+	name := "Cluster maintenance windows"
+	if ns := req.URL.Query().Get("namespace"); ns != "" {
+		name = fmt.Sprintf("Maintenance windows of %s", ns)
+	}
+	now := s.clock.Now().UTC().Format(icsTimeFormat)
+
+	var b strings.Builder
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//AppsCode//Supervisor//EN")
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+	writeICSLine(&b, "METHOD:PUBLISH")
+	writeICSLine(&b, "X-WR-CALNAME:"+escapeICSText(name))
+	for _, e := range entries {
+		summary := fmt.Sprintf("Maintenance window %s", e.Name)
+		description := fmt.Sprintf("%s %s", e.Kind, e.Name)
+		if e.Namespace != "" {
+			description = fmt.Sprintf("%s %s/%s", e.Kind, e.Namespace, e.Name)
+		}
+		if e.Default {
+			description += " (default)"
+		}
+
+		writeICSLine(&b, "BEGIN:VEVENT")
+		writeICSLine(&b, fmt.Sprintf("UID:%s-%s-%s-%s@%s", strings.ToLower(e.Kind), e.Namespace, e.Name,
+			e.Start.UTC().Format(icsTimeFormat), api.GroupVersion.Group))
+		writeICSLine(&b, "DTSTAMP:"+now)
+		writeICSLine(&b, "DTSTART:"+e.Start.UTC().Format(icsTimeFormat))
+		writeICSLine(&b, "DTEND:"+e.End.UTC().Format(icsTimeFormat))
+		writeICSLine(&b, "SUMMARY:"+escapeICSText(summary))
+		writeICSLine(&b, "DESCRIPTION:"+escapeICSText(description))
+		writeICSLine(&b, "TRANSP:TRANSPARENT")
+		writeICSLine(&b, "END:VEVENT")
+	}
+	writeICSLine(&b, "END:VCALENDAR")
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", calendarFeed))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(b.String())); err != nil {
+		klog.Errorf("failed to write the calendar feed: %v", err)
+	}
+}
+
+// writeICSLine writes the content line folded at 75 octets and terminated by CRLF, as required by RFC 5545.
+func writeICSLine(b *strings.Builder, line string) {
+	limit := icsLineLimit
+	for len(line) > limit {
+		cut := limit
+		// don't split a multi-byte character
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// the continuation lines start with a space
+		limit = icsLineLimit - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+// escapeICSText escapes the special characters of the TEXT values.
+func escapeICSText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
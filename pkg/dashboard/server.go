@@ -0,0 +1,142 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// APIPrefix is the path prefix of the endpoints of the dashboard API.
+const APIPrefix = "/api/v1/"
+
+// Server serves a small REST API for the web dashboards to list the pending Recommendations,
+// approve or reject them and show the calendar of the maintenance windows, without raw CRD access.
+// The requests are authenticated by their bearer tokens through TokenReviews, and authorized
+// through SubjectAccessReviews against the RBAC permissions of the users on the Supervisor resources.
+type Server struct {
+	addr                  string
+	certFile              string
+	keyFile               string
+	kc                    client.Client
+	kubeClient            kubernetes.Interface
+	clock                 clockwork.Clock
+	twoPersonRuleSelector labels.Selector
+}
+
+var _ manager.Runnable = &Server{}
+
+// NewServer returns the Server listening on the given address. It serves https if the certificate and key files are given.
+// In the namespaces matching the twoPersonRuleSelector, the creator of a Recommendation can't approve it.
+func NewServer(addr, certFile, keyFile string, kc client.Client, kubeClient kubernetes.Interface, clock clockwork.Clock, twoPersonRuleSelector labels.Selector) *Server {
+	return &Server{
+		addr:                  addr,
+		certFile:              certFile,
+		keyFile:               keyFile,
+		kc:                    kc,
+		kubeClient:            kubeClient,
+		clock:                 clock,
+		twoPersonRuleSelector: twoPersonRuleSelector,
+	}
+}
+
+// Start runs the http server until the context is done.
+func (s *Server) Start(ctx context.Context) error {
+	srv := &http.Server{
+		Addr:              s.addr,
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Shutdown(context.Background())
+	}()
+	klog.Infof("starting dashboard API server on %s", s.addr)
+	var err error
+	if s.certFile != "" {
+		err = srv.ListenAndServeTLS(s.certFile, s.keyFile)
+	} else {
+		err = srv.ListenAndServe()
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// ServeHTTP routes the requests:
+//
+//	GET  /api/v1/recommendations?namespace=<ns>&pending=true
+//	POST /api/v1/namespaces/<ns>/recommendations/<name>/approve
+//	POST /api/v1/namespaces/<ns>/recommendations/<name>/reject
+//	GET  /api/v1/windows?namespace=<ns>&from=<RFC3339>&to=<RFC3339>
+//	GET  /api/v1/windows.ics?namespace=<ns>&from=<RFC3339>&to=<RFC3339>
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if !strings.HasPrefix(req.URL.Path, APIPrefix) {
+		http.NotFound(w, req)
+		return
+	}
+	user, err := s.authenticate(req)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, APIPrefix), "/"), "/")
+	switch {
+	case len(parts) == 1 && parts[0] == "recommendations" && req.Method == http.MethodGet:
+		s.listRecommendations(w, req, user)
+	case len(parts) == 1 && parts[0] == "windows" && req.Method == http.MethodGet:
+		s.listWindows(w, req, user)
+	case len(parts) == 1 && parts[0] == calendarFeed && req.Method == http.MethodGet:
+		s.exportCalendar(w, req, user)
+	case len(parts) == 5 && parts[0] == "namespaces" && parts[2] == "recommendations" && req.Method == http.MethodPost:
+		switch parts[4] {
+		case "approve", "reject":
+			s.review(w, req, user, parts[1], parts[3], parts[4])
+		default:
+			http.NotFound(w, req)
+		}
+	case len(parts) == 1 || len(parts) == 5:
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		klog.Errorf("failed to write the dashboard API response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
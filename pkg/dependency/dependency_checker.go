@@ -0,0 +1,97 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dependency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	// ErrDependencyNotSucceeded is returned when a referred Recommendation can never be Succeeded.
+	ErrDependencyNotSucceeded = errors.New("dependency can't be succeeded")
+	// ErrDependencyCycle is returned when the Recommendation depends on itself through the referred Recommendations.
+	ErrDependencyCycle = errors.New("dependency cycle is detected")
+)
+
+type DependencyChecker struct {
+	ctx  context.Context
+	kc   client.Client
+	rcmd *api.Recommendation
+}
+
+func NewDependencyChecker(ctx context.Context, kc client.Client, rcmd *api.Recommendation) *DependencyChecker {
+	return &DependencyChecker{
+		ctx:  ctx,
+		kc:   kc,
+		rcmd: rcmd,
+	}
+}
+
+// IsDependencySatisfied returns true when all the Recommendations given in spec.dependsOn are Succeeded.
+// Otherwise, the message describes the dependency being waited for. A missing dependency is waited for too,
+// as it may be created later. It returns an error wrapping ErrDependencyNotSucceeded if any of them is Skipped
+// or terminally failed, and an error wrapping ErrDependencyCycle if the dependencies lead back to the Recommendation,
+// as such a cycle would be waited for forever.
+func (c *DependencyChecker) IsDependencySatisfied() (bool, string, error) {
+	cycle, err := c.rcmd.DependencyCycle(c.getDependency)
+	if err != nil {
+		return false, "", err
+	}
+	if cycle != nil {
+		return false, "", fmt.Errorf("%w: %s", ErrDependencyCycle, strings.Join(cycle, " -> "))
+	}
+
+	for _, ref := range c.rcmd.Spec.DependsOn {
+		key := c.rcmd.DependencyKey(ref)
+
+		dep := &api.Recommendation{}
+		if err := c.kc.Get(c.ctx, key, dep); kerr.IsNotFound(err) {
+			return false, fmt.Sprintf("Recommendation %s is not found", key.String()), nil
+		} else if err != nil {
+			return false, "", err
+		}
+
+		if dep.Status.Phase == api.Succeeded {
+			continue
+		}
+		if dep.Status.Phase == api.Skipped || dep.IsTerminallyFailed() {
+			return false, "", fmt.Errorf("%w: Recommendation %s is %s", ErrDependencyNotSucceeded, key.String(), dep.Status.Phase)
+		}
+		return false, fmt.Sprintf("Recommendation %s is not Succeeded yet", key.String()), nil
+	}
+	return true, "", nil
+}
+
+// getDependency returns the Recommendation of the given key, or nil if it is not found.
+func (c *DependencyChecker) getDependency(key types.NamespacedName) (*api.Recommendation, error) {
+	dep := &api.Recommendation{}
+	if err := c.kc.Get(c.ctx, key, dep); kerr.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return dep, nil
+}
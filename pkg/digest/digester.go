@@ -0,0 +1,161 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package digest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/maintenance"
+	"kubeops.dev/supervisor/pkg/notifier"
+
+	"github.com/jonboulle/clockwork"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Digester periodically sends a summary of the pending Recommendations and the upcoming
+// maintenance windows of each namespace through the Notifiers of the namespace.
+type Digester struct {
+	kc       client.Client
+	schedule *Schedule
+	clock    clockwork.Clock
+}
+
+var _ manager.Runnable = &Digester{}
+
+func NewDigester(kc client.Client, schedule *Schedule, clock clockwork.Clock) *Digester {
+	return &Digester{
+		kc:       kc,
+		schedule: schedule,
+		clock:    clock,
+	}
+}
+
+// Start sends the digests at the scheduled times until the context is done.
+func (d *Digester) Start(ctx context.Context) error {
+	for {
+		next := d.schedule.Next(d.clock.Now())
+		if next.IsZero() {
+			return fmt.Errorf("digest schedule has no upcoming time")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-d.clock.After(next.Sub(d.clock.Now())):
+			if err := d.Send(ctx); err != nil {
+				klog.Errorf("failed to send digests: %v", err)
+			}
+		}
+	}
+}
+
+// Send sends the digest of every namespace having a Notifier.
+func (d *Digester) Send(ctx context.Context) error {
+	notifiers := &api.NotifierList{}
+	if err := d.kc.List(ctx, notifiers); err != nil {
+		return err
+	}
+	namespaces := map[string]bool{}
+	for _, n := range notifiers.Items {
+		namespaces[n.Namespace] = true
+	}
+
+	cmwList := &api.ClusterMaintenanceWindowList{}
+	if err := d.kc.List(ctx, cmwList); err != nil {
+		return err
+	}
+	for ns := range namespaces {
+		text, err := d.summary(ctx, ns, cmwList.Items)
+		if err != nil {
+			return err
+		}
+		if text == "" {
+			continue
+		}
+		err = notifier.NotifyNamespace(ctx, d.kc, notifier.Message{
+			Event:     notifier.EventDigest,
+			Namespace: ns,
+			Text:      text,
+		})
+		if err != nil {
+			klog.Errorf("failed to send the digest of namespace %s: %v", ns, err)
+		}
+	}
+	return nil
+}
+
+// summary returns the digest text of the namespace. Empty string is returned if there is nothing to report.
+func (d *Digester) summary(ctx context.Context, ns string, cmws []api.ClusterMaintenanceWindow) (string, error) {
+	now := d.clock.Now()
+
+	rcmdList := &api.RecommendationList{}
+	if err := d.kc.List(ctx, rcmdList, client.InNamespace(ns)); err != nil {
+		return "", err
+	}
+	var pending []string
+	for _, rcmd := range rcmdList.Items {
+		if rcmd.Status.ApprovalStatus != "" && rcmd.Status.ApprovalStatus != api.ApprovalPending {
+			continue
+		}
+		pending = append(pending, fmt.Sprintf("- %s: %s %s, severity %s, pending for %s", rcmd.Name,
+			rcmd.Spec.Target.Kind, rcmd.Spec.Target.Name, rcmd.Spec.Severity, now.Sub(rcmd.CreationTimestamp.Time).Round(time.Minute)))
+	}
+
+	mwList := &api.MaintenanceWindowList{}
+	if err := d.kc.List(ctx, mwList, client.InNamespace(ns)); err != nil {
+		return "", err
+	}
+	for _, cmw := range cmws {
+		mwList.Items = append(mwList.Items, api.MaintenanceWindow{ObjectMeta: cmw.ObjectMeta, Spec: cmw.Spec})
+	}
+	var windows []string
+	for _, mw := range mwList.Items {
+		next, err := maintenance.NextWindow(mw, now)
+		if err != nil {
+			klog.Errorf("failed to get the next window of %s: %v", mw.Name, err)
+			continue
+		}
+		if next == nil {
+			continue
+		}
+		windows = append(windows, fmt.Sprintf("- %s %s: %s - %s", next.MaintenanceWindow.Kind, mw.Name,
+			next.Start.UTC().Format(time.RFC3339), next.End.UTC().Format(time.RFC3339)))
+	}
+
+	if len(pending) == 0 && len(windows) == 0 {
+		return "", nil
+	}
+	sort.Strings(pending)
+	sort.Strings(windows)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d Recommendation(s) pending for approval\n", len(pending))
+	for _, p := range pending {
+		sb.WriteString(p + "\n")
+	}
+	fmt.Fprintf(&sb, "\nUpcoming maintenance windows\n")
+	for _, w := range windows {
+		sb.WriteString(w + "\n")
+	}
+	return sb.String(), nil
+}
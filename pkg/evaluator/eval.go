@@ -82,6 +82,11 @@ func (e *Evaluator) EvaluateSuccessfulOperation() (*bool, error) {
 	return nil, nil
 }
 
+// EvaluateRule returns true if the given rule is satisfied by the object.
+func (e *Evaluator) EvaluateRule(rule string) (bool, error) {
+	return e.evaluateRule(rule)
+}
+
 func (e *Evaluator) evaluateRule(rule string) (bool, error) {
 	program, err := getProgramForRule(rule)
 	if err != nil {
@@ -0,0 +1,159 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package execution
+
+import (
+	"context"
+	"math"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"github.com/jonboulle/clockwork"
+	core "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type BudgetChecker struct {
+	ctx   context.Context
+	kc    client.Client
+	rcmd  *api.Recommendation
+	clock clockwork.Clock
+}
+
+func NewBudgetChecker(ctx context.Context, kc client.Client, rcmd *api.Recommendation, clock clockwork.Clock) *BudgetChecker {
+	return &BudgetChecker{
+		ctx:   ctx,
+		kc:    kc,
+		rcmd:  rcmd,
+		clock: clock,
+	}
+}
+
+// ExceededBudget returns the DowntimeBudget of the namespace whose remaining budget is shorter than the
+// expected downtime of the Recommendation along with its usage, if any. Both the completed executions and the in progress
+// Recommendations of the current period are counted against the budget.
+func (b *BudgetChecker) ExceededBudget() (*api.DowntimeBudget, *BudgetUsage, error) {
+	budgetList := &api.DowntimeBudgetList{}
+	if err := b.kc.List(b.ctx, budgetList, client.InNamespace(b.rcmd.Namespace)); err != nil {
+		return nil, nil, err
+	}
+	if len(budgetList.Items) == 0 {
+		return nil, nil, nil
+	}
+
+	meList := &api.MaintenanceExecutionList{}
+	if err := b.kc.List(b.ctx, meList, client.InNamespace(b.rcmd.Namespace)); err != nil {
+		return nil, nil, err
+	}
+	rcmdList := &api.RecommendationList{}
+	if err := b.kc.List(b.ctx, rcmdList, client.InNamespace(b.rcmd.Namespace)); err != nil {
+		return nil, nil, err
+	}
+
+	now := b.clock.Now()
+	required := ExpectedDowntime(b.rcmd)
+	for i, db := range budgetList.Items {
+		if !IsCoveredByBudget(&db, b.rcmd.Spec.Target) {
+			continue
+		}
+		usage := Usage(&db, meList.Items, now)
+		for _, rc := range rcmdList.Items {
+			if rc.Name != b.rcmd.Name && rc.Status.Phase == api.InProgress && IsCoveredByBudget(&db, rc.Spec.Target) {
+				usage.Used += ExpectedDowntime(&rc)
+			}
+		}
+		if usage.Used+required > usage.Budget {
+			return &budgetList.Items[i], &usage, nil
+		}
+	}
+	return nil, nil, nil
+}
+
+// BudgetUsage holds the disruption counted against a DowntimeBudget in its current period.
+type BudgetUsage struct {
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Budget      time.Duration
+	Used        time.Duration
+	Executions  int32
+}
+
+// UsedMinutes returns the used budget in minutes, rounded up.
+func (u BudgetUsage) UsedMinutes() int32 {
+	return int32(math.Ceil(u.Used.Minutes()))
+}
+
+// RemainingMinutes returns the remaining budget in minutes, rounded down.
+func (u BudgetUsage) RemainingMinutes() int32 {
+	if u.Used >= u.Budget {
+		return 0
+	}
+	return int32(math.Floor((u.Budget - u.Used).Minutes()))
+}
+
+// Usage sums the disruption of the executions started in the current calendar month counted against the DowntimeBudget.
+func Usage(db *api.DowntimeBudget, execs []api.MaintenanceExecution, now time.Time) BudgetUsage {
+	now = now.UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	usage := BudgetUsage{
+		PeriodStart: start,
+		PeriodEnd:   start.AddDate(0, 1, 0),
+		Budget:      time.Duration(db.Spec.MonthlyMinutes) * time.Minute,
+	}
+	for _, me := range execs {
+		if me.Namespace != db.Namespace || me.Spec.StartTime == nil || !IsCoveredByBudget(db, me.Spec.Target) {
+			continue
+		}
+		if me.Spec.StartTime.Time.Before(usage.PeriodStart) || !me.Spec.StartTime.Time.Before(usage.PeriodEnd) {
+			continue
+		}
+		usage.Executions++
+		usage.Used += disruption(me)
+	}
+	return usage
+}
+
+// ExpectedDowntime returns the expected downtime of the Recommendation if it is specified,
+// otherwise the estimated duration of its execution.
+func ExpectedDowntime(rcmd *api.Recommendation) time.Duration {
+	if rcmd.Spec.Impact != nil && rcmd.Spec.Impact.ExpectedDowntime != nil {
+		return rcmd.Spec.Impact.ExpectedDowntime.Duration
+	}
+	if rcmd.Status.EstimatedDuration != nil {
+		return rcmd.Status.EstimatedDuration.Duration.Duration
+	}
+	return 0
+}
+
+// IsCoveredByBudget returns true if the DowntimeBudget limits the disruption of the given target.
+func IsCoveredByBudget(db *api.DowntimeBudget, target core.TypedLocalObjectReference) bool {
+	if db.Spec.Target == nil {
+		return true
+	}
+	return api.IsSameTarget(*db.Spec.Target, target)
+}
+
+func disruption(me api.MaintenanceExecution) time.Duration {
+	if me.Spec.Downtime != nil {
+		return me.Spec.Downtime.Duration
+	}
+	if me.Spec.CompletionTime == nil {
+		return 0
+	}
+	return me.Spec.CompletionTime.Sub(me.Spec.StartTime.Time)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package execution
+
+import (
+	"context"
+	"sort"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	kmc "kmodules.xyz/client-go/client"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CircuitBreakerScope specifies the Recommendations whose failures open the circuit together.
+type CircuitBreakerScope string
+
+const (
+	// CircuitBreakerScopeTarget counts the failures of the Recommendations of the same target object.
+	CircuitBreakerScopeTarget CircuitBreakerScope = "Target"
+	// CircuitBreakerScopeNamespace counts the failures of the Recommendations of the same namespace.
+	CircuitBreakerScopeNamespace CircuitBreakerScope = "Namespace"
+)
+
+type CircuitBreaker struct {
+	ctx       context.Context
+	kc        client.Client
+	rcmd      *api.Recommendation
+	threshold int
+	scope     CircuitBreakerScope
+}
+
+func NewCircuitBreaker(ctx context.Context, kc client.Client, rcmd *api.Recommendation, threshold int, scope CircuitBreakerScope) *CircuitBreaker {
+	return &CircuitBreaker{
+		ctx:       ctx,
+		kc:        kc,
+		rcmd:      rcmd,
+		threshold: threshold,
+		scope:     scope,
+	}
+}
+
+// IsOpen returns true if the last `threshold` executions in the scope of the Recommendation are failed.
+// The failures recorded before the last reset of the circuit or a successful execution are not counted.
+func (b *CircuitBreaker) IsOpen() (bool, error) {
+	failures, err := b.consecutiveFailures()
+	if err != nil {
+		return false, err
+	}
+	return len(failures) >= b.threshold, nil
+}
+
+// Reset closes the circuit by marking the last counted failure, so that the failures completed until then are not
+// counted anymore. The mark is kept in the MaintenanceExecution, which outlives the Recommendations, so the reset
+// isn't lost when the Recommendation is deleted. The spec of the MaintenanceExecution is left untouched, as it is
+// the immutable history of the execution.
+func (b *CircuitBreaker) Reset() error {
+	failures, err := b.consecutiveFailures()
+	if err != nil || len(failures) == 0 {
+		return err
+	}
+	_, err = kmc.CreateOrPatch(b.ctx, b.kc, &failures[0], func(obj client.Object, createOp bool) client.Object {
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[api.CircuitResetKey] = "true"
+		obj.SetAnnotations(annotations)
+		return obj
+	})
+	return err
+}
+
+// consecutiveFailures returns the most recent failed MaintenanceExecutions in the scope of the Recommendation,
+// until a successful one or the last reset of the circuit.
+func (b *CircuitBreaker) consecutiveFailures() ([]api.MaintenanceExecution, error) {
+	meList := &api.MaintenanceExecutionList{}
+	if err := b.kc.List(b.ctx, meList, client.InNamespace(b.rcmd.Namespace)); err != nil {
+		return nil, err
+	}
+
+	executions := make([]api.MaintenanceExecution, 0, len(meList.Items))
+	for _, me := range meList.Items {
+		if me.Spec.CompletionTime == nil {
+			continue
+		}
+		if b.scope != CircuitBreakerScopeNamespace && !b.isSameTarget(&me) {
+			continue
+		}
+		executions = append(executions, me)
+	}
+	sort.Slice(executions, func(i, j int) bool {
+		return executions[i].Spec.CompletionTime.After(executions[j].Spec.CompletionTime.Time)
+	})
+
+	failures := make([]api.MaintenanceExecution, 0)
+	for _, me := range executions {
+		if me.Annotations[api.CircuitResetKey] == "true" {
+			break
+		}
+		if me.Spec.Result != api.Failed && me.Spec.Result != api.Stalled {
+			break
+		}
+		failures = append(failures, me)
+	}
+	return failures, nil
+}
+
+func (b *CircuitBreaker) isSameTarget(me *api.MaintenanceExecution) bool {
+	return api.IsSameTarget(me.Spec.Target, b.rcmd.Spec.Target)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package execution
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/shared"
+	"kubeops.dev/supervisor/pkg/target"
+
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// estimateSamples is the number of the latest successful executions the duration is estimated from.
+const estimateSamples = 10
+
+type DurationEstimator struct {
+	ctx  context.Context
+	kc   client.Client
+	rcmd *api.Recommendation
+}
+
+func NewDurationEstimator(ctx context.Context, kc client.Client, rcmd *api.Recommendation) *DurationEstimator {
+	return &DurationEstimator{
+		ctx:  ctx,
+		kc:   kc,
+		rcmd: rcmd,
+	}
+}
+
+// Estimate returns the rolling average duration of the latest successful executions of the same kind of
+// operation on the targets of the same kind and size as the Recommendation, in every namespace.
+// The number of replicas of the target is considered as its size.
+func (e *DurationEstimator) Estimate() (*api.DurationEstimate, error) {
+	gvk, err := shared.GetGVK(e.rcmd.Spec.Operation)
+	if err != nil {
+		return nil, err
+	}
+	opsType, err := shared.GetOperationType(e.rcmd.Spec.Operation)
+	if err != nil {
+		return nil, err
+	}
+	replicas, err := e.targetReplicas()
+	if err != nil {
+		return nil, err
+	}
+
+	meList := &api.MaintenanceExecutionList{}
+	if err := e.kc.List(e.ctx, meList); err != nil {
+		return nil, err
+	}
+	samples := make([]api.MaintenanceExecution, 0)
+	for _, me := range meList.Items {
+		ref := me.Spec.OperationRef
+		if me.Spec.Result != api.Succeeded || me.Spec.StartTime == nil || me.Spec.CompletionTime == nil || ref == nil ||
+			ref.APIGroup != gvk.Group || ref.Kind != gvk.Kind || me.Spec.OperationType != opsType ||
+			api.TargetGroup(me.Spec.Target.APIGroup) != api.TargetGroup(e.rcmd.Spec.Target.APIGroup) ||
+			me.Spec.Target.Kind != e.rcmd.Spec.Target.Kind || me.Spec.TargetReplicas != replicas {
+			continue
+		}
+		samples = append(samples, me)
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[j].Spec.CompletionTime.Before(samples[i].Spec.CompletionTime)
+	})
+	if len(samples) > estimateSamples {
+		samples = samples[:estimateSamples]
+	}
+
+	est := &api.DurationEstimate{
+		Samples:        int32(len(samples)),
+		TargetReplicas: replicas,
+	}
+	if len(samples) == 0 {
+		return est, nil
+	}
+	var total time.Duration
+	for _, me := range samples {
+		total += me.Spec.CompletionTime.Sub(me.Spec.StartTime.Time)
+	}
+	est.Duration = metav1.Duration{Duration: total / time.Duration(len(samples))}
+	return est, nil
+}
+
+// targetReplicas returns the `spec.replicas` of the target object, or zero(0) if it isn't set.
+func (e *DurationEstimator) targetReplicas() (int32, error) {
+	obj, err := target.GetTarget(e.ctx, e.kc, e.rcmd)
+	if kerr.IsNotFound(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	replicas, _, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	return int32(replicas), err
+}
@@ -0,0 +1,122 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package execution
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/shared"
+
+	"github.com/jonboulle/clockwork"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kmapi "kmodules.xyz/client-go/api/v1"
+	cutil "kmodules.xyz/client-go/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxRecordPrefixLength leaves room for the hash suffix within the maximum length of the object names.
+const maxRecordPrefixLength = 242
+
+type ExecutionRecorder struct {
+	ctx   context.Context
+	kc    client.Client
+	rcmd  *api.Recommendation
+	clock clockwork.Clock
+}
+
+func NewExecutionRecorder(ctx context.Context, kc client.Client, rcmd *api.Recommendation, clock clockwork.Clock) *ExecutionRecorder {
+	return &ExecutionRecorder{
+		ctx:   ctx,
+		kc:    kc,
+		rcmd:  rcmd,
+		clock: clock,
+	}
+}
+
+// Record creates the MaintenanceExecution of the Recommendation with the given result. The MaintenanceExecution
+// has no owner reference, so that it is kept after the Recommendation is deleted. It is never updated once created,
+// so the same result recorded again, e.g. by a repeated reconciliation, is ignored.
+func (e *ExecutionRecorder) Record(result api.RecommendationPhase, reason string) error {
+	me := &api.MaintenanceExecution{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.recordName(result),
+			Namespace: e.rcmd.Namespace,
+		},
+		Spec: e.buildSpec(result, reason),
+	}
+	return client.IgnoreAlreadyExists(e.kc.Create(e.ctx, me))
+}
+
+// recordName returns the name of the MaintenanceExecution recording the given result of the Recommendation.
+// The name is derived from the UID of the Recommendation, so that the records of a re-created Recommendation
+// of the same name never collide, and from its attempts, so that each execution is recorded separately.
+func (e *ExecutionRecorder) recordName(result api.RecommendationPhase) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%d/%s", e.rcmd.UID, e.rcmd.Status.FailedAttempt, result)))
+	name := e.rcmd.Name
+	if len(name) > maxRecordPrefixLength {
+		name = name[:maxRecordPrefixLength]
+	}
+	return fmt.Sprintf("%s-%x", strings.TrimSuffix(name, "-"), sum[:5])
+}
+
+func (e *ExecutionRecorder) buildSpec(result api.RecommendationPhase, reason string) api.MaintenanceExecutionSpec {
+	now := metav1.NewTime(e.clock.Now().UTC())
+	spec := api.MaintenanceExecutionSpec{
+		Recommendation:    core.LocalObjectReference{Name: e.rcmd.Name},
+		RecommendationUID: e.rcmd.UID,
+		Target:            e.rcmd.Spec.Target,
+		ApprovedWindow:    e.rcmd.Status.ApprovedWindow,
+		Approver:          e.rcmd.Status.Reviewer,
+		ApprovedBy:        e.rcmd.Status.ApprovedBy,
+		CompletionTime:    &now,
+		Result:            result,
+		Reason:            reason,
+		FailedAttempt:     e.rcmd.Status.FailedAttempt,
+	}
+	if _, cond := cutil.GetCondition(e.rcmd.Status.Conditions, api.SuccessfullyCreatedOperation); cond != nil {
+		spec.StartTime = &cond.LastTransitionTime
+	}
+	if w := e.rcmd.Status.ScheduledWindow; w != nil && spec.StartTime != nil &&
+		!spec.StartTime.Before(&w.Start) && (w.End.IsZero() || !w.End.Before(spec.StartTime)) {
+		spec.MaintenanceWindow = w.MaintenanceWindow
+	}
+	if est := e.rcmd.Status.EstimatedDuration; est != nil {
+		spec.TargetReplicas = est.TargetReplicas
+	}
+	if impact := e.rcmd.Spec.Impact; impact != nil {
+		spec.Downtime = impact.ExpectedDowntime
+	}
+	if opsType, err := shared.GetOperationType(e.rcmd.Spec.Operation); err == nil {
+		spec.OperationType = opsType
+	}
+	if e.rcmd.Status.CreatedOperationRef != nil {
+		if gvk, err := shared.GetGVK(e.rcmd.Spec.Operation); err == nil {
+			spec.OperationRef = &kmapi.TypedObjectReference{
+				APIGroup:  gvk.Group,
+				Kind:      gvk.Kind,
+				Namespace: e.rcmd.Namespace,
+				Name:      e.rcmd.Status.CreatedOperationRef.Name,
+			}
+		}
+	}
+	return spec
+}
@@ -0,0 +1,121 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/shared"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Executor executes the operation of a Recommendation.
+type Executor interface {
+	// CreateObject starts the execution of the operation with the given name.
+	CreateObject(name string) error
+	// CheckStatus returns true if the operation is succeeded, false if the operation is failed
+	// and nil if the operation is still running.
+	CheckStatus(name string) (*bool, error)
+	// Cleanup removes anything created for the operation with the given name.
+	Cleanup(name string) error
+}
+
+// Validator is implemented by the Executors which can validate the operation before creating it.
+type Validator interface {
+	Validate(name string) error
+}
+
+// Releaser is implemented by the Executors which hold the target while the operation is running,
+// e.g. by cordoning the Node. Release gives the target back without deleting the operation.
+type Releaser interface {
+	Release(name string) error
+}
+
+// Release releases the target held by the given Executor when its operation reaches a terminal outcome.
+// It is a no-op for the Executors which do not implement the Releaser.
+func Release(exec Executor, name string) error {
+	if r, ok := exec.(Releaser); ok {
+		return r.Release(name)
+	}
+	return nil
+}
+
+// RemoteCreator is implemented by the Executors whose CreateObject calls a remote system, e.g. opening a pull request
+// on GitHub. The execution slot of their operation is reserved first, and the operation is created afterwards outside
+// the lock of the execution limits. So CreateObject has to be safe to retry.
+type RemoteCreator interface {
+	CreatesRemotely() bool
+}
+
+// CreatesRemotely returns true if the operation of the given Executor is created in a remote system.
+func CreatesRemotely(exec Executor) bool {
+	if c, ok := exec.(RemoteCreator); ok {
+		return c.CreatesRemotely()
+	}
+	return false
+}
+
+// Factory returns an Executor for the given Recommendation.
+type Factory func(ctx context.Context, kc client.Client, rcmd *api.Recommendation) Executor
+
+var (
+	factories = map[schema.GroupVersionKind]Factory{}
+	mu        sync.RWMutex
+)
+
+// Register registers the Factory of the Executor to be used for the operations of the given GroupVersionKind.
+// The operations of an unregistered GroupVersionKind are executed by the UnstructuredExecutor,
+// or by the GitOpsExecutor if the GitOps mode is enabled.
+func Register(gvk schema.GroupVersionKind, f Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+	factories[gvk] = f
+}
+
+// New returns the registered Executor for the operation of the given Recommendation.
+func New(ctx context.Context, kc client.Client, rcmd *api.Recommendation) (Executor, error) {
+	gvk, err := shared.GetGVK(rcmd.Spec.Operation)
+	if err != nil {
+		return nil, err
+	}
+
+	mu.RLock()
+	f, found := factories[gvk]
+	repo := gitOpsRepository
+	mu.RUnlock()
+	if found {
+		return f(ctx, kc, rcmd), nil
+	}
+	if repo != nil {
+		return NewGitOpsExecutor(ctx, kc, rcmd, repo), nil
+	}
+	return NewUnstructuredExecutor(ctx, kc, rcmd), nil
+}
+
+// OperationName returns the deterministic name of the operation of the current attempt of the given Recommendation.
+// The same name is returned until the attempt is failed, so that an operation created before a restart of the
+// operator is adopted instead of creating a duplicate one.
+func OperationName(rcmd *api.Recommendation) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%d", rcmd.UID, rcmd.Status.FailedAttempt)))
+	return fmt.Sprintf("supervisor-%x", sum[:5])
+}
@@ -0,0 +1,136 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/gitops"
+
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+var gitOpsRepository *gitops.Repository
+
+// EnableGitOps makes the operations, which are not executed by a registered Executor, to be
+// executed through pull requests against the given Repository instead of creating them directly.
+func EnableGitOps(repo *gitops.Repository) {
+	mu.Lock()
+	defer mu.Unlock()
+	gitOpsRepository = repo
+}
+
+// GitOpsExecutor commits the operation object of a Recommendation to a Git repository and opens
+// a pull request, so that the operation is applied by ArgoCD or Flux once it is merged.
+// The status of the operation is evaluated after the operation object is applied to the cluster.
+type GitOpsExecutor struct {
+	*UnstructuredExecutor
+	repo *gitops.Repository
+}
+
+var (
+	_ Executor      = &GitOpsExecutor{}
+	_ Validator     = &GitOpsExecutor{}
+	_ RemoteCreator = &GitOpsExecutor{}
+)
+
+func NewGitOpsExecutor(ctx context.Context, kc client.Client, rcmd *api.Recommendation, repo *gitops.Repository) *GitOpsExecutor {
+	return &GitOpsExecutor{
+		UnstructuredExecutor: NewUnstructuredExecutor(ctx, kc, rcmd),
+		repo:                 repo,
+	}
+}
+
+// CreateObject opens the pull request adding the operation object with the given name.
+func (e *GitOpsExecutor) CreateObject(name string) error {
+	obj, err := e.buildObject(name)
+	if err != nil {
+		return err
+	}
+	content, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return err
+	}
+	path, err := e.repo.Path(gitops.PathData{
+		Namespace:      e.rcmd.Namespace,
+		Recommendation: e.rcmd.Name,
+		Target:         e.rcmd.Spec.Target,
+		Operation:      name,
+	})
+	if err != nil {
+		return err
+	}
+
+	url, err := e.repo.OpenPullRequest(e.ctx, gitops.PullRequest{
+		Branch:  fmt.Sprintf("supervisor/%s/%s", e.rcmd.Namespace, name),
+		Path:    path,
+		Content: content,
+		Title:   fmt.Sprintf("Execute Recommendation %s/%s", e.rcmd.Namespace, e.rcmd.Name),
+		Body:    e.pullRequestBody(obj.GetKind(), name),
+	})
+	if err != nil {
+		return err
+	}
+	klog.Infof("opened pull request %s for the operation %s of Recommendation %s/%s", url, name, e.rcmd.Namespace, e.rcmd.Name)
+	return nil
+}
+
+// CreatesRemotely returns true, as the pull request is opened through up to five calls to GitHub.
+func (e *GitOpsExecutor) CreatesRemotely() bool {
+	return true
+}
+
+// CheckStatus returns nil until the operation object is applied to the cluster.
+func (e *GitOpsExecutor) CheckStatus(name string) (*bool, error) {
+	status, err := e.UnstructuredExecutor.CheckStatus(name)
+	if kerr.IsNotFound(err) {
+		return nil, nil
+	}
+	return status, err
+}
+
+// Cleanup is a no-op, as the operation object is owned by the Git repository.
+// The pull request has to be closed or reverted in the Git repository.
+func (e *GitOpsExecutor) Cleanup(_ string) error {
+	return nil
+}
+
+func (e *GitOpsExecutor) pullRequestBody(kind, name string) string {
+	var sb strings.Builder
+	target := e.rcmd.Spec.Target
+	_, _ = fmt.Fprintf(&sb, "Recommendation: `%s/%s`\n", e.rcmd.Namespace, e.rcmd.Name)
+	_, _ = fmt.Fprintf(&sb, "Target: %s `%s/%s`\n", target.Kind, e.rcmd.Namespace, target.Name)
+	_, _ = fmt.Fprintf(&sb, "Operation: %s `%s`\n", kind, name)
+	if e.rcmd.Status.ApprovedBy != nil {
+		_, _ = fmt.Fprintf(&sb, "Approved by: %s at %s\n", e.rcmd.Status.ApprovedBy.Username, e.rcmd.Status.ApprovedBy.Timestamp.UTC())
+	} else if e.rcmd.Status.Comments != "" {
+		_, _ = fmt.Fprintf(&sb, "Approval: %s\n", e.rcmd.Status.Comments)
+	}
+	if w := e.rcmd.Status.ScheduledWindow; w != nil {
+		_, _ = fmt.Fprintf(&sb, "Maintenance window: %s - %s\n", w.Start.UTC(), w.End.UTC())
+	}
+	if e.rcmd.Spec.Description != "" {
+		_, _ = fmt.Fprintf(&sb, "\n%s\n", e.rcmd.Spec.Description)
+	}
+	return sb.String()
+}
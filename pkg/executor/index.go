@@ -0,0 +1,38 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+
+	core "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PodNodeNameIndex indexes the Pods by the name of the Node they are scheduled on.
+const PodNodeNameIndex = "spec.nodeName"
+
+// IndexPods registers the field indexes of the Pods, so that the pods of a Node are listed
+// from the cache without going through every Pod of the cluster.
+func IndexPods(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &core.Pod{}, PodNodeNameIndex, func(rawObj client.Object) []string {
+		if name := rawObj.(*core.Pod).Spec.NodeName; name != "" {
+			return []string{name}
+		}
+		return nil
+	})
+}
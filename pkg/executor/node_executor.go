@@ -0,0 +1,191 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"encoding/json"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/evaluator"
+
+	core "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kmc "kmodules.xyz/client-go/client"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const mirrorPodAnnotationKey = "kubernetes.io/config.mirror"
+
+func init() {
+	Register(api.GroupVersion.WithKind(api.ResourceKindNodeMaintenance), func(ctx context.Context, kc client.Client, rcmd *api.Recommendation) Executor {
+		return NewNodeExecutor(ctx, kc, rcmd)
+	})
+}
+
+// NodeExecutor executes the NodeMaintenance operations. It cordons and drains the target Node
+// of the Recommendation and uncordons it when the maintenance is completed.
+type NodeExecutor struct {
+	ctx  context.Context
+	kc   client.Client
+	rcmd *api.Recommendation
+}
+
+var (
+	_ Executor = &NodeExecutor{}
+	_ Releaser = &NodeExecutor{}
+)
+
+func NewNodeExecutor(ctx context.Context, kc client.Client, rcmd *api.Recommendation) *NodeExecutor {
+	return &NodeExecutor{
+		ctx:  ctx,
+		kc:   kc,
+		rcmd: rcmd,
+	}
+}
+
+// CreateObject cordons the target Node and starts evicting its pods.
+func (e *NodeExecutor) CreateObject(_ string) error {
+	if err := e.setUnschedulable(true); err != nil {
+		return err
+	}
+	_, err := e.drain()
+	return err
+}
+
+// CheckStatus keeps evicting the pods of the target Node until it is drained.
+// Then, the OperationPhaseRules are evaluated against the Node.
+func (e *NodeExecutor) CheckStatus(_ string) (*bool, error) {
+	drained, err := e.drain()
+	if err != nil || !drained {
+		return nil, err
+	}
+
+	node, err := e.getNode()
+	if err != nil {
+		return nil, err
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(node)
+	if err != nil {
+		return nil, err
+	}
+	success, err := evaluator.New(&unstructured.Unstructured{Object: content}, e.rcmd.Spec.Rules).EvaluateSuccessfulOperation()
+	if err != nil || success == nil || !*success {
+		return success, err
+	}
+	return success, e.setUnschedulable(false)
+}
+
+// Cleanup uncordons the target Node.
+func (e *NodeExecutor) Cleanup(name string) error {
+	return e.Release(name)
+}
+
+// Release uncordons the target Node, so that the Node is not left cordoned when the maintenance is
+// failed or stalled.
+func (e *NodeExecutor) Release(_ string) error {
+	return client.IgnoreNotFound(e.setUnschedulable(false))
+}
+
+func (e *NodeExecutor) getNode() (*core.Node, error) {
+	node := &core.Node{}
+	if err := e.kc.Get(e.ctx, client.ObjectKey{Name: e.rcmd.Spec.Target.Name}, node); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func (e *NodeExecutor) setUnschedulable(unschedulable bool) error {
+	node, err := e.getNode()
+	if err != nil {
+		return err
+	}
+	if node.Spec.Unschedulable == unschedulable {
+		return nil
+	}
+	_, err = kmc.CreateOrPatch(e.ctx, e.kc, node, func(obj client.Object, createOp bool) client.Object {
+		in := obj.(*core.Node)
+		in.Spec.Unschedulable = unschedulable
+		return in
+	})
+	return err
+}
+
+// drain evicts the pods of the target Node except the DaemonSet and mirror pods.
+// It returns true if no pod is left to evict. The pods protected by PodDisruptionBudgets are retried later.
+func (e *NodeExecutor) drain() (bool, error) {
+	spec, err := e.getSpec()
+	if err != nil {
+		return false, err
+	}
+
+	pods := &core.PodList{}
+	if err := e.kc.List(e.ctx, pods, client.MatchingFields{PodNodeNameIndex: e.rcmd.Spec.Target.Name}); err != nil {
+		return false, err
+	}
+
+	drained := true
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !isEvictable(pod) {
+			continue
+		}
+		drained = false
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		eviction := &policy.Eviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pod.Name,
+				Namespace: pod.Namespace,
+			},
+		}
+		if spec.GracePeriodSeconds != nil {
+			eviction.DeleteOptions = &metav1.DeleteOptions{GracePeriodSeconds: spec.GracePeriodSeconds}
+		}
+		err := e.kc.SubResource("eviction").Create(e.ctx, pod, eviction)
+		if err != nil && !kerr.IsNotFound(err) && !kerr.IsTooManyRequests(err) {
+			return false, err
+		}
+	}
+	return drained, nil
+}
+
+func (e *NodeExecutor) getSpec() (*api.NodeMaintenanceSpec, error) {
+	op := &api.NodeMaintenance{}
+	if err := json.Unmarshal(e.rcmd.Spec.Operation.Raw, op); err != nil {
+		return nil, err
+	}
+	return &op.Spec, nil
+}
+
+func isEvictable(pod *core.Pod) bool {
+	if pod.Status.Phase == core.PodSucceeded || pod.Status.Phase == core.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[mirrorPodAnnotationKey]; ok {
+		return false
+	}
+	if owner := metav1.GetControllerOf(pod); owner != nil && owner.Kind == "DaemonSet" {
+		return false
+	}
+	return true
+}
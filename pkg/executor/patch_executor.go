@@ -0,0 +1,108 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/evaluator"
+	"kubeops.dev/supervisor/pkg/target"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	Register(api.GroupVersion.WithKind(api.ResourceKindPatch), func(ctx context.Context, kc client.Client, rcmd *api.Recommendation) Executor {
+		return NewPatchExecutor(ctx, kc, rcmd)
+	})
+}
+
+// PatchExecutor executes the Patch operations. It applies the given patch to the target object of the Recommendation.
+type PatchExecutor struct {
+	ctx  context.Context
+	kc   client.Client
+	rcmd *api.Recommendation
+}
+
+var (
+	_ Executor  = &PatchExecutor{}
+	_ Validator = &PatchExecutor{}
+)
+
+func NewPatchExecutor(ctx context.Context, kc client.Client, rcmd *api.Recommendation) *PatchExecutor {
+	return &PatchExecutor{
+		ctx:  ctx,
+		kc:   kc,
+		rcmd: rcmd,
+	}
+}
+
+// Validate applies the patch to the target object in server-side dry-run mode.
+func (e *PatchExecutor) Validate(_ string) error {
+	return e.patch(client.DryRunAll)
+}
+
+// CreateObject applies the patch to the target object.
+func (e *PatchExecutor) CreateObject(_ string) error {
+	return e.patch()
+}
+
+// CheckStatus evaluates the OperationPhaseRules of the Recommendation against the target object.
+func (e *PatchExecutor) CheckStatus(_ string) (*bool, error) {
+	obj, err := target.GetTarget(e.ctx, e.kc, e.rcmd)
+	if err != nil {
+		return nil, err
+	}
+	return evaluator.New(obj, e.rcmd.Spec.Rules).EvaluateSuccessfulOperation()
+}
+
+// Cleanup does nothing as an applied patch can't be reverted.
+func (e *PatchExecutor) Cleanup(_ string) error {
+	return nil
+}
+
+func (e *PatchExecutor) patch(opts ...client.PatchOption) error {
+	operation, err := renderOperation(e.ctx, e.kc, e.rcmd)
+	if err != nil {
+		return err
+	}
+	op := &api.Patch{}
+	if err := json.Unmarshal(operation.Raw, op); err != nil {
+		return err
+	}
+	var patchType types.PatchType
+	switch op.Spec.Type {
+	case api.JSONPatch:
+		patchType = types.JSONPatchType
+	case api.StrategicMergePatch:
+		patchType = types.StrategicMergePatchType
+	case api.MergePatch, "":
+		patchType = types.MergePatchType
+	default:
+		return fmt.Errorf("unknown patch type %s", op.Spec.Type)
+	}
+
+	obj, err := target.GetTarget(e.ctx, e.kc, e.rcmd)
+	if err != nil {
+		return err
+	}
+	return e.kc.Patch(e.ctx, obj, client.RawPatch(patchType, []byte(op.Spec.Patch)), opts...)
+}
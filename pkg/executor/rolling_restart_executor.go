@@ -0,0 +1,174 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"github.com/jonboulle/clockwork"
+	"gomodules.xyz/pointer"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	kmc "kmodules.xyz/client-go/client"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	restartedAtAnnotationKey = "kubectl.kubernetes.io/restartedAt"
+	// restartedByAnnotationKey records the operation restarting the pod template, so that the rollout
+	// of the operation is not confused with the previous rollout while the cache is not synced yet.
+	restartedByAnnotationKey = "supervisor.appscode.com/restarted-by"
+	progressDeadlineExceeded = "ProgressDeadlineExceeded"
+)
+
+func init() {
+	Register(api.GroupVersion.WithKind(api.ResourceKindRollingRestart), func(ctx context.Context, kc client.Client, rcmd *api.Recommendation) Executor {
+		return NewRollingRestartExecutor(ctx, kc, rcmd, api.GetClock())
+	})
+}
+
+// RollingRestartExecutor executes the RollingRestart operations. It restarts the target workload
+// of the Recommendation by updating the restart annotation of its pod template.
+type RollingRestartExecutor struct {
+	ctx   context.Context
+	kc    client.Client
+	rcmd  *api.Recommendation
+	clock clockwork.Clock
+}
+
+var _ Executor = &RollingRestartExecutor{}
+
+func NewRollingRestartExecutor(ctx context.Context, kc client.Client, rcmd *api.Recommendation, clock clockwork.Clock) *RollingRestartExecutor {
+	return &RollingRestartExecutor{
+		ctx:   ctx,
+		kc:    kc,
+		rcmd:  rcmd,
+		clock: clock,
+	}
+}
+
+// CreateObject triggers the rolling restart of the target workload.
+func (e *RollingRestartExecutor) CreateObject(name string) error {
+	obj, err := e.getWorkload()
+	if err != nil {
+		return err
+	}
+	_, err = kmc.CreateOrPatch(e.ctx, e.kc, obj, func(obj client.Object, createOp bool) client.Object {
+		tmpl := podTemplateOf(obj)
+		if tmpl.Annotations == nil {
+			tmpl.Annotations = map[string]string{}
+		}
+		tmpl.Annotations[restartedAtAnnotationKey] = e.clock.Now().UTC().Format(time.RFC3339)
+		tmpl.Annotations[restartedByAnnotationKey] = name
+		return obj
+	})
+	return err
+}
+
+// CheckStatus returns true when the rollout of the target workload is completed.
+// False is returned if the progress deadline of a Deployment is exceeded.
+// The rollout is considered only after the restart of the operation is observed in the cache,
+// so that the generation of the restarted pod template is the one checked against the ObservedGeneration.
+func (e *RollingRestartExecutor) CheckStatus(name string) (*bool, error) {
+	obj, err := e.getWorkload()
+	if err != nil {
+		return nil, err
+	}
+	if podTemplateOf(obj).Annotations[restartedByAnnotationKey] != name {
+		return nil, nil
+	}
+
+	var completed, failed bool
+	switch w := obj.(type) {
+	case *apps.Deployment:
+		replicas := replicasOf(w.Spec.Replicas)
+		completed = w.Status.ObservedGeneration >= w.Generation &&
+			w.Status.UpdatedReplicas == replicas &&
+			w.Status.AvailableReplicas == replicas &&
+			w.Status.Replicas == replicas
+		for _, cond := range w.Status.Conditions {
+			if cond.Type == apps.DeploymentProgressing && cond.Status == core.ConditionFalse && cond.Reason == progressDeadlineExceeded {
+				failed = true
+			}
+		}
+	case *apps.StatefulSet:
+		replicas := replicasOf(w.Spec.Replicas)
+		completed = w.Status.ObservedGeneration >= w.Generation &&
+			w.Status.UpdateRevision == w.Status.CurrentRevision &&
+			w.Status.UpdatedReplicas == replicas &&
+			w.Status.ReadyReplicas == replicas
+	case *apps.DaemonSet:
+		completed = w.Status.ObservedGeneration >= w.Generation &&
+			w.Status.UpdatedNumberScheduled == w.Status.DesiredNumberScheduled &&
+			w.Status.NumberAvailable == w.Status.DesiredNumberScheduled
+	}
+
+	if completed {
+		return pointer.BoolP(true), nil
+	}
+	if failed {
+		return pointer.BoolP(false), nil
+	}
+	return nil, nil
+}
+
+// Cleanup does nothing as a restart can't be reverted.
+func (e *RollingRestartExecutor) Cleanup(_ string) error {
+	return nil
+}
+
+func (e *RollingRestartExecutor) getWorkload() (client.Object, error) {
+	var obj client.Object
+	switch e.rcmd.Spec.Target.Kind {
+	case "Deployment":
+		obj = &apps.Deployment{}
+	case "StatefulSet":
+		obj = &apps.StatefulSet{}
+	case "DaemonSet":
+		obj = &apps.DaemonSet{}
+	default:
+		return nil, fmt.Errorf("rolling restart is not supported for kind %s", e.rcmd.Spec.Target.Kind)
+	}
+	key := client.ObjectKey{Namespace: e.rcmd.Namespace, Name: e.rcmd.Spec.Target.Name}
+	if err := e.kc.Get(e.ctx, key, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func podTemplateOf(obj client.Object) *core.PodTemplateSpec {
+	switch w := obj.(type) {
+	case *apps.Deployment:
+		return &w.Spec.Template
+	case *apps.StatefulSet:
+		return &w.Spec.Template
+	case *apps.DaemonSet:
+		return &w.Spec.Template
+	}
+	return &core.PodTemplateSpec{}
+}
+
+func replicasOf(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
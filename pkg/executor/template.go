@@ -0,0 +1,97 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"bytes"
+	"context"
+	"text/template"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/target"
+
+	sprig "github.com/go-task/slim-sprig"
+	"gomodules.xyz/pointer"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TemplateTarget holds the identity of the target resource available to the operation templates.
+type TemplateTarget struct {
+	APIGroup string
+	Kind     string
+	Name     string
+}
+
+// TemplateData holds the variables available to the operation templates, e.g. `{{ .Target.Name }}`.
+type TemplateData struct {
+	Namespace          string
+	Recommendation     string
+	Target             TemplateTarget
+	CurrentVersion     string
+	RecommendedVersion string
+}
+
+// renderOperation returns the operation of the Recommendation with its template variables resolved.
+// The variables are resolved against the current state of the target at execution time, so that the same
+// operation is valid across the targets. Operations without any template action are returned as it is.
+func renderOperation(ctx context.Context, kc client.Client, rcmd *api.Recommendation) (runtime.RawExtension, error) {
+	if !bytes.Contains(rcmd.Spec.Operation.Raw, []byte("{{")) {
+		return rcmd.Spec.Operation, nil
+	}
+
+	// The hermetic functions don't expose the environment of the operator to the creators of the Recommendations.
+	t, err := template.New("operation").Funcs(sprig.HermeticTxtFuncMap()).Option("missingkey=error").Parse(string(rcmd.Spec.Operation.Raw))
+	if err != nil {
+		return runtime.RawExtension{}, err
+	}
+	data, err := newTemplateData(ctx, kc, rcmd)
+	if err != nil {
+		return runtime.RawExtension{}, err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return runtime.RawExtension{}, err
+	}
+	return runtime.RawExtension{Raw: buf.Bytes()}, nil
+}
+
+// newTemplateData returns the TemplateData of the Recommendation. The current version is read from
+// the `spec.version` field of the target resource, if it still exists.
+func newTemplateData(ctx context.Context, kc client.Client, rcmd *api.Recommendation) (*TemplateData, error) {
+	data := &TemplateData{
+		Namespace:      rcmd.Namespace,
+		Recommendation: rcmd.Name,
+		Target: TemplateTarget{
+			APIGroup: pointer.String(rcmd.Spec.Target.APIGroup),
+			Kind:     rcmd.Spec.Target.Kind,
+			Name:     rcmd.Spec.Target.Name,
+		},
+		RecommendedVersion: rcmd.Spec.RecommendedVersion,
+	}
+
+	obj, err := target.GetTarget(ctx, kc, rcmd)
+	if kerr.IsNotFound(err) {
+		return data, nil
+	} else if err != nil {
+		return nil, err
+	}
+	data.CurrentVersion, _, _ = unstructured.NestedString(obj.Object, "spec", "version")
+	return data, nil
+}
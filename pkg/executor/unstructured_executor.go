@@ -0,0 +1,168 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/evaluator"
+	"kubeops.dev/supervisor/pkg/shared"
+	"kubeops.dev/supervisor/pkg/tracing"
+
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// UnstructuredExecutor executes the operation of a Recommendation as an unstructured object.
+// So, the operation can be any kind of kubernetes object e.g. KubeDB OpsRequest, Stash RestoreSession
+// or any custom resource.
+type UnstructuredExecutor struct {
+	ctx  context.Context
+	kc   client.Client
+	rcmd *api.Recommendation
+}
+
+var (
+	_ Executor  = &UnstructuredExecutor{}
+	_ Validator = &UnstructuredExecutor{}
+)
+
+func NewUnstructuredExecutor(ctx context.Context, kc client.Client, rcmd *api.Recommendation) *UnstructuredExecutor {
+	return &UnstructuredExecutor{
+		ctx:  ctx,
+		kc:   kc,
+		rcmd: rcmd,
+	}
+}
+
+// Validate creates the operation object with the given name in server-side dry-run mode,
+// so that the invalid operations are reported before executing them.
+// An already existing operation object is left to CreateObject to adopt.
+func (e *UnstructuredExecutor) Validate(name string) error {
+	obj, err := e.buildObject(name)
+	if err != nil {
+		return err
+	}
+	if err := e.kc.Create(e.ctx, obj, client.DryRunAll); !kerr.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// CreateObject creates the operation object with the given name.
+// If the operation object already exists for the Recommendation, e.g. it is created before a restart
+// of the operator but not recorded in the Recommendation status, the existing object is adopted.
+func (e *UnstructuredExecutor) CreateObject(name string) error {
+	obj, err := e.buildObject(name)
+	if err != nil {
+		return err
+	}
+	e.setOwnerReference(obj)
+	err = e.kc.Create(e.ctx, obj)
+	if !kerr.IsAlreadyExists(err) {
+		return err
+	}
+
+	existing, gErr := e.GetObject(name)
+	if gErr != nil {
+		return gErr
+	}
+	if !e.isOwnedOperation(existing) {
+		return err
+	}
+	klog.Infof("adopted the existing operation %s of Recommendation %s/%s", name, e.rcmd.Namespace, e.rcmd.Name)
+	return nil
+}
+
+// isOwnedOperation returns true if the given operation object is created for the Recommendation.
+// The cluster scoped operations are identified by their label, as they have no owner reference.
+func (e *UnstructuredExecutor) isOwnedOperation(obj *unstructured.Unstructured) bool {
+	return metav1.IsControlledBy(obj, e.rcmd) || obj.GetLabels()[api.RecommendationKey] == e.rcmd.Name
+}
+
+// CheckStatus evaluates the OperationPhaseRules of the Recommendation against the created operation object.
+func (e *UnstructuredExecutor) CheckStatus(name string) (*bool, error) {
+	obj, err := e.GetObject(name)
+	if err != nil {
+		return nil, err
+	}
+	return evaluator.New(obj, e.rcmd.Spec.Rules).EvaluateSuccessfulOperation()
+}
+
+// GetObject returns the created operation object of the given name.
+func (e *UnstructuredExecutor) GetObject(name string) (*unstructured.Unstructured, error) {
+	obj, err := e.buildObject(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.kc.Get(e.ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// Cleanup deletes the created operation object of the given name, if exists.
+func (e *UnstructuredExecutor) Cleanup(name string) error {
+	obj, err := e.buildObject(name)
+	if err != nil {
+		return err
+	}
+	return client.IgnoreNotFound(e.kc.Delete(e.ctx, obj))
+}
+
+// buildObject returns the operation object with the given name and its template variables resolved. Namespaced operations are always
+// created in the namespace of the Recommendation and cluster scoped operations have no namespace.
+func (e *UnstructuredExecutor) buildObject(name string) (*unstructured.Unstructured, error) {
+	operation, err := renderOperation(e.ctx, e.kc, e.rcmd)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := shared.GetUnstructuredObj(operation)
+	if err != nil {
+		return nil, err
+	}
+	gvk := obj.GroupVersionKind()
+	mapping, err := e.kc.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	obj.SetName(name)
+	tracing.SetAnnotations(e.ctx, obj)
+	shared.SetTrackingMetadata(obj, e.rcmd)
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		obj.SetNamespace(e.rcmd.Namespace)
+	} else {
+		obj.SetNamespace("")
+	}
+	return obj, nil
+}
+
+// setOwnerReference makes the Recommendation the controller of the operation object created directly in the cluster.
+// The owner reference can't refer to the Recommendation from the cluster scoped operations. The operations committed
+// to Git have no owner reference either, as the UID of the Recommendation is meaningless outside of the cluster.
+func (e *UnstructuredExecutor) setOwnerReference(obj *unstructured.Unstructured) {
+	if obj.GetNamespace() == "" {
+		return
+	}
+	obj.SetOwnerReferences(append(obj.GetOwnerReferences(), *metav1.NewControllerRef(e.rcmd, api.GroupVersion.WithKind(api.ResourceKindRecommendation))))
+}
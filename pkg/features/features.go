@@ -0,0 +1,63 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/component-base/featuregate"
+)
+
+const (
+	// CanaryRollout enables the canary and the rollout strategies of the RecommendationGroups.
+	// If disabled, the members of a group are executed as soon as the group is approved.
+	CanaryRollout featuregate.Feature = "CanaryRollout"
+
+	// Notifications enables sending the Recommendation events and the digest through the Notifiers.
+	Notifications featuregate.Feature = "Notifications"
+
+	// GitOpsMode enables opening pull requests of the operations against a GitOps repository.
+	GitOpsMode featuregate.Feature = "GitOpsMode"
+
+	// AutoRecommenders enables the recommenders creating Recommendations for the KubeDB databases.
+	AutoRecommenders featuregate.Feature = "AutoRecommenders"
+)
+
+// DefaultMutableFeatureGate is the feature gate of the operator, set by the --feature-gates flag.
+// It is kept apart from the feature gate of the generic apiserver, so only the features of the
+// operator are accepted by the flag.
+var DefaultMutableFeatureGate featuregate.MutableFeatureGate = featuregate.NewFeatureGate()
+
+// DefaultFeatureGate is the read only view of DefaultMutableFeatureGate.
+var DefaultFeatureGate featuregate.FeatureGate = DefaultMutableFeatureGate
+
+// defaultFeatureGates holds the default state of the features. The subsystems shipped before the
+// feature gates were introduced are Beta and enabled by default, new ones should start as disabled Alpha.
+var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
+	CanaryRollout:    {Default: true, PreRelease: featuregate.Beta},
+	Notifications:    {Default: true, PreRelease: featuregate.Beta},
+	GitOpsMode:       {Default: true, PreRelease: featuregate.Beta},
+	AutoRecommenders: {Default: true, PreRelease: featuregate.Beta},
+}
+
+func init() {
+	runtime.Must(DefaultMutableFeatureGate.Add(defaultFeatureGates))
+}
+
+// Enabled returns true if the feature is enabled in DefaultFeatureGate.
+func Enabled(f featuregate.Feature) bool {
+	return DefaultFeatureGate.Enabled(f)
+}
@@ -0,0 +1,150 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flux
+
+import (
+	"context"
+	"fmt"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/target"
+
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// owner specifies the labels set by a Flux controller on the objects it manages.
+type owner struct {
+	gk           schema.GroupKind
+	nameKey      string
+	namespaceKey string
+}
+
+// The HelmReleases are checked first, as a HelmRelease itself may be managed by a Kustomization.
+var owners = []owner{
+	{
+		gk:           schema.GroupKind{Group: "helm.toolkit.fluxcd.io", Kind: "HelmRelease"},
+		nameKey:      "helm.toolkit.fluxcd.io/name",
+		namespaceKey: "helm.toolkit.fluxcd.io/namespace",
+	},
+	{
+		gk:           schema.GroupKind{Group: "kustomize.toolkit.fluxcd.io", Kind: "Kustomization"},
+		nameKey:      "kustomize.toolkit.fluxcd.io/name",
+		namespaceKey: "kustomize.toolkit.fluxcd.io/namespace",
+	},
+}
+
+// Suspender suspends the Flux Kustomization or HelmRelease managing the target of a Recommendation
+// during the execution, so that Flux doesn't revert the changes of the operation.
+type Suspender struct {
+	ctx  context.Context
+	kc   client.Client
+	rcmd *api.Recommendation
+}
+
+func NewSuspender(ctx context.Context, kc client.Client, rcmd *api.Recommendation) *Suspender {
+	return &Suspender{
+		ctx:  ctx,
+		kc:   kc,
+		rcmd: rcmd,
+	}
+}
+
+// Suspend suspends the Flux object managing the target, if any, and marks it as suspended by the Recommendation.
+// The Flux objects which are already suspended by others are left untouched.
+func (s *Suspender) Suspend() error {
+	obj, err := s.getOwner()
+	if err != nil || obj == nil {
+		return err
+	}
+	if suspended, _, _ := unstructured.NestedBool(obj.Object, "spec", "suspend"); suspended {
+		return nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopy())
+	if err = unstructured.SetNestedField(obj.Object, true, "spec", "suspend"); err != nil {
+		return err
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[api.SuspendedByKey] = s.suspender()
+	obj.SetAnnotations(annotations)
+	return s.kc.Patch(s.ctx, obj, patch)
+}
+
+// Resume resumes the Flux object managing the target, if it is suspended by the Recommendation.
+func (s *Suspender) Resume() error {
+	obj, err := s.getOwner()
+	if err != nil || obj == nil {
+		return client.IgnoreNotFound(err)
+	}
+	if obj.GetAnnotations()[api.SuspendedByKey] != s.suspender() {
+		return nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopy())
+	if err = unstructured.SetNestedField(obj.Object, false, "spec", "suspend"); err != nil {
+		return err
+	}
+	annotations := obj.GetAnnotations()
+	delete(annotations, api.SuspendedByKey)
+	obj.SetAnnotations(annotations)
+	return s.kc.Patch(s.ctx, obj, patch)
+}
+
+func (s *Suspender) suspender() string {
+	return fmt.Sprintf("%s/%s", s.rcmd.Namespace, s.rcmd.Name)
+}
+
+// getOwner returns the Flux object managing the target. Nil is returned if the target is not managed
+// by Flux or the Flux object doesn't exist.
+func (s *Suspender) getOwner() (*unstructured.Unstructured, error) {
+	targetObj, err := target.GetTarget(s.ctx, s.kc, s.rcmd)
+	if err != nil {
+		return nil, err
+	}
+	labels := targetObj.GetLabels()
+	for _, o := range owners {
+		name, ok := labels[o.nameKey]
+		if !ok {
+			continue
+		}
+		mapping, err := s.kc.RESTMapper().RESTMapping(o.gk)
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, err
+		}
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(mapping.GroupVersionKind)
+		key := client.ObjectKey{Namespace: labels[o.namespaceKey], Name: name}
+		if err = s.kc.Get(s.ctx, key, obj); err != nil {
+			if kerr.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		return obj, nil
+	}
+	return nil, nil
+}
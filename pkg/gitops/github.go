@@ -0,0 +1,170 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitops
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	core "k8s.io/api/core/v1"
+)
+
+const DefaultPathTemplate = "{{ .Namespace }}/{{ .Target.Name }}/{{ .Operation }}.yaml"
+
+// Repository opens pull requests against a GitHub repository, so that the changes are applied
+// to the cluster by ArgoCD or Flux once they are merged.
+type Repository struct {
+	apiURL string
+	repo   string
+	branch string
+	token  string
+	path   *template.Template
+	client *http.Client
+}
+
+// PathData holds the fields available to the path template of the Repository.
+type PathData struct {
+	Namespace      string
+	Recommendation string
+	Target         core.TypedLocalObjectReference
+	Operation      string
+}
+
+// PullRequest specifies a file to commit in a new branch and the pull request of the branch.
+type PullRequest struct {
+	Branch  string
+	Path    string
+	Content []byte
+	Title   string
+	Body    string
+}
+
+// NewRepository returns the Repository of the given `owner/name`. The pull requests are opened against
+// the given base branch and the files are committed at the path rendered from the given template.
+func NewRepository(apiURL, repo, branch, pathTemplate, token string) (*Repository, error) {
+	if pathTemplate == "" {
+		pathTemplate = DefaultPathTemplate
+	}
+	path, err := template.New("path").Option("missingkey=error").Parse(pathTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gitops path template: %w", err)
+	}
+	if len(strings.Split(repo, "/")) != 2 {
+		return nil, fmt.Errorf("gitops repository %q is not in owner/name format", repo)
+	}
+	return &Repository{
+		apiURL: strings.TrimSuffix(apiURL, "/"),
+		repo:   repo,
+		branch: branch,
+		token:  token,
+		path:   path,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// Path renders the path template of the Repository.
+func (r *Repository) Path(data PathData) (string, error) {
+	var buf bytes.Buffer
+	if err := r.path.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(buf.String(), "/"), nil
+}
+
+// OpenPullRequest commits the file of the PullRequest in its branch, created from the base branch,
+// and opens the pull request. It is safe to retry, the existing branch and pull request are reused.
+func (r *Repository) OpenPullRequest(ctx context.Context, pr PullRequest) (string, error) {
+	base := struct {
+		Object struct {
+			SHA string `json:"sha"`
+		} `json:"object"`
+	}{}
+	if _, err := r.do(ctx, http.MethodGet, "/git/ref/heads/"+r.branch, nil, &base); err != nil {
+		return "", err
+	}
+	ref := map[string]string{"ref": "refs/heads/" + pr.Branch, "sha": base.Object.SHA}
+	if status, err := r.do(ctx, http.MethodPost, "/git/refs", ref, nil); err != nil && status != http.StatusUnprocessableEntity {
+		return "", err
+	}
+
+	file := map[string]string{
+		"message": pr.Title,
+		"content": base64.StdEncoding.EncodeToString(pr.Content),
+		"branch":  pr.Branch,
+	}
+	existing := struct {
+		SHA string `json:"sha"`
+	}{}
+	if status, err := r.do(ctx, http.MethodGet, "/contents/"+pr.Path+"?ref="+pr.Branch, nil, &existing); err == nil {
+		file["sha"] = existing.SHA
+	} else if status != http.StatusNotFound {
+		return "", err
+	}
+	if _, err := r.do(ctx, http.MethodPut, "/contents/"+pr.Path, file, nil); err != nil {
+		return "", err
+	}
+
+	pull := map[string]string{"title": pr.Title, "body": pr.Body, "head": pr.Branch, "base": r.branch}
+	out := struct {
+		HTMLURL string `json:"html_url"`
+	}{}
+	status, err := r.do(ctx, http.MethodPost, "/pulls", pull, &out)
+	if err != nil && status != http.StatusUnprocessableEntity {
+		return "", err
+	}
+	return out.HTMLURL, nil
+}
+
+func (r *Repository) do(ctx context.Context, method, path string, in, out any) (int, error) {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return 0, err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/repos/%s%s", r.apiURL, r.repo, path), body)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if r.token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+	if resp.StatusCode >= http.StatusBadRequest {
+		return resp.StatusCode, fmt.Errorf("github responded to %s %s with status %d", method, path, resp.StatusCode)
+	}
+	if out == nil {
+		return resp.StatusCode, nil
+	}
+	return resp.StatusCode, json.NewDecoder(resp.Body).Decode(out)
+}
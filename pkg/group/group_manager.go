@@ -0,0 +1,191 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package group
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/features"
+	"kubeops.dev/supervisor/pkg/rollout"
+
+	"github.com/jonboulle/clockwork"
+	cutil "kmodules.xyz/client-go/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type GroupManager struct {
+	ctx   context.Context
+	kc    client.Client
+	rcmd  *api.Recommendation
+	clock clockwork.Clock
+}
+
+func NewGroupManager(ctx context.Context, kc client.Client, rcmd *api.Recommendation, clock clockwork.Clock) *GroupManager {
+	return &GroupManager{
+		ctx:   ctx,
+		kc:    kc,
+		rcmd:  rcmd,
+		clock: clock,
+	}
+}
+
+// IsEveryMemberApproved returns true if the Recommendation doesn't belong to any group
+// or all the members of its group are Approved.
+// rejected is true if any of the group members is Rejected.
+func (m *GroupManager) IsEveryMemberApproved() (approved bool, rejected bool, err error) {
+	if m.rcmd.Spec.GroupRef == nil {
+		return true, false, nil
+	}
+	members, err := ListMembers(m.ctx, m.kc, m.rcmd.Namespace, m.rcmd.Spec.GroupRef.Name)
+	if err != nil {
+		return false, false, err
+	}
+
+	approved = true
+	for _, rc := range members {
+		if rc.Status.ApprovalStatus == api.ApprovalRejected {
+			return false, true, nil
+		}
+		if rc.Status.ApprovalStatus != api.ApprovalApproved {
+			approved = false
+		}
+	}
+	return approved, false, nil
+}
+
+// IsCanaryVerified returns true if the Recommendation is allowed to execute according to the canary strategy
+// of its group. Canary members are always allowed. Rest of the members are allowed once all the canary members
+// are completed and the verification period is passed after the last canary execution.
+// halted is true if any of the canary members is terminally failed and the group halts on failure.
+// wait holds the remaining verification period, if any.
+// The canary strategy is ignored if the CanaryRollout feature is disabled.
+func (m *GroupManager) IsCanaryVerified() (verified bool, halted bool, wait time.Duration, err error) {
+	if m.rcmd.Spec.GroupRef == nil || !features.Enabled(features.CanaryRollout) {
+		return true, false, 0, nil
+	}
+	rg := &api.RecommendationGroup{}
+	key := client.ObjectKey{Namespace: m.rcmd.Namespace, Name: m.rcmd.Spec.GroupRef.Name}
+	if err = m.kc.Get(m.ctx, key, rg); err != nil {
+		return false, false, 0, err
+	}
+	if rg.Spec.Canary == nil {
+		return true, false, 0, nil
+	}
+
+	members, err := ListMembers(m.ctx, m.kc, m.rcmd.Namespace, rg.Name)
+	if err != nil {
+		return false, false, 0, err
+	}
+	canaries := CanaryMembers(members, rg.Spec.Canary.Replicas)
+	for _, rc := range canaries {
+		if rc.Name == m.rcmd.Name {
+			return true, false, 0, nil
+		}
+	}
+
+	var lastExecution time.Time
+	for _, rc := range canaries {
+		if rc.IsTerminallyFailed() || rc.Status.Phase == api.Skipped {
+			if rg.Spec.Canary.HaltOnFailure {
+				return false, true, 0, nil
+			}
+			continue
+		}
+		// the canary members failed in an attempt are still retried
+		if rc.IsAwaitingOrProgressingRecommendation() || rc.Status.Phase == api.Failed {
+			return false, false, 0, nil
+		}
+		if _, cond := cutil.GetCondition(rc.Status.Conditions, api.SuccessfullyExecutedOperation); cond != nil && cond.LastTransitionTime.After(lastExecution) {
+			lastExecution = cond.LastTransitionTime.Time
+		}
+	}
+
+	if remaining := lastExecution.Add(rg.Spec.Canary.VerificationPeriod.Duration).Sub(m.clock.Now()); remaining > 0 {
+		return false, false, remaining, nil
+	}
+	return true, false, 0, nil
+}
+
+// IsRolloutAllowed returns true if the Recommendation is allowed to execute according to the rollout strategy
+// of its group, i.e. it belongs to the current wave of the rollout.
+// halted is true if any member of the previous waves is failed and the rollout halts on failure.
+// wait holds the remaining soak time of the previous wave, if any.
+// The rollout strategy is ignored if the CanaryRollout feature is disabled.
+func (m *GroupManager) IsRolloutAllowed() (allowed bool, halted bool, wait time.Duration, err error) {
+	if m.rcmd.Spec.GroupRef == nil || !features.Enabled(features.CanaryRollout) {
+		return true, false, 0, nil
+	}
+	rg := &api.RecommendationGroup{}
+	key := client.ObjectKey{Namespace: m.rcmd.Namespace, Name: m.rcmd.Spec.GroupRef.Name}
+	if err = m.kc.Get(m.ctx, key, rg); err != nil {
+		return false, false, 0, err
+	}
+	if rg.Spec.Rollout == nil {
+		return true, false, 0, nil
+	}
+
+	members, err := ListMembers(m.ctx, m.kc, m.rcmd.Namespace, rg.Name)
+	if err != nil {
+		return false, false, 0, err
+	}
+	units := make([]rollout.Unit, 0, len(members))
+	for i := range members {
+		units = append(units, rollout.MemberUnit(&members[i]))
+	}
+	allowedUnits, halted, wait := rollout.Allowed(units, rg.Spec.Rollout, m.clock.Now())
+	return allowedUnits[m.rcmd.Name], halted, wait, nil
+}
+
+// CanaryMembers returns the first `replicas` members sorted by name.
+func CanaryMembers(members []api.Recommendation, replicas int32) []api.Recommendation {
+	sorted := make([]api.Recommendation, len(members))
+	copy(sorted, members)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	if int(replicas) < len(sorted) {
+		sorted = sorted[:replicas]
+	}
+	return sorted
+}
+
+// ListMembers returns the Recommendations of the given namespace referring to the given RecommendationGroup.
+func ListMembers(ctx context.Context, kc client.Client, namespace, groupName string) ([]api.Recommendation, error) {
+	rcmdList := &api.RecommendationList{}
+	if err := kc.List(ctx, rcmdList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	members := make([]api.Recommendation, 0)
+	for _, rc := range rcmdList.Items {
+		if rc.Spec.GroupRef != nil && rc.Spec.GroupRef.Name == groupName {
+			members = append(members, rc)
+		}
+	}
+	return members, nil
+}
+
+// IsSameGroup returns true if both of the Recommendations belong to the same RecommendationGroup.
+func IsSameGroup(a, b *api.Recommendation) bool {
+	if a.Spec.GroupRef == nil || b.Spec.GroupRef == nil {
+		return false
+	}
+	return a.Namespace == b.Namespace && a.Spec.GroupRef.Name == b.Spec.GroupRef.Name
+}
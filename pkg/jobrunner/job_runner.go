@@ -0,0 +1,101 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobrunner
+
+import (
+	"context"
+	"fmt"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"gomodules.xyz/pointer"
+	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type JobRunner struct {
+	ctx  context.Context
+	kc   client.Client
+	rcmd *api.Recommendation
+}
+
+func NewJobRunner(ctx context.Context, kc client.Client, rcmd *api.Recommendation) *JobRunner {
+	return &JobRunner{
+		ctx:  ctx,
+		kc:   kc,
+		rcmd: rcmd,
+	}
+}
+
+func (j *JobRunner) jobKey(suffix string) client.ObjectKey {
+	return client.ObjectKey{Namespace: j.rcmd.Namespace, Name: fmt.Sprintf("%s-%s", j.rcmd.Name, suffix)}
+}
+
+// Run creates a Job named `<recommendation-name>-<suffix>` from the given template if it doesn't exist
+// and returns its result. nil result means the Job is still running.
+// The Job is owned by the Recommendation, so that it is garbage collected along with the Recommendation.
+func (j *JobRunner) Run(suffix string, tmpl *batch.JobTemplateSpec) (*bool, string, error) {
+	job := &batch.Job{}
+	key := j.jobKey(suffix)
+	err := j.kc.Get(j.ctx, key, job)
+	if kerr.IsNotFound(err) {
+		job = &batch.Job{
+			ObjectMeta: *tmpl.ObjectMeta.DeepCopy(),
+			Spec:       *tmpl.Spec.DeepCopy(),
+		}
+		job.Name = key.Name
+		job.Namespace = key.Namespace
+		job.OwnerReferences = append(job.OwnerReferences, *metav1.NewControllerRef(j.rcmd, api.GroupVersion.WithKind(api.ResourceKindRecommendation)))
+		if job.Spec.Template.Spec.RestartPolicy == "" {
+			job.Spec.Template.Spec.RestartPolicy = core.RestartPolicyNever
+		}
+		if err := j.kc.Create(j.ctx, job); err != nil {
+			return nil, "", err
+		}
+		return nil, "", nil
+	} else if err != nil {
+		return nil, "", err
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != core.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batch.JobComplete:
+			return pointer.BoolP(true), fmt.Sprintf("Job %s is completed", job.Name), nil
+		case batch.JobFailed:
+			return pointer.BoolP(false), fmt.Sprintf("Job %s is failed, reason: %s, message: %s", job.Name, cond.Reason, cond.Message), nil
+		}
+	}
+	return nil, "", nil
+}
+
+// Cleanup deletes the Job created by Run along with its Pods.
+func (j *JobRunner) Cleanup(suffix string) error {
+	key := j.jobKey(suffix)
+	job := &batch.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+	}
+	return client.IgnoreNotFound(j.kc.Delete(j.ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)))
+}
@@ -0,0 +1,98 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenance
+
+import (
+	"context"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"github.com/jonboulle/clockwork"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type FreezeChecker struct {
+	ctx   context.Context
+	kc    client.Client
+	rcmd  *api.Recommendation
+	clock clockwork.Clock
+}
+
+func NewFreezeChecker(ctx context.Context, kc client.Client, rcmd *api.Recommendation, clock clockwork.Clock) *FreezeChecker {
+	return &FreezeChecker{
+		ctx:   ctx,
+		kc:    kc,
+		rcmd:  rcmd,
+		clock: clock,
+	}
+}
+
+// ActiveFreeze returns the active MaintenanceFreeze holding the execution of the Recommendation, if any.
+// If multiple MaintenanceFreezes are active, the one ending last is returned.
+func (f *FreezeChecker) ActiveFreeze() (*api.MaintenanceFreeze, error) {
+	freezeList := &api.MaintenanceFreezeList{}
+	if err := f.kc.List(f.ctx, freezeList); err != nil {
+		return nil, err
+	}
+
+	now := f.clock.Now()
+	var ns *core.Namespace
+	var active *api.MaintenanceFreeze
+	for i, mf := range freezeList.Items {
+		if now.Before(mf.Spec.Start.Time) || !now.Before(mf.Spec.End.Time) {
+			continue
+		}
+		if !f.isTargetSelected(mf.Spec.Targets) {
+			continue
+		}
+		if mf.Spec.NamespaceSelector != nil {
+			if ns == nil {
+				ns = &core.Namespace{}
+				if err := f.kc.Get(f.ctx, client.ObjectKey{Name: f.rcmd.Namespace}, ns); err != nil {
+					return nil, err
+				}
+			}
+			selector, err := metav1.LabelSelectorAsSelector(mf.Spec.NamespaceSelector)
+			if err != nil {
+				return nil, err
+			}
+			if !selector.Matches(labels.Set(ns.Labels)) {
+				continue
+			}
+		}
+		if active == nil || mf.Spec.End.After(active.Spec.End.Time) {
+			active = &freezeList.Items[i]
+		}
+	}
+	return active, nil
+}
+
+func (f *FreezeChecker) isTargetSelected(targets []metav1.GroupKind) bool {
+	if len(targets) == 0 {
+		return true
+	}
+	group := api.TargetGroup(f.rcmd.Spec.Target.APIGroup)
+	for _, gk := range targets {
+		if gk.Group == group && gk.Kind == f.rcmd.Spec.Target.Kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenance
+
+import (
+	"context"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IndexDefaultMaintenanceWindows registers the field indexes of the default MaintenanceWindows and ClusterMaintenanceWindows
+// used to find the default windows of the Recommendations.
+func IndexDefaultMaintenanceWindows(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &api.MaintenanceWindow{}, api.DefaultMaintenanceWindowKey, func(rawObj client.Object) []string {
+		app := rawObj.(*api.MaintenanceWindow)
+		if v, ok := app.Annotations[api.DefaultMaintenanceWindowKey]; ok && v == "true" {
+			return []string{"true"}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return indexer.IndexField(ctx, &api.ClusterMaintenanceWindow{}, api.DefaultClusterMaintenanceWindowKey, func(rawObj client.Object) []string {
+		app := rawObj.(*api.ClusterMaintenanceWindow)
+		if v, ok := app.Annotations[api.DefaultClusterMaintenanceWindowKey]; ok && v == "true" {
+			return []string{"true"}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,159 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenance
+
+import (
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
+// NextScheduledWindow returns the earliest window in which the Recommendation is going to be executed.
+// The currently running window is returned if it is maintenance time now.
+// nil is returned if no upcoming window is found.
+func (r *RecommendationMaintenance) NextScheduledWindow() (*api.ScheduledWindow, error) {
+	now := r.clock.Now().UTC()
+	aw := r.rcmd.Status.ApprovedWindow
+	if aw != nil && aw.Window == api.Immediate {
+		return &api.ScheduledWindow{Start: metav1.NewTime(now)}, nil
+	} else if aw != nil && aw.Window == api.SpecificDates {
+		return nextDateWindow(aw.Dates, now), nil
+	}
+
+	mwList, err := r.AvailableMaintenanceWindowList()
+	if err != nil {
+		return nil, err
+	}
+
+	var next *api.ScheduledWindow
+	for _, mw := range mwList.Items {
+		candidate, err := NextWindow(mw, now)
+		if err != nil {
+			return nil, err
+		}
+		if candidate == nil {
+			continue
+		}
+		if next == nil || candidate.Start.Before(&next.Start) {
+			next = candidate
+		}
+	}
+	return next, nil
+}
+
+// NextWindow returns the current or the next window of the MaintenanceWindow after the given time.
+// nil is returned if the MaintenanceWindow has no upcoming window.
+func NextWindow(mw api.MaintenanceWindow, now time.Time) (*api.ScheduledWindow, error) {
+	loc, err := getLocation(mw.Spec.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	next := nextDayWindow(mw.Spec.Days, now, loc)
+	if dw := nextDateWindow(mw.Spec.Dates, now); dw != nil && (next == nil || dw.Start.Before(&next.Start)) {
+		next = dw
+	}
+	if next != nil {
+		next.MaintenanceWindow = maintenanceWindowRef(mw)
+	}
+	return next, nil
+}
+
+// maxOccurrences limits the number of windows returned by Occurrences.
+const maxOccurrences = 1000
+
+// Occurrences returns the windows of the MaintenanceWindow overlapping the given time range in chronological order.
+func Occurrences(mw api.MaintenanceWindow, from, to time.Time) ([]api.ScheduledWindow, error) {
+	var windows []api.ScheduledWindow
+	now := from
+	for len(windows) < maxOccurrences {
+		next, err := NextWindow(mw, now)
+		if err != nil {
+			return nil, err
+		}
+		if next == nil || !next.Start.Time.Before(to) || !next.End.Time.After(now) {
+			break
+		}
+		windows = append(windows, *next)
+		now = next.End.Time.Add(time.Second)
+	}
+	return windows, nil
+}
+
+func nextDateWindow(dates []api.DateWindow, now time.Time) *api.ScheduledWindow {
+	var next *api.ScheduledWindow
+	for _, d := range dates {
+		if d.End.UTC().Before(now) {
+			continue
+		}
+		if next == nil || d.Start.Before(&next.Start) {
+			next = &api.ScheduledWindow{
+				Start: d.Start,
+				End:   d.End,
+			}
+		}
+	}
+	return next
+}
+
+// nextDayWindow looks for the next TimeWindow in the following week, considering the times in the given location.
+func nextDayWindow(days map[api.DayOfWeek][]api.TimeWindow, now time.Time, loc *time.Location) *api.ScheduledWindow {
+	localNow := now.In(loc)
+	for i := 0; i <= 7; i++ {
+		date := localNow.AddDate(0, 0, i)
+		var next *api.ScheduledWindow
+		for _, tw := range days[api.DayOfWeek(date.Weekday().String())] {
+			start := onDate(date, tw.Start, loc)
+			end := onDate(date, tw.End, loc)
+			if end.Before(localNow) {
+				continue
+			}
+			if next == nil || start.Before(next.Start.Time) {
+				next = &api.ScheduledWindow{
+					Start: metav1.NewTime(start.UTC()),
+					End:   metav1.NewTime(end.UTC()),
+				}
+			}
+		}
+		if next != nil {
+			return next
+		}
+	}
+	return nil
+}
+
+func onDate(date time.Time, t kmapi.TimeOfDay, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+}
+
+func maintenanceWindowRef(mw api.MaintenanceWindow) *kmapi.TypedObjectReference {
+	if mw.Name == "" {
+		return nil
+	}
+	ref := &kmapi.TypedObjectReference{
+		APIGroup:  api.GroupVersion.Group,
+		Kind:      api.ResourceKindMaintenanceWindow,
+		Namespace: mw.Namespace,
+		Name:      mw.Name,
+	}
+	if mw.Namespace == "" {
+		ref.Kind = api.ResourceKindClusterMaintenanceWindow
+	}
+	return ref
+}
@@ -20,9 +20,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/target"
 
 	"github.com/jonboulle/clockwork"
 	"gomodules.xyz/pointer"
@@ -63,7 +65,7 @@ func (r *RecommendationMaintenance) IsMaintenanceTime() (bool, error) {
 		return false, nil
 	}
 
-	mwList, err := r.getAvailableMaintenanceWindowList()
+	mwList, err := r.AvailableMaintenanceWindowList()
 	if err != nil {
 		return false, err
 	}
@@ -104,6 +106,26 @@ func (r *RecommendationMaintenance) IsMaintenanceTime() (bool, error) {
 	return false, nil
 }
 
+// getTargetMaintenanceWindow returns the MaintenanceWindow or ClusterMaintenanceWindow referred by the
+// `supervisor.appscode.com/maintenance-window` annotation of the target object, if any.
+func (r *RecommendationMaintenance) getTargetMaintenanceWindow() (*api.MaintenanceWindow, error) {
+	obj, err := target.GetTarget(r.ctx, r.kc, r.rcmd)
+	if err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	ref, ok := obj.GetAnnotations()[api.MaintenanceWindowKey]
+	if !ok || ref == "" {
+		return nil, nil
+	}
+	if kind, name, found := strings.Cut(ref, "/"); found {
+		if kind != api.ResourceKindClusterMaintenanceWindow {
+			return nil, fmt.Errorf("invalid %s annotation %q of the target, expected <name> or %s/<name>", api.MaintenanceWindowKey, ref, api.ResourceKindClusterMaintenanceWindow)
+		}
+		return r.getClusterMaintenanceWindow(name)
+	}
+	return r.getMaintenanceWindow(client.ObjectKey{Name: ref})
+}
+
 func (r *RecommendationMaintenance) getDefaultMaintenanceWindow() (*api.MaintenanceWindow, error) {
 	mwList := &api.MaintenanceWindowList{}
 	if err := r.kc.List(r.ctx, mwList, client.InNamespace(r.rcmd.Namespace), client.MatchingFields{
@@ -135,8 +157,9 @@ func (r *RecommendationMaintenance) getDefaultClusterMaintenanceWindow() (*api.M
 	}
 
 	mw := &api.MaintenanceWindow{
-		Spec:   clusterMWList.Items[0].Spec,
-		Status: clusterMWList.Items[0].Status,
+		ObjectMeta: clusterMWList.Items[0].ObjectMeta,
+		Spec:       clusterMWList.Items[0].Spec,
+		Status:     clusterMWList.Items[0].Status,
 	}
 	return mw, nil
 }
@@ -152,6 +175,19 @@ func (r *RecommendationMaintenance) getMaintenanceWindow(key client.ObjectKey) (
 	return mw, nil
 }
 
+// getClusterMaintenanceWindow returns the ClusterMaintenanceWindow of the given name as a MaintenanceWindow.
+func (r *RecommendationMaintenance) getClusterMaintenanceWindow(name string) (*api.MaintenanceWindow, error) {
+	cMW := &api.ClusterMaintenanceWindow{}
+	if err := r.kc.Get(r.ctx, client.ObjectKey{Name: name}, cMW); err != nil {
+		return nil, err
+	}
+	return &api.MaintenanceWindow{
+		ObjectMeta: cMW.ObjectMeta,
+		Spec:       cMW.Spec,
+		Status:     cMW.Status,
+	}, nil
+}
+
 func (r *RecommendationMaintenance) getMaintenanceWindows() (*api.MaintenanceWindowList, error) {
 	mwList := &api.MaintenanceWindowList{}
 	if err := r.kc.List(r.ctx, mwList, client.InNamespace(r.rcmd.Namespace)); err != nil {
@@ -168,8 +204,9 @@ func (r *RecommendationMaintenance) getMWListFromClusterMWList() (*api.Maintenan
 	mwList := &api.MaintenanceWindowList{}
 	for _, cMW := range clusterMWList.Items {
 		mw := api.MaintenanceWindow{
-			Spec:   cMW.Spec,
-			Status: cMW.Status,
+			ObjectMeta: cMW.ObjectMeta,
+			Spec:       cMW.Spec,
+			Status:     cMW.Status,
 		}
 		mwList.Items = append(mwList.Items, mw)
 	}
@@ -214,14 +251,23 @@ func (r *RecommendationMaintenance) isMaintenanceTimeWindow(timeWindows []api.Ti
 	return false
 }
 
-func (r *RecommendationMaintenance) getAvailableMaintenanceWindowList() (*api.MaintenanceWindowList, error) {
+// AvailableMaintenanceWindowList returns the MaintenanceWindows the Recommendation can be executed in,
+// according to its ApprovedWindow or the MaintenanceWindow annotation of its target object,
+// otherwise the default MaintenanceWindow and ClusterMaintenanceWindow.
+// ClusterMaintenanceWindows are returned as MaintenanceWindows without namespace.
+func (r *RecommendationMaintenance) AvailableMaintenanceWindowList() (*api.MaintenanceWindowList, error) {
 	aw := r.rcmd.Status.ApprovedWindow
 	mwList := &api.MaintenanceWindowList{}
 	if aw == nil {
-		mw, err := r.getDefaultMaintenanceWindow()
+		mw, err := r.getTargetMaintenanceWindow()
 		if err != nil {
 			return nil, err
 		}
+		if mw == nil {
+			if mw, err = r.getDefaultMaintenanceWindow(); err != nil {
+				return nil, err
+			}
+		}
 		if mw != nil {
 			mwList.Items = append(mwList.Items, *mw)
 		}
@@ -235,6 +281,12 @@ func (r *RecommendationMaintenance) getAvailableMaintenanceWindowList() (*api.Ma
 				mwList.Items = append(mwList.Items, *cMW)
 			}
 		}
+	} else if aw.MaintenanceWindow != nil && aw.MaintenanceWindow.Kind == api.ResourceKindClusterMaintenanceWindow {
+		mw, err := r.getClusterMaintenanceWindow(aw.MaintenanceWindow.Name)
+		if err != nil {
+			return nil, err
+		}
+		mwList.Items = append(mwList.Items, *mw)
 	} else if aw.MaintenanceWindow != nil {
 		mw, err := r.getMaintenanceWindow(client.ObjectKey{Namespace: aw.MaintenanceWindow.Namespace, Name: aw.MaintenanceWindow.Name})
 		if err != nil {
@@ -258,6 +310,19 @@ func (r *RecommendationMaintenance) getAvailableMaintenanceWindowList() (*api.Ma
 	return mwList, nil
 }
 
+// IsWindowActive returns true if the MaintenanceWindow of the given spec is open at the current time.
+func IsWindowActive(spec api.MaintenanceWindowSpec, clock clockwork.Clock) (bool, error) {
+	r := &RecommendationMaintenance{clock: clock}
+	loc, err := getLocation(spec.Timezone)
+	if err != nil {
+		return false, err
+	}
+	if mTimes, found := spec.Days[api.DayOfWeek(getCurrentDay(clock, loc))]; found && r.isMaintenanceTimeWindow(mTimes, loc) {
+		return true, nil
+	}
+	return r.isMaintenanceDateWindow(spec.Dates), nil
+}
+
 func getCurrentDay(clock clockwork.Clock, loc *time.Location) string {
 	return clock.Now().In(loc).Weekday().String()
 }
@@ -0,0 +1,125 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"github.com/jonboulle/clockwork"
+	kmapi "kmodules.xyz/client-go/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// utilizationLookback limits how far back the last occurrence of a window is searched.
+const utilizationLookback = 8 * 24 * time.Hour
+
+type UtilizationReporter struct {
+	ctx   context.Context
+	kc    client.Client
+	clock clockwork.Clock
+}
+
+func NewUtilizationReporter(ctx context.Context, kc client.Client, clock clockwork.Clock) *UtilizationReporter {
+	return &UtilizationReporter{
+		ctx:   ctx,
+		kc:    kc,
+		clock: clock,
+	}
+}
+
+// Report returns the utilization of the current or the last occurrence of the MaintenanceWindow.
+// nil is returned if the window has no occurrence in the last 8 days.
+// ClusterMaintenanceWindows are given as MaintenanceWindows without namespace.
+func (u *UtilizationReporter) Report(mw api.MaintenanceWindow) (*api.WindowUtilization, error) {
+	now := u.clock.Now().UTC()
+	windows, err := Occurrences(mw, now.Add(-utilizationLookback), now)
+	if err != nil {
+		return nil, err
+	}
+	if len(windows) == 0 {
+		return nil, nil
+	}
+	w := windows[len(windows)-1]
+	ref := maintenanceWindowRef(mw)
+	ut := &api.WindowUtilization{
+		Start: w.Start,
+		End:   w.End,
+	}
+
+	var opts []client.ListOption
+	if mw.Namespace != "" {
+		opts = append(opts, client.InNamespace(mw.Namespace))
+	}
+	meList := &api.MaintenanceExecutionList{}
+	if err := u.kc.List(u.ctx, meList, opts...); err != nil {
+		return nil, err
+	}
+	var busy time.Duration
+	for _, me := range meList.Items {
+		start := me.Spec.StartTime
+		if !isSameWindow(me.Spec.MaintenanceWindow, ref) || start == nil || start.Before(&w.Start) || !start.Before(&w.End) {
+			continue
+		}
+		ut.Operations++
+		if me.Spec.CompletionTime != nil {
+			busy += me.Spec.CompletionTime.Sub(start.Time)
+		}
+	}
+	ut.BusyMinutes = int64(busy / time.Minute)
+
+	rcmdList := &api.RecommendationList{}
+	if err := u.kc.List(u.ctx, rcmdList, opts...); err != nil {
+		return nil, err
+	}
+	for _, rcmd := range rcmdList.Items {
+		if rcmd.Status.Phase != api.Waiting || rcmd.Status.ApprovalStatus != api.ApprovalApproved ||
+			rcmd.Status.ScheduledWindow == nil || !isSameWindow(rcmd.Status.ScheduledWindow.MaintenanceWindow, ref) {
+			continue
+		}
+		approvedAt := rcmd.CreationTimestamp
+		if rcmd.Status.ReviewTimestamp != nil {
+			approvedAt = *rcmd.Status.ReviewTimestamp
+		}
+		if approvedAt.Before(&w.End) {
+			ut.QueueOverflow++
+		}
+	}
+	return ut, nil
+}
+
+// UntilNextTransition returns the duration until the next occurrence of the MaintenanceWindow opens,
+// or the current occurrence is closed. Zero(0) is returned if the window has no upcoming occurrence.
+func UntilNextTransition(mw api.MaintenanceWindow, now time.Time) (time.Duration, error) {
+	next, err := NextWindow(mw, now)
+	if err != nil || next == nil {
+		return 0, err
+	}
+	if next.Start.Time.After(now) {
+		return next.Start.Sub(now) + time.Second, nil
+	}
+	return next.End.Sub(now) + time.Second, nil
+}
+
+func isSameWindow(a, b *kmapi.TypedObjectReference) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Kind == b.Kind && a.Namespace == b.Namespace && a.Name == b.Name
+}
@@ -0,0 +1,209 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancemode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/target"
+
+	core "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	PhaseStarted   = "Started"
+	PhaseCompleted = "Completed"
+)
+
+// Event is posted to the maintenance mode webhook when the execution of a Recommendation is started and completed.
+type Event struct {
+	Phase          string                         `json:"phase"`
+	Namespace      string                         `json:"namespace"`
+	Recommendation string                         `json:"recommendation"`
+	Target         core.TypedLocalObjectReference `json:"target"`
+	Timestamp      metav1.Time                    `json:"timestamp"`
+}
+
+// Signaler signals the front doors of the target of a Recommendation that the target is under maintenance
+// during the execution, so that they can show a maintenance page. The Services owned by the target and
+// the Ingresses routing to them are annotated, and the given webhook is called, if any.
+type Signaler struct {
+	ctx        context.Context
+	kc         client.Client
+	rcmd       *api.Recommendation
+	annotate   bool
+	webhookURL string
+	client     *http.Client
+}
+
+func NewSignaler(ctx context.Context, kc client.Client, rcmd *api.Recommendation, annotate bool, webhookURL string) *Signaler {
+	return &Signaler{
+		ctx:        ctx,
+		kc:         kc,
+		rcmd:       rcmd,
+		annotate:   annotate,
+		webhookURL: webhookURL,
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Signal marks the front doors of the target as under maintenance.
+func (s *Signaler) Signal() error {
+	if s.annotate {
+		if err := s.setAnnotations(true); err != nil {
+			return err
+		}
+	}
+	return s.notify(PhaseStarted)
+}
+
+// Clear removes the maintenance mark of the front doors set by the Recommendation.
+func (s *Signaler) Clear() error {
+	if s.annotate {
+		if err := s.setAnnotations(false); err != nil {
+			return err
+		}
+	}
+	return s.notify(PhaseCompleted)
+}
+
+// setAnnotations sets or removes the UnderMaintenanceKey annotation of the Services owned by the target
+// and the Ingresses routing to them. The annotations set by the other Recommendations are left untouched.
+func (s *Signaler) setAnnotations(set bool) error {
+	objs, err := s.frontDoors()
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		value, found := obj.GetAnnotations()[api.UnderMaintenanceKey]
+		if set == found || (found && value != s.signaler()) {
+			continue
+		}
+		patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+		annotations := obj.GetAnnotations()
+		if set {
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[api.UnderMaintenanceKey] = s.signaler()
+		} else {
+			delete(annotations, api.UnderMaintenanceKey)
+		}
+		obj.SetAnnotations(annotations)
+		if err := s.kc.Patch(s.ctx, obj, patch); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// frontDoors returns the Services owned by the target and the Ingresses routing to them.
+func (s *Signaler) frontDoors() ([]client.Object, error) {
+	targetObj, err := target.GetTarget(s.ctx, s.kc, s.rcmd)
+	if err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+
+	svcList := &core.ServiceList{}
+	if err := s.kc.List(s.ctx, svcList, client.InNamespace(s.rcmd.Namespace)); err != nil {
+		return nil, err
+	}
+	var objs []client.Object
+	services := map[string]bool{}
+	for i := range svcList.Items {
+		if metav1.IsControlledBy(&svcList.Items[i], targetObj) {
+			objs = append(objs, &svcList.Items[i])
+			services[svcList.Items[i].Name] = true
+		}
+	}
+	if len(services) == 0 {
+		return objs, nil
+	}
+
+	ingList := &networking.IngressList{}
+	if err := s.kc.List(s.ctx, ingList, client.InNamespace(s.rcmd.Namespace)); err != nil {
+		return nil, err
+	}
+	for i := range ingList.Items {
+		if routesTo(&ingList.Items[i], services) {
+			objs = append(objs, &ingList.Items[i])
+		}
+	}
+	return objs, nil
+}
+
+// routesTo returns true if any backend of the Ingress is one of the given Services.
+func routesTo(ing *networking.Ingress, services map[string]bool) bool {
+	if b := ing.Spec.DefaultBackend; b != nil && b.Service != nil && services[b.Service.Name] {
+		return true
+	}
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			if path.Backend.Service != nil && services[path.Backend.Service.Name] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// notify posts the Event of the given phase to the webhook, if any.
+func (s *Signaler) notify(phase string) error {
+	if s.webhookURL == "" {
+		return nil
+	}
+	data, err := json.Marshal(Event{
+		Phase:          phase,
+		Namespace:      s.rcmd.Namespace,
+		Recommendation: s.rcmd.Name,
+		Target:         s.rcmd.Spec.Target,
+		Timestamp:      metav1.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodPost, s.webhookURL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("maintenance mode webhook %s responded with status %d", s.webhookURL, resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *Signaler) signaler() string {
+	return fmt.Sprintf("%s/%s", s.rcmd.Namespace, s.rcmd.Name)
+}
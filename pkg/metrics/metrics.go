@@ -0,0 +1,244 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/maintenance"
+	"kubeops.dev/supervisor/pkg/shared"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "supervisor"
+
+	labelNamespace      = "namespace"
+	labelTargetKind     = "target_kind"
+	labelApprovalStatus = "approval_status"
+	labelResult         = "result"
+	labelOperation      = "operation"
+	labelOperationType  = "operation_type"
+
+	ExecutionStarted   = "started"
+	ExecutionSucceeded = "succeeded"
+	ExecutionFailed    = "failed"
+
+	ExecutorValidate    = "validate"
+	ExecutorCreate      = "create"
+	ExecutorCheckStatus = "check_status"
+)
+
+var (
+	approvals = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "recommendation_approvals_total",
+		Help:      "Number of the approval decisions of Recommendations processed by the operator.",
+	}, []string{labelNamespace, labelTargetKind, labelApprovalStatus})
+
+	executions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "recommendation_executions_total",
+		Help:      "Number of the started, succeeded and failed executions of Recommendations.",
+	}, []string{labelNamespace, labelTargetKind, labelResult})
+
+	slaBreaches = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "recommendation_sla_breaches_total",
+		Help:      "Number of Recommendations which are queued for longer than their maximum queue duration.",
+	}, []string{labelNamespace, labelTargetKind})
+
+	queueDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "recommendation_queue_duration_seconds",
+		Help:      "Time between the creation of a Recommendation and the start of its execution.",
+		Buckets:   prometheus.ExponentialBuckets(60, 4, 10),
+	}, []string{labelNamespace, labelTargetKind})
+
+	executionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "recommendation_execution_duration_seconds",
+		Help:      "Time between the creation and the successful completion of the operations of Recommendations.",
+		Buckets:   prometheus.ExponentialBuckets(30, 2, 12),
+	}, []string{labelNamespace, labelTargetKind, labelOperationType})
+
+	executorDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "executor_duration_seconds",
+		Help:      "Latency of the executor calls for the operations of Recommendations.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{labelNamespace, labelTargetKind, labelOperation})
+
+	recommendationsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "recommendations"),
+		"Number of Recommendations by phase.",
+		[]string{labelNamespace, labelTargetKind, "phase"}, nil,
+	)
+
+	maintenanceWindowActiveDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "maintenance_window_active"),
+		"Whether the MaintenanceWindow is open at the moment. ClusterMaintenanceWindows have an empty namespace.",
+		[]string{labelNamespace, "kind", "name"}, nil,
+	)
+
+	maintenanceWindowOperationsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "maintenance_window_operations"),
+		"Number of the executions completed in the current or the last occurrence of the MaintenanceWindow.",
+		[]string{labelNamespace, "kind", "name"}, nil,
+	)
+
+	maintenanceWindowBusyMinutesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "maintenance_window_busy_minutes"),
+		"Total duration of the executions in the current or the last occurrence of the MaintenanceWindow.",
+		[]string{labelNamespace, "kind", "name"}, nil,
+	)
+
+	maintenanceWindowQueueOverflowDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "maintenance_window_queue_overflow"),
+		"Number of the approved Recommendations left waiting after the current or the last occurrence of the MaintenanceWindow.",
+		[]string{labelNamespace, "kind", "name"}, nil,
+	)
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(approvals, executions, slaBreaches, queueDuration, executionDuration, executorDuration)
+}
+
+// RegisterStateCollector registers the collector of the metrics which are computed from
+// the current state of the Recommendations and MaintenanceWindows.
+func RegisterStateCollector(kc client.Reader, clock clockwork.Clock) error {
+	return ctrlmetrics.Registry.Register(&stateCollector{kc: kc, clock: clock})
+}
+
+// RecordApproval counts the approval decision of the Recommendation.
+func RecordApproval(rcmd *api.Recommendation, status api.ApprovalStatus) {
+	approvals.WithLabelValues(rcmd.Namespace, rcmd.Spec.Target.Kind, string(status)).Inc()
+}
+
+// RecordExecution counts the execution of the Recommendation with the given result.
+// The time spent in queue is observed when the execution is started.
+func RecordExecution(rcmd *api.Recommendation, result string, now time.Time) {
+	executions.WithLabelValues(rcmd.Namespace, rcmd.Spec.Target.Kind, result).Inc()
+	if result == ExecutionStarted {
+		queueDuration.WithLabelValues(rcmd.Namespace, rcmd.Spec.Target.Kind).Observe(now.Sub(rcmd.CreationTimestamp.Time).Seconds())
+	}
+}
+
+// RecordSLABreach counts the breach of the maximum queue duration of the Recommendation.
+func RecordSLABreach(rcmd *api.Recommendation) {
+	slaBreaches.WithLabelValues(rcmd.Namespace, rcmd.Spec.Target.Kind).Inc()
+}
+
+// ObserveExecutionDuration observes the duration of the successful execution of the Recommendation.
+func ObserveExecutionDuration(rcmd *api.Recommendation, d time.Duration) {
+	opsType, _ := shared.GetOperationType(rcmd.Spec.Operation)
+	executionDuration.WithLabelValues(rcmd.Namespace, rcmd.Spec.Target.Kind, opsType).Observe(d.Seconds())
+}
+
+// ObserveExecutor observes the latency of the given executor operation started at the given time.
+func ObserveExecutor(rcmd *api.Recommendation, operation string, start time.Time) {
+	executorDuration.WithLabelValues(rcmd.Namespace, rcmd.Spec.Target.Kind, operation).Observe(time.Since(start).Seconds())
+}
+
+type stateCollector struct {
+	kc    client.Reader
+	clock clockwork.Clock
+}
+
+var _ prometheus.Collector = &stateCollector{}
+
+func (c *stateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- recommendationsDesc
+	ch <- maintenanceWindowActiveDesc
+	ch <- maintenanceWindowOperationsDesc
+	ch <- maintenanceWindowBusyMinutesDesc
+	ch <- maintenanceWindowQueueOverflowDesc
+}
+
+func (c *stateCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+	c.collectRecommendations(ctx, ch)
+	c.collectMaintenanceWindows(ctx, ch)
+}
+
+func (c *stateCollector) collectRecommendations(ctx context.Context, ch chan<- prometheus.Metric) {
+	rcmdList := &api.RecommendationList{}
+	if err := c.kc.List(ctx, rcmdList); err != nil {
+		klog.Errorf("failed to list Recommendations for metrics: %v", err)
+		return
+	}
+
+	type key struct {
+		namespace, targetKind string
+		phase                 api.RecommendationPhase
+	}
+	counts := make(map[key]int)
+	for _, rcmd := range rcmdList.Items {
+		phase := rcmd.Status.Phase
+		if phase == "" {
+			phase = api.Pending
+		}
+		counts[key{rcmd.Namespace, rcmd.Spec.Target.Kind, phase}]++
+	}
+	for k, n := range counts {
+		ch <- prometheus.MustNewConstMetric(recommendationsDesc, prometheus.GaugeValue, float64(n), k.namespace, k.targetKind, string(k.phase))
+	}
+}
+
+func (c *stateCollector) collectMaintenanceWindows(ctx context.Context, ch chan<- prometheus.Metric) {
+	mwList := &api.MaintenanceWindowList{}
+	if err := c.kc.List(ctx, mwList); err != nil {
+		klog.Errorf("failed to list MaintenanceWindows for metrics: %v", err)
+	}
+	for _, mw := range mwList.Items {
+		c.collectWindow(ch, mw.Namespace, api.ResourceKindMaintenanceWindow, mw.Name, mw.Spec, mw.Status)
+	}
+
+	cmwList := &api.ClusterMaintenanceWindowList{}
+	if err := c.kc.List(ctx, cmwList); err != nil {
+		klog.Errorf("failed to list ClusterMaintenanceWindows for metrics: %v", err)
+	}
+	for _, cmw := range cmwList.Items {
+		c.collectWindow(ch, "", api.ResourceKindClusterMaintenanceWindow, cmw.Name, cmw.Spec, cmw.Status)
+	}
+}
+
+func (c *stateCollector) collectWindow(ch chan<- prometheus.Metric, ns, kind, name string, spec api.MaintenanceWindowSpec, status api.MaintenanceWindowStatus) {
+	if ut := status.Utilization; ut != nil {
+		ch <- prometheus.MustNewConstMetric(maintenanceWindowOperationsDesc, prometheus.GaugeValue, float64(ut.Operations), ns, kind, name)
+		ch <- prometheus.MustNewConstMetric(maintenanceWindowBusyMinutesDesc, prometheus.GaugeValue, float64(ut.BusyMinutes), ns, kind, name)
+		ch <- prometheus.MustNewConstMetric(maintenanceWindowQueueOverflowDesc, prometheus.GaugeValue, float64(ut.QueueOverflow), ns, kind, name)
+	}
+
+	active, err := maintenance.IsWindowActive(spec, c.clock)
+	if err != nil {
+		klog.Errorf("failed to check whether %s %s/%s is active: %v", kind, ns, name, err)
+		return
+	}
+	var val float64
+	if active {
+		val = 1
+	}
+	ch <- prometheus.MustNewConstMetric(maintenanceWindowActiveDesc, prometheus.GaugeValue, val, ns, kind, name)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+)
+
+const (
+	pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+	opsgenieAlertsURL  = "https://api.opsgenie.com/v2/alerts"
+)
+
+// incidentProvider opens PagerDuty incidents or Opsgenie alerts deduplicated by the Recommendation.
+type incidentProvider struct {
+	typ    api.NotifierType
+	key    string
+	client *http.Client
+}
+
+var _ Provider = &incidentProvider{}
+
+func newIncidentProvider(typ api.NotifierType, key string) *incidentProvider {
+	return &incidentProvider{
+		typ:    typ,
+		key:    key,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *incidentProvider) Send(ctx context.Context, msg Message) error {
+	var url string
+	var payload any
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+
+	switch p.typ {
+	case api.NotifierTypePagerDuty:
+		severity := "error"
+		if msg.Critical {
+			severity = "critical"
+		}
+		url = pagerDutyEventsURL
+		payload = map[string]any{
+			"routing_key":  p.key,
+			"event_action": "trigger",
+			"dedup_key":    msg.DedupKey(),
+			"payload": map[string]any{
+				"summary":        msg.Title() + ": " + msg.Text,
+				"source":         fmt.Sprintf("%s/%s", msg.Target.Kind, msg.Target.Name),
+				"severity":       severity,
+				"custom_details": msg,
+			},
+		}
+	case api.NotifierTypeOpsgenie:
+		priority := "P3"
+		if msg.Critical {
+			priority = "P1"
+		}
+		url = opsgenieAlertsURL
+		header.Set("Authorization", "GenieKey "+p.key)
+		payload = map[string]any{
+			"message":     msg.Title(),
+			"alias":       msg.DedupKey(),
+			"description": msg.Text,
+			"priority":    priority,
+			"source":      "supervisor",
+		}
+	default:
+		return fmt.Errorf("unknown incident Notifier type %q", p.typ)
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header = header
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%s responded with status %d", p.typ, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/shared"
+
+	"gomodules.xyz/pointer"
+)
+
+// jiraProvider keeps a Jira issue per Recommendation. The issue is found by the label
+// holding the uid of the Recommendation, so no state needs to be stored in the cluster.
+type jiraProvider struct {
+	spec     api.JiraNotifier
+	baseURL  string
+	username string
+	token    string
+	client   *http.Client
+}
+
+var _ Provider = &jiraProvider{}
+
+func newJiraProvider(spec api.JiraNotifier, baseURL, username, token string) *jiraProvider {
+	return &jiraProvider{
+		spec:     spec,
+		baseURL:  strings.TrimSuffix(baseURL, "/"),
+		username: username,
+		token:    token,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *jiraProvider) Send(ctx context.Context, msg Message) error {
+	if msg.Name == "" {
+		// the digests of the namespaces are not tracked as issues
+		return nil
+	}
+
+	key, err := p.findIssue(ctx, msg)
+	if err != nil {
+		return err
+	}
+	if key == "" {
+		if key, err = p.createIssue(ctx, msg); err != nil {
+			return err
+		}
+	} else {
+		comment := map[string]string{"body": fmt.Sprintf("%s\n%s", msg.Title(), msg.Text)}
+		if err = p.do(ctx, http.MethodPost, "/rest/api/2/issue/"+key+"/comment", comment, nil); err != nil {
+			return err
+		}
+	}
+
+	if transition := p.transitionOf(msg); transition != "" {
+		return p.transition(ctx, key, transition)
+	}
+	return nil
+}
+
+// transitionOf returns the name of the transition to apply for the Message.
+func (p *jiraProvider) transitionOf(msg Message) string {
+	switch msg.Event {
+	case api.EventReasonExecutionStarted, api.ForcedExecutionStarted:
+		return p.spec.InProgressTransition
+	case api.EventReasonExecutionSucceeded, api.EventReasonRejected, api.EventReasonExpired, api.EventReasonSkipped,
+		api.VerificationFailed, api.ActiveDeadlineExceeded, api.PrometheusGateBreached, api.OperationValidationFailed:
+		return p.spec.DoneTransition
+	case api.EventReasonExecutionFailed:
+		// the failed attempts are retried until the backoff limit is exceeded
+		if rc := msg.Recommendation; rc != nil && rc.Status.FailedAttempt >= pointer.Int32(rc.Spec.BackoffLimit) {
+			return p.spec.DoneTransition
+		}
+	}
+	return ""
+}
+
+func issueLabel(msg Message) string {
+	return "supervisor-" + string(msg.UID)
+}
+
+func (p *jiraProvider) findIssue(ctx context.Context, msg Message) (string, error) {
+	jql := fmt.Sprintf("project = %q AND labels = %q", p.spec.Project, issueLabel(msg))
+	out := struct {
+		Issues []struct {
+			Key string `json:"key"`
+		} `json:"issues"`
+	}{}
+	if err := p.do(ctx, http.MethodGet, "/rest/api/2/search?fields=key&maxResults=1&jql="+url.QueryEscape(jql), nil, &out); err != nil {
+		return "", err
+	}
+	if len(out.Issues) == 0 {
+		return "", nil
+	}
+	return out.Issues[0].Key, nil
+}
+
+func (p *jiraProvider) createIssue(ctx context.Context, msg Message) (string, error) {
+	operation := "Operation"
+	if rc := msg.Recommendation; rc != nil {
+		if gvk, err := shared.GetGVK(rc.Spec.Operation); err == nil {
+			operation = gvk.Kind
+		}
+		if opsType, err := shared.GetOperationType(rc.Spec.Operation); err == nil && opsType != "" {
+			operation = fmt.Sprintf("%s %s", opsType, operation)
+		}
+	}
+	description := fmt.Sprintf("Recommendation: %s/%s\nTarget: %s %s/%s\nOperation: %s\n\n%s",
+		msg.Namespace, msg.Name, msg.Target.Kind, msg.Namespace, msg.Target.Name, operation, msg.Text)
+	if rc := msg.Recommendation; rc != nil && rc.Spec.Description != "" {
+		description = fmt.Sprintf("%s\n\n%s", rc.Spec.Description, description)
+	}
+
+	issue := map[string]any{
+		"fields": map[string]any{
+			"project":     map[string]string{"key": p.spec.Project},
+			"issuetype":   map[string]string{"name": p.spec.IssueType},
+			"summary":     fmt.Sprintf("%s of %s %s/%s", operation, msg.Target.Kind, msg.Namespace, msg.Target.Name),
+			"description": description,
+			"labels":      []string{"supervisor", issueLabel(msg)},
+		},
+	}
+	out := struct {
+		Key string `json:"key"`
+	}{}
+	if err := p.do(ctx, http.MethodPost, "/rest/api/2/issue", issue, &out); err != nil {
+		return "", err
+	}
+	return out.Key, nil
+}
+
+// transition applies the transition of the given name to the issue. It is a no-op if the transition
+// is not available from the current status of the issue, e.g. the issue is already transitioned.
+func (p *jiraProvider) transition(ctx context.Context, key, name string) error {
+	out := struct {
+		Transitions []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"transitions"`
+	}{}
+	path := "/rest/api/2/issue/" + key + "/transitions"
+	if err := p.do(ctx, http.MethodGet, path, nil, &out); err != nil {
+		return err
+	}
+	for _, t := range out.Transitions {
+		if strings.EqualFold(t.Name, name) {
+			return p.do(ctx, http.MethodPost, path, map[string]any{"transition": map[string]string{"id": t.ID}}, nil)
+		}
+	}
+	return nil
+}
+
+func (p *jiraProvider) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, p.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.SetBasicAuth(p.username, p.token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("jira responded to %s %s with status %d", method, path, resp.StatusCode)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
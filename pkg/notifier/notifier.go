@@ -0,0 +1,191 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/target"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Message is the notification of a transition of a Recommendation.
+type Message struct {
+	Event          string                         `json:"event"`
+	Namespace      string                         `json:"namespace"`
+	Name           string                         `json:"name"`
+	Target         core.TypedLocalObjectReference `json:"target"`
+	Phase          api.RecommendationPhase        `json:"phase,omitempty"`
+	ApprovalStatus api.ApprovalStatus             `json:"approvalStatus,omitempty"`
+	UID            types.UID                      `json:"uid"`
+	Critical       bool                           `json:"critical"`
+	Text           string                         `json:"text"`
+	Window         *api.ScheduledWindow           `json:"window,omitempty"`
+
+	// Recommendation and TargetObject are only available to the Notifier templates.
+	Recommendation *api.Recommendation `json:"-"`
+	TargetObject   map[string]any      `json:"-"`
+}
+
+// DedupKey returns the key which identifies the incidents of the same Recommendation,
+// so that the retries of a failed execution don't open new incidents.
+func (m Message) DedupKey() string {
+	return fmt.Sprintf("supervisor/%s/%s/%s", m.Namespace, m.Name, m.UID)
+}
+
+// EventDigest is the event of the periodic summary of a namespace.
+const EventDigest = "Digest"
+
+// Title returns the one line summary of the Message.
+func (m Message) Title() string {
+	if m.Name == "" {
+		return fmt.Sprintf("[%s] Namespace %s", m.Event, m.Namespace)
+	}
+	return fmt.Sprintf("[%s] Recommendation %s/%s", m.Event, m.Namespace, m.Name)
+}
+
+// Provider sends the Message to a notification backend.
+type Provider interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewProvider returns the Provider of the Notifier configured with its Secret.
+func NewProvider(ctx context.Context, kc client.Client, n *api.Notifier) (Provider, error) {
+	secret := &core.Secret{}
+	if err := kc.Get(ctx, client.ObjectKey{Namespace: n.Namespace, Name: n.Spec.SecretRef.Name}, secret); err != nil {
+		return nil, err
+	}
+
+	switch n.Spec.Type {
+	case api.NotifierTypeSlack, api.NotifierTypeMSTeams, api.NotifierTypeWebhook:
+		url, ok := secret.Data[api.NotifierURLKey]
+		if !ok {
+			return nil, fmt.Errorf("secret %s/%s doesn't have the %q key", secret.Namespace, secret.Name, api.NotifierURLKey)
+		}
+		return newWebhookProvider(n.Spec.Type, string(url), n.Spec.Template, n.Spec.Interactive), nil
+	case api.NotifierTypePagerDuty, api.NotifierTypeOpsgenie:
+		key, ok := secret.Data[api.NotifierIntegrationKey]
+		if !ok {
+			return nil, fmt.Errorf("secret %s/%s doesn't have the %q key", secret.Namespace, secret.Name, api.NotifierIntegrationKey)
+		}
+		return newIncidentProvider(n.Spec.Type, string(key)), nil
+	case api.NotifierTypeJira:
+		if n.Spec.Jira == nil {
+			return nil, errors.New("jira configuration is not provided for the Jira Notifier")
+		}
+		url, ok := secret.Data[api.NotifierURLKey]
+		if !ok {
+			return nil, fmt.Errorf("secret %s/%s doesn't have the %q key", secret.Namespace, secret.Name, api.NotifierURLKey)
+		}
+		return newJiraProvider(*n.Spec.Jira, string(url),
+			string(secret.Data[api.NotifierUsernameKey]), string(secret.Data[api.NotifierPasswordKey])), nil
+	case api.NotifierTypeEmail:
+		if n.Spec.Email == nil {
+			return nil, errors.New("email configuration is not provided for the Email Notifier")
+		}
+		return &emailProvider{
+			spec:     *n.Spec.Email,
+			template: n.Spec.Template,
+			username: string(secret.Data[api.NotifierUsernameKey]),
+			password: string(secret.Data[api.NotifierPasswordKey]),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown Notifier type %q", n.Spec.Type)
+	}
+}
+
+// NewMessage returns the Message of the given Event reason of the Recommendation.
+// The Recommendation is copied, as the Message may be sent after the Recommendation is modified.
+func NewMessage(ctx context.Context, kc client.Client, rcmd *api.Recommendation, event, text string) Message {
+	rcmd = rcmd.DeepCopy()
+	msg := Message{
+		Event:          event,
+		Namespace:      rcmd.Namespace,
+		Name:           rcmd.Name,
+		Target:         rcmd.Spec.Target,
+		Phase:          rcmd.Status.Phase,
+		ApprovalStatus: rcmd.Status.ApprovalStatus,
+		UID:            rcmd.UID,
+		Critical:       rcmd.IsCritical(),
+		Text:           text,
+		Window:         rcmd.Status.ScheduledWindow,
+		Recommendation: rcmd,
+	}
+	if obj, err := target.GetTarget(ctx, kc, rcmd); err == nil {
+		msg.TargetObject = obj.Object
+	}
+	return msg
+}
+
+// NotifyNamespace sends the Message through every Notifier of the Message namespace subscribed to its event.
+func NotifyNamespace(ctx context.Context, kc client.Client, msg Message) error {
+	notifiers := &api.NotifierList{}
+	if err := kc.List(ctx, notifiers, client.InNamespace(msg.Namespace)); err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range notifiers.Items {
+		n := &notifiers.Items[i]
+		if n.Spec.Paused || !isSubscribed(n, msg) {
+			continue
+		}
+		provider, err := NewProvider(ctx, kc, n)
+		if err == nil {
+			err = provider.Send(ctx, msg)
+		}
+		if err != nil {
+			klog.Errorf("failed to notify %q through Notifier %s/%s: %v", msg.Title(), n.Namespace, n.Name, err)
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func isSubscribed(n *api.Notifier, msg Message) bool {
+	if len(n.Spec.Events) == 0 {
+		if n.Spec.Type == api.NotifierTypePagerDuty || n.Spec.Type == api.NotifierTypeOpsgenie {
+			return isEscalated(msg)
+		}
+		return true
+	}
+	for _, e := range n.Spec.Events {
+		if e == msg.Event {
+			return true
+		}
+	}
+	return false
+}
+
+// isEscalated returns true for the failures of the executions and the expiry of the Critical Recommendations.
+func isEscalated(msg Message) bool {
+	switch msg.Event {
+	case api.EventReasonExecutionFailed, api.VerificationFailed, api.ActiveDeadlineExceeded, api.PrometheusGateBreached, api.OperationValidationFailed:
+		return true
+	case api.EventReasonExpired:
+		return msg.Critical
+	default:
+		return false
+	}
+}
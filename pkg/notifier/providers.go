@@ -0,0 +1,234 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+)
+
+// Action ids of the buttons of the interactive Slack messages
+const (
+	SlackActionApprove = "approve"
+	SlackActionReject  = "reject"
+)
+
+// webhookProvider posts the Message to an incoming webhook url in the payload format of the NotifierType.
+type webhookProvider struct {
+	typ         api.NotifierType
+	url         string
+	template    string
+	interactive bool
+	client      *http.Client
+}
+
+var _ Provider = &webhookProvider{}
+
+func newWebhookProvider(typ api.NotifierType, url, template string, interactive bool) *webhookProvider {
+	return &webhookProvider{
+		typ:         typ,
+		url:         url,
+		template:    template,
+		interactive: interactive,
+		client:      &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *webhookProvider) Send(ctx context.Context, msg Message) error {
+	var data []byte
+	var err error
+	if p.template != "" {
+		data, err = render(p.template, msg)
+	} else {
+		data, err = json.Marshal(p.payload(msg))
+	}
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%s webhook responded with status %d", p.typ, resp.StatusCode)
+	}
+	return nil
+}
+
+func (p *webhookProvider) payload(msg Message) any {
+	switch p.typ {
+	case api.NotifierTypeSlack:
+		text := fmt.Sprintf("*%s*\n%s", msg.Title(), msg.Text)
+		if !p.interactive || msg.Event != api.EventReasonAwaitingApproval {
+			return map[string]string{"text": text}
+		}
+		return slackInteractivePayload(text, msg)
+	case api.NotifierTypeMSTeams:
+		return map[string]string{
+			"@type":    "MessageCard",
+			"@context": "https://schema.org/extensions",
+			"summary":  msg.Title(),
+			"title":    msg.Title(),
+			"text":     msg.Text,
+		}
+	default:
+		return msg
+	}
+}
+
+// slackInteractivePayload returns the Slack Block Kit message with the Approve and Reject buttons.
+// The value of the buttons is the `namespace/name` of the Recommendation.
+func slackInteractivePayload(text string, msg Message) any {
+	value := msg.Namespace + "/" + msg.Name
+	button := func(actionID, label, style string) map[string]any {
+		return map[string]any{
+			"type":      "button",
+			"action_id": actionID,
+			"text":      map[string]string{"type": "plain_text", "text": label},
+			"style":     style,
+			"value":     value,
+		}
+	}
+	return map[string]any{
+		"text": text,
+		"blocks": []any{
+			map[string]any{
+				"type": "section",
+				"text": map[string]string{"type": "mrkdwn", "text": text},
+			},
+			map[string]any{
+				"type": "actions",
+				"elements": []any{
+					button(SlackActionApprove, "Approve", "primary"),
+					button(SlackActionReject, "Reject", "danger"),
+				},
+			},
+		},
+	}
+}
+
+// emailProvider sends the Message as a plain text email through the SMTP server.
+type emailProvider struct {
+	spec     api.EmailNotifier
+	template string
+	username string
+	password string
+}
+
+var _ Provider = &emailProvider{}
+
+// smtpTimeout bounds the whole conversation with the SMTP server, so that a hung server can't block the sender.
+const smtpTimeout = 30 * time.Second
+
+func (p *emailProvider) Send(ctx context.Context, msg Message) error {
+	addr := net.JoinHostPort(p.spec.Host, strconv.Itoa(int(p.spec.Port)))
+	var auth smtp.Auth
+	if p.username != "" {
+		auth = smtp.PlainAuth("", p.username, p.password, p.spec.Host)
+	}
+
+	text := msg.Text
+	if p.template != "" {
+		data, err := render(p.template, msg)
+		if err != nil {
+			return err
+		}
+		text = string(data)
+	}
+
+	var body strings.Builder
+	body.WriteString("From: " + p.spec.From + "\r\n")
+	body.WriteString("To: " + strings.Join(p.spec.To, ", ") + "\r\n")
+	body.WriteString("Subject: " + msg.Title() + "\r\n")
+	body.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	body.WriteString(text + "\r\n")
+	return sendMail(ctx, addr, p.spec.Host, auth, p.spec.From, p.spec.To, []byte(body.String()))
+}
+
+// sendMail works like smtp.SendMail, except the connection is dialed and used within the smtpTimeout.
+func sendMail(ctx context.Context, addr, host string, auth smtp.Auth, from string, to []string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, smtpTimeout)
+	defer cancel()
+
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return err
+	}
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close() // nolint:errcheck
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err = c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("smtp: server doesn't support AUTH")
+		}
+		if err = c.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err = c.Mail(from); err != nil {
+		return err
+	}
+	for _, rcpt := range to {
+		if err = c.Rcpt(rcpt); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(body); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
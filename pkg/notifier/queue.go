@@ -0,0 +1,87 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"hash/fnv"
+
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Queue sends the Messages through the Notifiers in the background, so that a slow notification backend
+// doesn't block the reconcilers. The Messages of the same Recommendation are sent by the same worker
+// in the order they are enqueued, e.g. the Jira issue is created before it is commented on.
+// The Messages are dropped when the queue of their worker is full.
+type Queue struct {
+	kc     client.Client
+	queues []chan Message
+}
+
+var _ manager.Runnable = &Queue{}
+
+// NewQueue returns a Queue having the given number of workers, each buffering up to size Messages.
+func NewQueue(kc client.Client, workers, size int) *Queue {
+	if workers < 1 {
+		workers = 1
+	}
+	q := &Queue{
+		kc:     kc,
+		queues: make([]chan Message, workers),
+	}
+	for i := range q.queues {
+		q.queues[i] = make(chan Message, size)
+	}
+	return q
+}
+
+// Enqueue adds the Message to the queue of its worker without blocking.
+// It returns false if the Message is dropped as the queue is full.
+func (q *Queue) Enqueue(msg Message) bool {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(msg.Namespace + "/" + msg.Name))
+	select {
+	case q.queues[h.Sum32()%uint32(len(q.queues))] <- msg:
+		return true
+	default:
+		klog.Errorf("dropped the notification %q as the notification queue is full", msg.Title())
+		return false
+	}
+}
+
+// Start runs the workers until the context is done.
+func (q *Queue) Start(ctx context.Context) error {
+	for _, queue := range q.queues {
+		go q.work(ctx, queue)
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func (q *Queue) work(ctx context.Context, queue <-chan Message) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-queue:
+			// the failures are logged for every Notifier by NotifyNamespace
+			_ = NotifyNamespace(ctx, q.kc, msg)
+		}
+	}
+}
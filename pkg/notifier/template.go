@@ -0,0 +1,38 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"text/template"
+
+	sprig "github.com/go-task/slim-sprig"
+)
+
+// render executes the Notifier template with the Message. The hermetic functions are used,
+// so that the environment of the operator can't be sent out through the templates of the tenants.
+func render(tmpl string, msg Message) ([]byte, error) {
+	t, err := template.New("notifier").Funcs(sprig.HermeticTxtFuncMap()).Option("missingkey=zero").Parse(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,289 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/rollout"
+
+	"github.com/jonboulle/clockwork"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Labels of the ManifestWorks referring back to the distributed object in the hub cluster
+const (
+	DistributedKindKey      = "supervisor.appscode.com/distributed-kind"
+	DistributedNamespaceKey = "supervisor.appscode.com/distributed-namespace"
+	DistributedNameKey      = "supervisor.appscode.com/distributed-name"
+)
+
+var (
+	ManifestWorkGVK   = schema.GroupVersionKind{Group: "work.open-cluster-management.io", Version: "v1", Kind: "ManifestWork"}
+	managedClusterGVK = schema.GroupVersionKind{Group: "cluster.open-cluster-management.io", Version: "v1", Kind: "ManagedClusterList"}
+)
+
+// Distributor distributes the objects of the hub cluster to the managed clusters through ManifestWorks.
+// The ManifestWork of a managed cluster is created in the namespace of the cluster and reports back
+// the status of the distributed object.
+type Distributor struct {
+	ctx   context.Context
+	kc    client.Client
+	clock clockwork.Clock
+}
+
+func NewDistributor(ctx context.Context, kc client.Client, clock clockwork.Clock) *Distributor {
+	return &Distributor{
+		ctx:   ctx,
+		kc:    kc,
+		clock: clock,
+	}
+}
+
+// Distribute applies the ManifestWork holding the manifest of the object in the clusters of the Distribution,
+// deletes the ManifestWorks of the clusters which are not part of the Distribution anymore and returns
+// the status of the object in each of the clusters. The previous statuses are used to keep the completion
+// times of the clusters. If the Distribution has a rollout strategy, only the clusters of the current wave
+// are applied; halted and wait are reported the same way as the rollout of a RecommendationGroup.
+func (d *Distributor) Distribute(obj client.Object, dist *api.Distribution, manifest runtime.Object, previous []api.ClusterStatus) (statuses []api.ClusterStatus, halted bool, wait time.Duration, err error) {
+	clusters, err := d.clusters(dist)
+	if err != nil {
+		return nil, false, 0, err
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(manifest)
+	if err != nil {
+		return nil, false, 0, err
+	}
+	delete(content, "status")
+	kind := manifest.GetObjectKind().GroupVersionKind().Kind
+
+	works, err := d.list(kind, obj.GetNamespace(), obj.GetName())
+	if err != nil {
+		return nil, false, 0, err
+	}
+	existing := map[string]*unstructured.Unstructured{}
+	for i := range works {
+		existing[works[i].GetNamespace()] = &works[i]
+	}
+	completionTimes := map[string]*metav1.Time{}
+	for _, s := range previous {
+		completionTimes[s.Cluster] = s.CompletionTime
+	}
+
+	units := make([]rollout.Unit, 0, len(clusters))
+	for _, cluster := range clusters {
+		status := api.ClusterStatus{Cluster: cluster}
+		if mw, ok := existing[cluster]; ok {
+			status = clusterStatus(cluster, mw)
+		}
+		status.CompletionTime = completionTimes[cluster]
+		if status.CompletionTime == nil && rollout.IsCompleted(status.Phase) {
+			status.CompletionTime = &metav1.Time{Time: d.clock.Now()}
+		}
+		u := rollout.Unit{Name: cluster, Started: existing[cluster] != nil, Phase: status.Phase}
+		if status.CompletionTime != nil {
+			u.CompletionTime = status.CompletionTime.Time
+		}
+		units = append(units, u)
+		statuses = append(statuses, status)
+	}
+
+	var allowed map[string]bool
+	if dist.Rollout != nil {
+		allowed, halted, wait = rollout.Allowed(units, dist.Rollout, d.clock.Now())
+	}
+
+	selected := map[string]bool{}
+	for _, cluster := range clusters {
+		selected[cluster] = true
+		if allowed != nil && !allowed[cluster] {
+			continue
+		}
+		mw := newManifestWork(cluster, kind, obj, content)
+		if cur, ok := existing[cluster]; ok {
+			cur.Object["spec"] = mw.Object["spec"]
+			cur.SetLabels(mw.GetLabels())
+			err = d.kc.Update(d.ctx, cur)
+		} else {
+			err = d.kc.Create(d.ctx, mw)
+		}
+		if err != nil {
+			return nil, false, 0, err
+		}
+	}
+
+	for cluster, mw := range existing {
+		if !selected[cluster] {
+			if err = client.IgnoreNotFound(d.kc.Delete(d.ctx, mw)); err != nil {
+				return nil, false, 0, err
+			}
+		}
+	}
+	return statuses, halted, wait, nil
+}
+
+// Cleanup deletes the ManifestWorks of the distributed object of the given kind, namespace and name.
+func (d *Distributor) Cleanup(kind, namespace, name string) error {
+	works, err := d.list(kind, namespace, name)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for i := range works {
+		errs = append(errs, client.IgnoreNotFound(d.kc.Delete(d.ctx, &works[i])))
+	}
+	return errors.Join(errs...)
+}
+
+func (d *Distributor) list(kind, namespace, name string) ([]unstructured.Unstructured, error) {
+	works := &unstructured.UnstructuredList{}
+	works.SetGroupVersionKind(ManifestWorkGVK.GroupVersion().WithKind(ManifestWorkGVK.Kind + "List"))
+	err := d.kc.List(d.ctx, works, client.MatchingLabels{
+		DistributedKindKey:      kind,
+		DistributedNamespaceKey: namespace,
+		DistributedNameKey:      name,
+	})
+	return works.Items, err
+}
+
+// clusters returns the sorted names of the listed and the selected ManagedClusters of the Distribution.
+func (d *Distributor) clusters(dist *api.Distribution) ([]string, error) {
+	names := map[string]bool{}
+	for _, c := range dist.Clusters {
+		names[c] = true
+	}
+	if dist.ClusterSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(dist.ClusterSelector)
+		if err != nil {
+			return nil, err
+		}
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(managedClusterGVK)
+		if err = d.kc.List(d.ctx, list, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, err
+		}
+		for _, c := range list.Items {
+			names[c.GetName()] = true
+		}
+	}
+
+	clusters := make([]string, 0, len(names))
+	for c := range names {
+		clusters = append(clusters, c)
+	}
+	sort.Strings(clusters)
+	return clusters, nil
+}
+
+// ManifestWorkName returns the name of the ManifestWork of the distributed object.
+func ManifestWorkName(kind, namespace, name string) string {
+	return fmt.Sprintf("supervisor-%s-%s-%s", strings.ToLower(kind), namespace, name)
+}
+
+func newManifestWork(cluster, kind string, obj client.Object, content map[string]any) *unstructured.Unstructured {
+	plural := strings.ToLower(kind) + "s"
+	mw := &unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": map[string]any{
+				"workload": map[string]any{
+					"manifests": []any{content},
+				},
+				"manifestConfigs": []any{
+					map[string]any{
+						"resourceIdentifier": map[string]any{
+							"group":     api.GroupVersion.Group,
+							"resource":  plural,
+							"namespace": obj.GetNamespace(),
+							"name":      obj.GetName(),
+						},
+						"feedbackRules": []any{
+							map[string]any{
+								"type": "JSONPaths",
+								"jsonPaths": []any{
+									map[string]any{"name": "phase", "path": ".status.phase"},
+									map[string]any{"name": "reason", "path": ".status.reason"},
+									map[string]any{"name": "approvalStatus", "path": ".status.approvalStatus"},
+									map[string]any{"name": "status", "path": ".status.status"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	mw.SetGroupVersionKind(ManifestWorkGVK)
+	mw.SetNamespace(cluster)
+	mw.SetName(ManifestWorkName(kind, obj.GetNamespace(), obj.GetName()))
+	mw.SetLabels(labels.Set{
+		DistributedKindKey:      kind,
+		DistributedNamespaceKey: obj.GetNamespace(),
+		DistributedNameKey:      obj.GetName(),
+	})
+	return mw
+}
+
+// clusterStatus returns the status of the distributed object from the Applied condition
+// and the status feedback of the ManifestWork.
+func clusterStatus(cluster string, mw *unstructured.Unstructured) api.ClusterStatus {
+	status := api.ClusterStatus{Cluster: cluster}
+	conditions, _, _ := unstructured.NestedSlice(mw.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]any)
+		if ok && cond["type"] == "Applied" && cond["status"] == string(metav1.ConditionTrue) {
+			status.Applied = true
+		}
+	}
+
+	manifests, _, _ := unstructured.NestedSlice(mw.Object, "status", "resourceStatus", "manifests")
+	for _, m := range manifests {
+		manifest, ok := m.(map[string]any)
+		if !ok {
+			continue
+		}
+		values, _, _ := unstructured.NestedSlice(manifest, "statusFeedback", "values")
+		for _, v := range values {
+			value, ok := v.(map[string]any)
+			if !ok {
+				continue
+			}
+			str, _, _ := unstructured.NestedString(value, "fieldValue", "string")
+			switch value["name"] {
+			case "phase":
+				status.Phase = api.RecommendationPhase(str)
+			case "reason":
+				status.Reason = str
+			case "approvalStatus", "status":
+				if str != "" {
+					status.ApprovalStatus = api.ApprovalStatus(str)
+				}
+			}
+		}
+	}
+	return status
+}
@@ -0,0 +1,73 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parallelism
+
+import (
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/policy"
+	"kubeops.dev/supervisor/pkg/target"
+
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// InProgressForSameTarget returns the other InProgress Recommendations of the same target object.
+// Only one Recommendation is allowed to execute at a time for a target object regardless of Parallelism.
+func (r *ParallelRunner) InProgressForSameTarget() ([]api.Recommendation, error) {
+	rcmdList := &api.RecommendationList{}
+	if err := r.kc.List(r.ctx, rcmdList, client.InNamespace(r.rcmd.Namespace), client.MatchingFields{api.RecommendationTargetIndex: r.rcmd.TargetIndexKey()}); err != nil {
+		return nil, err
+	}
+
+	var running []api.Recommendation
+	for _, rc := range rcmdList.Items {
+		if rc.Name == r.rcmd.Name || rc.Status.Phase != api.InProgress {
+			continue
+		}
+		if isSameTarget(r.rcmd, &rc) {
+			running = append(running, rc)
+		}
+	}
+	return running, nil
+}
+
+// ConcurrencyPolicy returns the ConcurrencyPolicy for the Recommendation.
+// The annotation of the target object gets priority over the ApprovalPolicy. Queue is used by default.
+func (r *ParallelRunner) ConcurrencyPolicy() (api.ConcurrencyPolicy, error) {
+	obj, err := target.GetTarget(r.ctx, r.kc, r.rcmd)
+	if err != nil && !kerr.IsNotFound(err) {
+		return "", err
+	}
+	if obj != nil {
+		if cp, ok := obj.GetAnnotations()[api.ConcurrencyPolicyKey]; ok {
+			return api.ConcurrencyPolicy(cp), nil
+		}
+	}
+
+	approvalPolicy, err := policy.NewApprovalPolicyFinder(r.ctx, r.kc, r.rcmd).FindApprovalPolicy()
+	if err != nil {
+		return "", err
+	}
+	if approvalPolicy != nil && approvalPolicy.ConcurrencyPolicy != "" {
+		return approvalPolicy.ConcurrencyPolicy, nil
+	}
+	return api.QueueConcurrent, nil
+}
+
+func isSameTarget(a, b *api.Recommendation) bool {
+	return a.Namespace == b.Namespace && api.IsSameTarget(a.Spec.Target, b.Spec.Target)
+}
@@ -0,0 +1,186 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parallelism
+
+import (
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/scheduler"
+	"kubeops.dev/supervisor/pkg/sharding"
+
+	cutil "kmodules.xyz/client-go/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IsWithinExecutionLimit returns true if executing the Recommendation doesn't exceed the maximum number of
+// InProgress Recommendations in the cluster and in its namespace. Zero(0) limit is treated as no limit.
+// Unlike Parallelism, the limits are never compromised to maintain the deadline.
+// If the cluster limit is set, the namespaces waiting for it are served round-robin instead of FIFO,
+// or in the order of the weighted fair scheduling of the priority classes if the Scheduler is enabled.
+// If the reconciliation is sharded, only the Recommendations of the namespaces owned by the given Shard are counted,
+// i.e. the cluster limit is enforced by each shard independently without racing against the other shards.
+func (r *ParallelRunner) IsWithinExecutionLimit(maxCluster, maxNamespace int, sched *scheduler.Scheduler, shard sharding.Shard) (bool, error) {
+	if maxCluster <= 0 && maxNamespace <= 0 {
+		return true, nil
+	}
+
+	rcmdList := &api.RecommendationList{}
+	if err := r.kc.List(r.ctx, rcmdList, inProgress); err != nil {
+		return false, err
+	}
+	ownedBy(shard, rcmdList)
+
+	var inCluster, inNamespace int
+	for _, rc := range rcmdList.Items {
+		if rc.Status.Phase != api.InProgress {
+			continue
+		}
+		inCluster++
+		if rc.Namespace == r.rcmd.Namespace {
+			inNamespace++
+		}
+	}
+
+	if maxCluster > 0 && inCluster >= maxCluster {
+		return false, nil
+	}
+	if maxNamespace > 0 && inNamespace >= maxNamespace {
+		return false, nil
+	}
+	if maxCluster > 0 && sched.Enabled() {
+		return r.isScheduled(sched, maxCluster-inCluster, rcmdList, shard)
+	}
+	if maxCluster > 0 {
+		return r.isNamespaceTurn(rcmdList, shard)
+	}
+	return true, nil
+}
+
+// ownedBy removes the Recommendations of the namespaces not owned by the given Shard from the list.
+func ownedBy(shard sharding.Shard, list *api.RecommendationList) {
+	if !shard.Enabled() {
+		return
+	}
+	items := list.Items[:0]
+	for _, rc := range list.Items {
+		if shard.OwnsNamespace(rc.Namespace) {
+			items = append(items, rc)
+		}
+	}
+	list.Items = items
+}
+
+// namespaceTurn holds the ordering of a namespace waiting for the cluster execution limit.
+type namespaceTurn struct {
+	inProgress    int
+	lastStarted   time.Time
+	oldestWaiting time.Time
+}
+
+func (t namespaceTurn) before(o namespaceTurn) bool {
+	if t.inProgress != o.inProgress {
+		return t.inProgress < o.inProgress
+	}
+	if !t.lastStarted.Equal(o.lastStarted) {
+		return t.lastStarted.Before(o.lastStarted)
+	}
+	return t.oldestWaiting.Before(o.oldestWaiting)
+}
+
+// isNamespaceTurn returns true if the namespace of the Recommendation is the next one to execute among the namespaces
+// having Recommendations waiting for the cluster execution limit. The namespaces are served round-robin, i.e. the
+// namespace with the fewest InProgress Recommendations is preferred, then the one whose last execution is the oldest.
+// So a namespace with lots of Recommendations can't starve the others for an entire window.
+func (r *ParallelRunner) isNamespaceTurn(inProgressList *api.RecommendationList, shard sharding.Shard) (bool, error) {
+	waitingList := &api.RecommendationList{}
+	if err := r.kc.List(r.ctx, waitingList, client.MatchingFields{api.RecommendationPhaseIndex: string(api.Waiting)}); err != nil {
+		return false, err
+	}
+	ownedBy(shard, waitingList)
+	turns := map[string]*namespaceTurn{
+		r.rcmd.Namespace: {oldestWaiting: r.rcmd.CreationTimestamp.Time},
+	}
+	for _, rc := range waitingList.Items {
+		if !scheduler.IsWaitingForExecutionLimit(&rc) {
+			continue
+		}
+		t, ok := turns[rc.Namespace]
+		if !ok {
+			t = &namespaceTurn{oldestWaiting: rc.CreationTimestamp.Time}
+			turns[rc.Namespace] = t
+		}
+		if rc.CreationTimestamp.Time.Before(t.oldestWaiting) {
+			t.oldestWaiting = rc.CreationTimestamp.Time
+		}
+	}
+	if len(turns) == 1 {
+		return true, nil
+	}
+
+	for _, rc := range inProgressList.Items {
+		if t, ok := turns[rc.Namespace]; ok && rc.Status.Phase == api.InProgress {
+			t.inProgress++
+			if _, cond := cutil.GetCondition(rc.Status.Conditions, api.SuccessfullyCreatedOperation); cond != nil && cond.LastTransitionTime.After(t.lastStarted) {
+				t.lastStarted = cond.LastTransitionTime.Time
+			}
+		}
+	}
+	meList := &api.MaintenanceExecutionList{}
+	if err := r.kc.List(r.ctx, meList); err != nil {
+		return false, err
+	}
+	for _, me := range meList.Items {
+		if t, ok := turns[me.Namespace]; ok && me.Spec.StartTime != nil && me.Spec.StartTime.After(t.lastStarted) {
+			t.lastStarted = me.Spec.StartTime.Time
+		}
+	}
+
+	own := *turns[r.rcmd.Namespace]
+	for ns, t := range turns {
+		if ns != r.rcmd.Namespace && (t.before(own) || (!own.before(*t) && ns < r.rcmd.Namespace)) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// isScheduled returns true if the Recommendation is within the free capacity of the cluster
+// in the ordering of the Scheduler.
+func (r *ParallelRunner) isScheduled(sched *scheduler.Scheduler, free int, inProgressList *api.RecommendationList, shard sharding.Shard) (bool, error) {
+	waitingList := &api.RecommendationList{}
+	if err := r.kc.List(r.ctx, waitingList, client.MatchingFields{api.RecommendationPhaseIndex: string(api.Waiting)}); err != nil {
+		return false, err
+	}
+	ownedBy(shard, waitingList)
+	waiting := []api.Recommendation{*r.rcmd}
+	for _, rc := range waitingList.Items {
+		if scheduler.IsWaitingForExecutionLimit(&rc) && (rc.Namespace != r.rcmd.Namespace || rc.Name != r.rcmd.Name) {
+			waiting = append(waiting, rc)
+		}
+	}
+
+	for i, rc := range sched.Order(inProgressList.Items, waiting) {
+		if i >= free {
+			break
+		}
+		if rc.Namespace == r.rcmd.Namespace && rc.Name == r.rcmd.Name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -20,11 +20,15 @@ import (
 	"context"
 
 	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/group"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// inProgress lists only the InProgress Recommendations from the phase index of the cache.
+var inProgress = client.MatchingFields{api.RecommendationPhaseIndex: string(api.InProgress)}
+
 type ParallelRunner struct {
 	ctx  context.Context
 	kc   client.Client
@@ -52,11 +56,14 @@ func (r *ParallelRunner) MaintainParallelism() (bool, error) {
 func (r *ParallelRunner) isMaintainingQueuePerNamespace() (bool, error) {
 	rcmdList := &api.RecommendationList{}
 
-	if err := r.kc.List(r.ctx, rcmdList, client.InNamespace(r.rcmd.Namespace)); err != nil {
+	if err := r.kc.List(r.ctx, rcmdList, client.InNamespace(r.rcmd.Namespace), inProgress); err != nil {
 		return false, err
 	}
 
 	for _, rc := range rcmdList.Items {
+		if group.IsSameGroup(r.rcmd, &rc) {
+			continue
+		}
 		if rc.Status.Phase == api.InProgress {
 			return false, nil
 		}
@@ -75,10 +82,10 @@ func (r *ParallelRunner) isMaintainingQueuePerTargetAndNamespace() (bool, error)
 	}
 
 	rcmdList := &api.RecommendationList{}
-	if err := r.kc.List(r.ctx, rcmdList, client.InNamespace(r.rcmd.Namespace)); err != nil {
+	if err := r.kc.List(r.ctx, rcmdList, client.InNamespace(r.rcmd.Namespace), inProgress); err != nil {
 		return false, err
 	}
-	return isMaintainingQueuePerGK(reqGK, rcmdList)
+	return r.isMaintainingQueuePerGK(reqGK, rcmdList)
 }
 
 func (r *ParallelRunner) isMaintainingQueuePerTarget() (bool, error) {
@@ -92,14 +99,17 @@ func (r *ParallelRunner) isMaintainingQueuePerTarget() (bool, error) {
 	}
 
 	rcmdList := &api.RecommendationList{}
-	if err := r.kc.List(r.ctx, rcmdList); err != nil {
+	if err := r.kc.List(r.ctx, rcmdList, inProgress); err != nil {
 		return false, err
 	}
-	return isMaintainingQueuePerGK(reqGK, rcmdList)
+	return r.isMaintainingQueuePerGK(reqGK, rcmdList)
 }
 
-func isMaintainingQueuePerGK(reqGK schema.GroupKind, rcList *api.RecommendationList) (bool, error) {
+func (r *ParallelRunner) isMaintainingQueuePerGK(reqGK schema.GroupKind, rcList *api.RecommendationList) (bool, error) {
 	for _, rc := range rcList.Items {
+		if group.IsSameGroup(r.rcmd, &rc) {
+			continue
+		}
 		gv, err := schema.ParseGroupVersion(*rc.Spec.Target.APIGroup)
 		if err != nil {
 			return false, err
@@ -0,0 +1,82 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parallelism
+
+import (
+	"sort"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/group"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// QueuePosition returns the 1-based position of the Recommendation in the execution queue of its Parallelism
+// and the length of the queue. The InProgress Recommendations are ahead of the queue, followed by the Approved
+// Recommendations waiting for execution in the order of their creation.
+// The members of the same RecommendationGroup don't queue up for each other, as in MaintainParallelism.
+// Zero position is returned if the Recommendation is not queued for execution.
+func (r *ParallelRunner) QueuePosition() (position int, length int, err error) {
+	perTarget := r.rcmd.Status.Parallelism == api.QueuePerTarget || r.rcmd.Status.Parallelism == api.QueuePerTargetAndNamespace
+	var opts []client.ListOption
+	if r.rcmd.Status.Parallelism != api.QueuePerTarget {
+		opts = append(opts, client.InNamespace(r.rcmd.Namespace))
+	}
+	rcmdList := &api.RecommendationList{}
+	if err = r.kc.List(r.ctx, rcmdList, opts...); err != nil {
+		return 0, 0, err
+	}
+
+	queue := make([]api.Recommendation, 0)
+	for _, rc := range rcmdList.Items {
+		isSelf := rc.Namespace == r.rcmd.Namespace && rc.Name == r.rcmd.Name
+		if !isQueued(&rc) || (!isSelf && group.IsSameGroup(r.rcmd, &rc)) {
+			continue
+		}
+		if perTarget && targetGroupKind(&rc) != targetGroupKind(r.rcmd) {
+			continue
+		}
+		queue = append(queue, rc)
+	}
+	sort.SliceStable(queue, func(i, j int) bool {
+		ip, jp := queue[i].Status.Phase == api.InProgress, queue[j].Status.Phase == api.InProgress
+		if ip != jp {
+			return ip
+		}
+		return queue[i].CreationTimestamp.Before(&queue[j].CreationTimestamp)
+	})
+
+	for i, rc := range queue {
+		if rc.Namespace == r.rcmd.Namespace && rc.Name == r.rcmd.Name {
+			return i + 1, len(queue), nil
+		}
+	}
+	return 0, len(queue), nil
+}
+
+// isQueued returns true if the Recommendation is Approved and not completed yet.
+func isQueued(rc *api.Recommendation) bool {
+	if rc.Status.ApprovalStatus != api.ApprovalApproved {
+		return false
+	}
+	return rc.Status.Phase == api.InProgress || rc.Status.Phase == api.Waiting || rc.Status.Phase == api.Pending || rc.Status.Phase == ""
+}
+
+func targetGroupKind(rc *api.Recommendation) schema.GroupKind {
+	return rc.TargetGroupKind()
+}
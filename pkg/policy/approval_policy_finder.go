@@ -19,12 +19,19 @@ package policy
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
 	"kubeops.dev/supervisor/pkg/shared"
+	"kubeops.dev/supervisor/pkg/target"
 
-	"gomodules.xyz/pointer"
+	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -32,6 +39,8 @@ type ApprovalPolicyFinder struct {
 	ctx  context.Context
 	kc   client.Client
 	rcmd *api.Recommendation
+
+	targetObj *unstructured.Unstructured
 }
 
 func NewApprovalPolicyFinder(ctx context.Context, kc client.Client, rcmd *api.Recommendation) *ApprovalPolicyFinder {
@@ -42,11 +51,30 @@ func NewApprovalPolicyFinder(ctx context.Context, kc client.Client, rcmd *api.Re
 	}
 }
 
+// FindApprovalPolicy returns the ApprovalPolicy matched with the Recommendation.
+// An ApprovalPolicy of the Deny effect is preferred over the ones of the Allow effect.
+// The matched ClusterApprovalPolicy is returned as an ApprovalPolicy, if no ApprovalPolicy of the namespace is matched.
 func (c *ApprovalPolicyFinder) FindApprovalPolicy() (*api.ApprovalPolicy, error) {
+	return c.findApprovalPolicy(nil)
+}
+
+// FindActiveApprovalPolicy returns the ApprovalPolicy matched with the Recommendation
+// which is valid at the given time.
+func (c *ApprovalPolicyFinder) FindActiveApprovalPolicy(now time.Time) (*api.ApprovalPolicy, error) {
+	return c.findApprovalPolicy(&now)
+}
+
+func (c *ApprovalPolicyFinder) findApprovalPolicy(now *time.Time) (*api.ApprovalPolicy, error) {
 	policyList := &api.ApprovalPolicyList{}
 	if err := c.kc.List(c.ctx, policyList, client.InNamespace(c.rcmd.Namespace)); err != nil {
 		return nil, err
 	}
+	clusterPolicies, err := c.getApprovalPoliciesFromClusterApprovalPolicies()
+	if err != nil {
+		return nil, err
+	}
+	policyList.Items = append(policyList.Items, clusterPolicies...)
+
 	if c.rcmd.Spec.Target.APIGroup == nil {
 		return nil, errors.New("target APIGroup is not provided")
 	}
@@ -59,28 +87,202 @@ func (c *ApprovalPolicyFinder) FindApprovalPolicy() (*api.ApprovalPolicy, error)
 		Kind:  opsGVK.Kind,
 	}
 
+	opsType, err := shared.GetOperationType(c.rcmd.Spec.Operation)
+	if err != nil {
+		return nil, err
+	}
+
 	targetObjGk := metav1.GroupKind{
-		Group: pointer.String(c.rcmd.Spec.Target.APIGroup),
+		Group: api.TargetGroup(c.rcmd.Spec.Target.APIGroup),
 		Kind:  c.rcmd.Spec.Target.Kind,
 	}
 
-	for _, p := range policyList.Items {
+	var allowed *api.ApprovalPolicy
+	for i := range policyList.Items {
+		p := &policyList.Items[i]
+		if allowed != nil && p.Effect != api.DenyEffect {
+			continue
+		}
+		if now != nil && !p.IsValidAt(*now) {
+			continue
+		}
+		excepted, err := c.isTargetExcepted(p.Except)
+		if err != nil {
+			return nil, err
+		}
+		if excepted {
+			continue
+		}
 		for _, t := range p.Targets {
-			if isMatched(t, targetObjGk, targetOpsGK) {
-				return &p, nil
+			if !isMatched(t, targetObjGk, targetOpsGK, opsType) {
+				continue
+			}
+			selected, err := c.isTargetSelected(t)
+			if err != nil {
+				return nil, err
+			}
+			if selected && p.Effect == api.DenyEffect {
+				return p, nil
+			}
+			if selected && allowed == nil {
+				allowed = p
 			}
 		}
 	}
-	return nil, nil
+	return allowed, nil
 }
 
-func isMatched(ref api.TargetRef, targetObjGK, targetOpsGK metav1.GroupKind) bool {
+func isMatched(ref api.TargetRef, targetObjGK, targetOpsGK metav1.GroupKind, opsType string) bool {
 	if ref.Group == targetObjGK.Group && ref.Kind == targetObjGK.Kind {
 		for _, op := range ref.Operations {
-			if op.GroupKind == targetOpsGK {
+			if op.GroupKind == targetOpsGK && isOperationTypeMatched(op, opsType) {
 				return true
 			}
 		}
 	}
 	return false
 }
+
+func isOperationTypeMatched(op api.Operation, opsType string) bool {
+	if len(op.Types) == 0 {
+		return true
+	}
+	for _, t := range op.Types {
+		if t == opsType {
+			return true
+		}
+	}
+	return false
+}
+
+// isTargetExcepted returns true if the target object of the Recommendation is excluded by the PolicyException.
+func (c *ApprovalPolicyFinder) isTargetExcepted(except *api.PolicyException) (bool, error) {
+	if except == nil {
+		return false, nil
+	}
+	for _, ns := range except.Namespaces {
+		if ns == c.rcmd.Namespace {
+			return true, nil
+		}
+	}
+	for _, name := range except.Names {
+		if name == c.rcmd.Spec.Target.Name {
+			return true, nil
+		}
+	}
+	if except.Selector == nil {
+		return false, nil
+	}
+
+	if err := c.loadTarget(); err != nil {
+		return false, err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(except.Selector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(c.targetObj.GetLabels())), nil
+}
+
+func (c *ApprovalPolicyFinder) loadTarget() error {
+	if c.targetObj != nil {
+		return nil
+	}
+	obj, err := target.GetTarget(c.ctx, c.kc, c.rcmd)
+	if err != nil {
+		return err
+	}
+	c.targetObj = obj
+	return nil
+}
+
+// isTargetSelected returns true if the target object of the Recommendation is selected
+// by the label selector and the field selector of the TargetRef.
+func (c *ApprovalPolicyFinder) isTargetSelected(ref api.TargetRef) (bool, error) {
+	if ref.Selector == nil && ref.FieldSelector == "" {
+		return true, nil
+	}
+	if err := c.loadTarget(); err != nil {
+		return false, err
+	}
+
+	if ref.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(ref.Selector)
+		if err != nil {
+			return false, err
+		}
+		if !selector.Matches(labels.Set(c.targetObj.GetLabels())) {
+			return false, nil
+		}
+	}
+
+	if ref.FieldSelector != "" {
+		selector, err := fields.ParseSelector(ref.FieldSelector)
+		if err != nil {
+			return false, err
+		}
+		set := fields.Set{}
+		for _, req := range selector.Requirements() {
+			val, found, err := unstructured.NestedFieldNoCopy(c.targetObj.Object, strings.Split(req.Field, ".")...)
+			if err != nil {
+				return false, err
+			}
+			if found {
+				set[req.Field] = fmt.Sprint(val)
+			}
+		}
+		if !selector.Matches(set) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// getApprovalPoliciesFromClusterApprovalPolicies returns the ClusterApprovalPolicies effective for the namespace
+// of the Recommendation as ApprovalPolicies.
+func (c *ApprovalPolicyFinder) getApprovalPoliciesFromClusterApprovalPolicies() ([]api.ApprovalPolicy, error) {
+	clusterPolicyList := &api.ClusterApprovalPolicyList{}
+	if err := c.kc.List(c.ctx, clusterPolicyList); err != nil {
+		return nil, err
+	}
+
+	var ns *core.Namespace
+	policies := make([]api.ApprovalPolicy, 0, len(clusterPolicyList.Items))
+	for _, cp := range clusterPolicyList.Items {
+		if cp.NamespaceSelector != nil {
+			if ns == nil {
+				ns = &core.Namespace{}
+				if err := c.kc.Get(c.ctx, client.ObjectKey{Name: c.rcmd.Namespace}, ns); err != nil {
+					return nil, err
+				}
+			}
+			selector, err := metav1.LabelSelectorAsSelector(cp.NamespaceSelector)
+			if err != nil {
+				return nil, err
+			}
+			if !selector.Matches(labels.Set(ns.Labels)) {
+				continue
+			}
+		}
+		policies = append(policies, api.ApprovalPolicy{
+			ObjectMeta:           cp.ObjectMeta,
+			MaintenanceWindowRef: cp.MaintenanceWindowRef,
+			Targets:              cp.Targets,
+			Effect:               cp.Effect,
+			DenyReason:           cp.DenyReason,
+			MaxSeverity:          cp.MaxSeverity,
+			MaxImpact:            cp.MaxImpact,
+			RequiredApprovals:    cp.RequiredApprovals,
+			AutoRejectAfter:      cp.AutoRejectAfter,
+			MaxQueueDuration:     cp.MaxQueueDuration,
+			Except:               cp.Except,
+			ValidFrom:            cp.ValidFrom,
+			ValidUntil:           cp.ValidUntil,
+			ConcurrencyPolicy:    cp.ConcurrencyPolicy,
+			Backup:               cp.Backup,
+			ServiceNow:           cp.ServiceNow,
+			PrometheusGates:      cp.PrometheusGates,
+		})
+	}
+	return policies, nil
+}
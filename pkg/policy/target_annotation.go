@@ -0,0 +1,54 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"strings"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/shared"
+	"kubeops.dev/supervisor/pkg/target"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IsApprovedByTargetAnnotation returns true if the operation type of the Recommendation is listed
+// in the `supervisor.appscode.com/approve-all` annotation of the target object.
+// It works as an implicit ApprovalPolicy for that one target object.
+func IsApprovedByTargetAnnotation(ctx context.Context, kc client.Client, rcmd *api.Recommendation) (bool, error) {
+	obj, err := target.GetTarget(ctx, kc, rcmd)
+	if err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	val, ok := obj.GetAnnotations()[api.ApproveAllKey]
+	if !ok {
+		return false, nil
+	}
+
+	opsType, err := shared.GetOperationType(rcmd.Spec.Operation)
+	if err != nil {
+		return false, err
+	}
+	for _, t := range strings.Split(val, ",") {
+		t = strings.TrimSpace(t)
+		if t == "*" || (opsType != "" && strings.EqualFold(t, opsType)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
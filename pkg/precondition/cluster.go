@@ -0,0 +1,140 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package precondition
+
+import (
+	"context"
+	"fmt"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/evaluator"
+	"kubeops.dev/supervisor/pkg/prometheus"
+	"kubeops.dev/supervisor/pkg/shared"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Preconditions holds the cluster level preconditions that must pass before any execution is started.
+type Preconditions struct {
+	// RequireReadyNodes requires every node of the cluster to be Ready.
+	RequireReadyNodes bool
+	// RequireNoActiveOps requires no other operation of the same kind to be running on the target.
+	RequireNoActiveOps bool
+	// Queries holds the PromQL thresholds that must be satisfied.
+	Queries []prometheus.Threshold
+}
+
+func (p Preconditions) IsEmpty() bool {
+	return !p.RequireReadyNodes && !p.RequireNoActiveOps && len(p.Queries) == 0
+}
+
+type ClusterChecker struct {
+	ctx           context.Context
+	kc            client.Client
+	rcmd          *api.Recommendation
+	preconditions Preconditions
+	prom          *prometheus.Client
+}
+
+func NewClusterChecker(ctx context.Context, kc client.Client, rcmd *api.Recommendation, preconditions Preconditions, prom *prometheus.Client) *ClusterChecker {
+	return &ClusterChecker{
+		ctx:           ctx,
+		kc:            kc,
+		rcmd:          rcmd,
+		preconditions: preconditions,
+		prom:          prom,
+	}
+}
+
+// Check returns false along with the reason if any of the preconditions fails.
+func (c *ClusterChecker) Check() (passed bool, msg string, err error) {
+	if c.preconditions.RequireReadyNodes {
+		if msg, err := c.checkNodes(); err != nil || msg != "" {
+			return false, msg, err
+		}
+	}
+	if c.preconditions.RequireNoActiveOps {
+		if msg, err := c.checkActiveOps(); err != nil || msg != "" {
+			return false, msg, err
+		}
+	}
+	for _, t := range c.preconditions.Queries {
+		if c.prom == nil {
+			return false, "", fmt.Errorf("prometheus is not configured to evaluate %s", t)
+		}
+		ok, msg, err := c.prom.Evaluate(c.ctx, t)
+		if err != nil || !ok {
+			return false, msg, err
+		}
+	}
+	return true, "", nil
+}
+
+func (c *ClusterChecker) checkNodes() (string, error) {
+	nodeList := &core.NodeList{}
+	if err := c.kc.List(c.ctx, nodeList); err != nil {
+		return "", err
+	}
+	for _, node := range nodeList.Items {
+		if !isNodeReady(&node) {
+			return fmt.Sprintf("Node %s is not Ready", node.Name), nil
+		}
+	}
+	return "", nil
+}
+
+func isNodeReady(node *core.Node) bool {
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == core.NodeReady {
+			return cond.Status == core.ConditionTrue
+		}
+	}
+	return false
+}
+
+// checkActiveOps looks for the operations of the same kind as the operation of the Recommendation referring to the target
+// by `spec.databaseRef`, like the KubeDB OpsRequests, which are neither successful nor failed.
+func (c *ClusterChecker) checkActiveOps() (string, error) {
+	gvk, err := shared.GetGVK(c.rcmd.Spec.Operation)
+	if err != nil {
+		return "", err
+	}
+	opsList := &unstructured.UnstructuredList{}
+	opsList.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := c.kc.List(c.ctx, opsList, client.InNamespace(c.rcmd.Namespace)); err != nil {
+		return "", err
+	}
+	for i, ops := range opsList.Items {
+		if c.rcmd.Status.CreatedOperationRef != nil && ops.GetName() == c.rcmd.Status.CreatedOperationRef.Name {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(ops.Object, "spec", "databaseRef", "name")
+		if name != c.rcmd.Spec.Target.Name {
+			continue
+		}
+		success, err := evaluator.New(&opsList.Items[i], c.rcmd.Spec.Rules).EvaluateSuccessfulOperation()
+		if err != nil {
+			return "", err
+		}
+		if success == nil {
+			return fmt.Sprintf("%s %s is running on the target", gvk.Kind, ops.GetName()), nil
+		}
+	}
+	return "", nil
+}
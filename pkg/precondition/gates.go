@@ -0,0 +1,98 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package precondition
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/policy"
+	"kubeops.dev/supervisor/pkg/prometheus"
+
+	"github.com/jonboulle/clockwork"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type GateChecker struct {
+	ctx   context.Context
+	kc    client.Client
+	rcmd  *api.Recommendation
+	prom  *prometheus.Client
+	clock clockwork.Clock
+}
+
+func NewGateChecker(ctx context.Context, kc client.Client, rcmd *api.Recommendation, prom *prometheus.Client, clock clockwork.Clock) *GateChecker {
+	return &GateChecker{
+		ctx:   ctx,
+		kc:    kc,
+		rcmd:  rcmd,
+		prom:  prom,
+		clock: clock,
+	}
+}
+
+// Gates returns the PrometheusGates of the Recommendation followed by the gates of its active ApprovalPolicy.
+func (c *GateChecker) Gates() ([]api.PrometheusGate, error) {
+	gates := append([]api.PrometheusGate{}, c.rcmd.Spec.PrometheusGates...)
+	approvalPolicy, err := policy.NewApprovalPolicyFinder(c.ctx, c.kc, c.rcmd).FindActiveApprovalPolicy(c.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	if approvalPolicy != nil {
+		gates = append(gates, approvalPolicy.PrometheusGates...)
+	}
+	return gates, nil
+}
+
+// Check evaluates the PrometheusGates of the Recommendation and returns false along with the breach if any of them is breached.
+func (c *GateChecker) Check() (passed bool, msg string, err error) {
+	gates, err := c.Gates()
+	if err != nil {
+		return false, "", err
+	}
+	if len(gates) == 0 {
+		return true, "", nil
+	}
+	if c.prom == nil {
+		return false, "", errors.New("prometheus-url is not configured to evaluate the PrometheusGates")
+	}
+	for _, gate := range gates {
+		t, err := toThreshold(gate)
+		if err != nil {
+			return false, "", err
+		}
+		ok, msg, err := c.prom.Evaluate(c.ctx, t)
+		if err != nil {
+			return false, "", fmt.Errorf("failed to evaluate PrometheusGate %s: %w", gate.Name, err)
+		}
+		if !ok {
+			return false, fmt.Sprintf("PrometheusGate %s: %s", gate.Name, msg), nil
+		}
+	}
+	return true, "", nil
+}
+
+func toThreshold(gate api.PrometheusGate) (prometheus.Threshold, error) {
+	v, err := strconv.ParseFloat(gate.Threshold, 64)
+	if err != nil {
+		return prometheus.Threshold{}, fmt.Errorf("invalid threshold of PrometheusGate %s: %w", gate.Name, err)
+	}
+	return prometheus.Threshold{Query: gate.Query, Operator: gate.Operator, Value: v}, nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	promapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+const queryTimeout = 30 * time.Second
+
+// Client evaluates the PromQL thresholds against a Prometheus server.
+type Client struct {
+	api promv1.API
+}
+
+func NewClient(address string) (*Client, error) {
+	c, err := promapi.NewClient(promapi.Config{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	return &Client{api: promv1.NewAPI(c)}, nil
+}
+
+// Threshold is a PromQL query whose result is compared with a value, e.g. `max(mysql_slave_lag_seconds) < 10`.
+type Threshold struct {
+	Query    string
+	Operator string
+	Value    float64
+}
+
+func (t Threshold) String() string {
+	return fmt.Sprintf("%s %s %v", t.Query, t.Operator, t.Value)
+}
+
+var thresholdRegex = regexp.MustCompile(`^(.+?)\s*(<=|>=|==|!=|<|>)\s*([-+]?[0-9]*\.?[0-9]+(?:[eE][-+]?[0-9]+)?)$`)
+
+// ParseThreshold parses a threshold in `<query> <operator> <value>` format.
+func ParseThreshold(s string) (Threshold, error) {
+	m := thresholdRegex.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return Threshold{}, fmt.Errorf("threshold %q must be in `<query> <operator> <value>` format", s)
+	}
+	v, err := strconv.ParseFloat(m[3], 64)
+	if err != nil {
+		return Threshold{}, err
+	}
+	return Threshold{Query: m[1], Operator: m[2], Value: v}, nil
+}
+
+// ParseThresholds parses the semicolon separated thresholds.
+func ParseThresholds(s string) ([]Threshold, error) {
+	var thresholds []Threshold
+	for _, part := range strings.Split(s, ";") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		t, err := ParseThreshold(part)
+		if err != nil {
+			return nil, err
+		}
+		thresholds = append(thresholds, t)
+	}
+	return thresholds, nil
+}
+
+// Evaluate runs the query of the threshold and returns true if every sample of the result satisfies the threshold.
+// An empty result doesn't satisfy any threshold. msg describes the breach, if any.
+func (c *Client) Evaluate(ctx context.Context, t Threshold) (ok bool, msg string, err error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	result, _, err := c.api.Query(ctx, t.Query, time.Now())
+	if err != nil {
+		return false, "", err
+	}
+
+	var values []float64
+	switch v := result.(type) {
+	case *model.Scalar:
+		values = append(values, float64(v.Value))
+	case model.Vector:
+		for _, sample := range v {
+			values = append(values, float64(sample.Value))
+		}
+	default:
+		return false, "", fmt.Errorf("query %q returned unsupported result type %s", t.Query, result.Type())
+	}
+	if len(values) == 0 {
+		return false, fmt.Sprintf("query %q returned no data", t.Query), nil
+	}
+	for _, v := range values {
+		if !compare(v, t.Operator, t.Value) {
+			return false, fmt.Sprintf("%s is breached by value %v", t, v), nil
+		}
+	}
+	return true, "", nil
+}
+
+func compare(v float64, op string, threshold float64) bool {
+	switch op {
+	case "<":
+		return v < threshold
+	case "<=":
+		return v <= threshold
+	case ">":
+		return v > threshold
+	case ">=":
+		return v >= threshold
+	case "==":
+		return v == threshold
+	case "!=":
+		return v != threshold
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recommender
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
+// CertificateGVK is the GroupVersionKind of the cert-manager Certificates.
+var CertificateGVK = schema.GroupVersionKind{
+	Group:   "cert-manager.io",
+	Version: "v1",
+	Kind:    "Certificate",
+}
+
+// CertificateExpiry returns the expiry time of the cert-manager Certificate from its `.status.notAfter` field.
+// ok is false if the Certificate is not issued yet.
+func CertificateExpiry(cert *unstructured.Unstructured) (expiry time.Time, ok bool) {
+	value, _, _ := unstructured.NestedString(cert.Object, "status", "notAfter")
+	if value == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// CertificateRecommendationName returns the deterministic name of the Recommendation rotating the certificates of the database
+// before the given expiry, so that the rotation is recommended only once per issued certificate.
+func CertificateRecommendationName(db metav1.Object, expiry time.Time) string {
+	return strings.ToLower(fmt.Sprintf("%s-rotate-certificates-%s", db.GetName(), expiry.UTC().Format("20060102150405")))
+}
+
+// NewCertificateRecommendation returns the Recommendation rotating the TLS certificates of the database
+// through a `ReconfigureTLS` ops request. The expiry of the certificate is used as the Deadline of the Recommendation.
+func NewCertificateRecommendation(db *unstructured.Unstructured, cert string, expiry time.Time) (*api.Recommendation, error) {
+	rcmd, err := newOpsRequestRecommendation(db, CertificateRecommendationName(db, expiry), map[string]interface{}{
+		"type": opsRequestTypeReconfigureTLS,
+		"tls": map[string]interface{}{
+			"rotateCertificates": true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	rcmd.Spec.Description = fmt.Sprintf("Certificate %s of %s %s expires at %s. Rotate the TLS certificates",
+		cert, db.GetKind(), db.GetName(), expiry.UTC().Format(time.RFC3339))
+	rcmd.Spec.Recommender = kmapi.ObjectReference{Name: KubeDBCertificateRecommenderName}
+	rcmd.Spec.Severity = api.SeverityHigh
+	rcmd.Spec.Deadline = &metav1.Time{Time: expiry}
+	return rcmd, nil
+}
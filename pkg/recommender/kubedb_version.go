@@ -0,0 +1,265 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recommender
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"github.com/Masterminds/semver/v3"
+	"gomodules.xyz/pointer"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kmapi "kmodules.xyz/client-go/api/v1"
+	catalogapi "kubedb.dev/apimachinery/apis/catalog/v1alpha1"
+	kubedbapi "kubedb.dev/apimachinery/apis/kubedb/v1alpha2"
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+)
+
+const (
+	// KubeDBVersionRecommenderName is the name of the recommender of the version upgrade Recommendations
+	// of the deprecated or EOL versions.
+	KubeDBVersionRecommenderName = "kubedb-version-recommender"
+	// KubeDBCVERecommenderName is the name of the recommender of the version upgrade Recommendations
+	// of the images having critical CVEs.
+	KubeDBCVERecommenderName = "kubedb-cve-recommender"
+	// KubeDBCertificateRecommenderName is the name of the recommender of the TLS certificate rotation Recommendations.
+	KubeDBCertificateRecommenderName = "kubedb-certificate-recommender"
+
+	opsRequestTypeUpdateVersion  = "UpdateVersion"
+	opsRequestTypeReconfigureTLS = "ReconfigureTLS"
+)
+
+// DatabaseGVK returns the GroupVersionKind of the KubeDB database of the given kind, e.g. `MongoDB`.
+func DatabaseGVK(kind string) schema.GroupVersionKind {
+	return kubedbapi.SchemeGroupVersion.WithKind(kind)
+}
+
+// CatalogVersionGVK returns the GroupVersionKind of the catalog versions of the given database kind, e.g. `MongoDBVersion`.
+func CatalogVersionGVK(kind string) schema.GroupVersionKind {
+	return catalogapi.SchemeGroupVersion.WithKind(kind + "Version")
+}
+
+// OpsRequestGVK returns the GroupVersionKind of the ops requests of the given database kind, e.g. `MongoDBOpsRequest`.
+func OpsRequestGVK(kind string) schema.GroupVersionKind {
+	return opsapi.SchemeGroupVersion.WithKind(kind + "OpsRequest")
+}
+
+// IsRetired returns true if the catalog version is deprecated or has passed its end-of-life date.
+// eol is true if the end-of-life date is passed.
+func IsRetired(version *unstructured.Unstructured, now time.Time) (retired bool, eol bool) {
+	if date, ok := endOfLife(version); ok && !now.Before(date) {
+		return true, true
+	}
+	deprecated, _, _ := unstructured.NestedBool(version.Object, "spec", "deprecated")
+	return deprecated, false
+}
+
+// TimeUntilEndOfLife returns the duration until the end-of-life date of the catalog version.
+// Zero is returned if the version has no end-of-life date or the date is passed.
+func TimeUntilEndOfLife(version *unstructured.Unstructured, now time.Time) time.Duration {
+	date, ok := endOfLife(version)
+	if !ok || !date.After(now) {
+		return 0
+	}
+	return date.Sub(now)
+}
+
+func endOfLife(version *unstructured.Unstructured) (time.Time, bool) {
+	value, ok := version.GetAnnotations()[api.EndOfLifeKey]
+	if !ok {
+		return time.Time{}, false
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// UpgradeTarget returns the name of the catalog version the database running the current catalog version should be upgraded to.
+// The target is the highest version of the same distribution and major version which is not retired
+// and is accepted by the update constraints of the current version. Empty string is returned if there is no such version.
+func UpgradeTarget(current *unstructured.Unstructured, versions []unstructured.Unstructured, now time.Time) (string, error) {
+	cv, err := semanticVersion(current)
+	if err != nil {
+		return "", err
+	}
+	allowlist, _, _ := unstructured.NestedStringSlice(current.Object, "spec", "updateConstraints", "allowlist")
+	denylist, _, _ := unstructured.NestedStringSlice(current.Object, "spec", "updateConstraints", "denylist")
+	distribution, _, _ := unstructured.NestedString(current.Object, "spec", "distribution")
+
+	type candidate struct {
+		name    string
+		version *semver.Version
+	}
+	var candidates []candidate
+	for i := range versions {
+		v := &versions[i]
+		if v.GetName() == current.GetName() {
+			continue
+		}
+		if retired, _ := IsRetired(v, now); retired {
+			continue
+		}
+		if d, _, _ := unstructured.NestedString(v.Object, "spec", "distribution"); d != distribution {
+			continue
+		}
+		sv, err := semanticVersion(v)
+		if err != nil || sv.Major() != cv.Major() || !sv.GreaterThan(cv) {
+			continue
+		}
+		accepted, err := isAccepted(sv, allowlist, denylist)
+		if err != nil {
+			return "", err
+		}
+		if accepted {
+			candidates = append(candidates, candidate{name: v.GetName(), version: sv})
+		}
+	}
+	if len(candidates) == 0 {
+		return "", nil
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].version.Equal(candidates[j].version) {
+			return candidates[i].name > candidates[j].name
+		}
+		return candidates[i].version.GreaterThan(candidates[j].version)
+	})
+	return candidates[0].name, nil
+}
+
+func semanticVersion(version *unstructured.Unstructured) (*semver.Version, error) {
+	value, _, _ := unstructured.NestedString(version.Object, "spec", "version")
+	sv, err := semver.NewVersion(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %q of %s %s: %w", value, version.GetKind(), version.GetName(), err)
+	}
+	return sv, nil
+}
+
+// isAccepted returns true if the version satisfies any of the allowlist constraints, if any, and none of the denylist constraints.
+func isAccepted(v *semver.Version, allowlist, denylist []string) (bool, error) {
+	for _, c := range denylist {
+		constraint, err := semver.NewConstraint(c)
+		if err != nil {
+			return false, err
+		}
+		if constraint.Check(v) {
+			return false, nil
+		}
+	}
+	if len(allowlist) == 0 {
+		return true, nil
+	}
+	for _, c := range allowlist {
+		constraint, err := semver.NewConstraint(c)
+		if err != nil {
+			return false, err
+		}
+		if constraint.Check(v) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// RecommendationName returns the deterministic name of the Recommendation upgrading the database to the target version,
+// so that the same upgrade is recommended only once.
+func RecommendationName(db metav1.Object, target string) string {
+	return strings.ToLower(fmt.Sprintf("%s-update-version-%s", db.GetName(), target))
+}
+
+// NewUpgradeRecommendation returns the Recommendation upgrading the database from the deprecated or EOL
+// current catalog version to the target catalog version.
+func NewUpgradeRecommendation(db *unstructured.Unstructured, current, target string, eol bool) (*api.Recommendation, error) {
+	rcmd, err := newUpdateVersionRecommendation(db, RecommendationName(db, target), target)
+	if err != nil {
+		return nil, err
+	}
+	status, severity := "deprecated", api.SeverityMedium
+	if eol {
+		status, severity = "end-of-life", api.SeverityHigh
+	}
+	rcmd.Spec.Description = fmt.Sprintf("%s %s is running the %s version %s. Update the version to %s", db.GetKind(), db.GetName(), status, current, target)
+	rcmd.Spec.Recommender = kmapi.ObjectReference{Name: KubeDBVersionRecommenderName}
+	rcmd.Spec.Severity = severity
+	return rcmd, nil
+}
+
+// newUpdateVersionRecommendation returns the Recommendation upgrading the database to the target catalog version
+// through an `UpdateVersion` ops request.
+func newUpdateVersionRecommendation(db *unstructured.Unstructured, name, target string) (*api.Recommendation, error) {
+	rcmd, err := newOpsRequestRecommendation(db, name, map[string]interface{}{
+		"type": opsRequestTypeUpdateVersion,
+		"updateVersion": map[string]interface{}{
+			"targetVersion": target,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	rcmd.Spec.RecommendedVersion = target
+	return rcmd, nil
+}
+
+// newOpsRequestRecommendation returns the Recommendation creating an ops request of the given spec for the database.
+func newOpsRequestRecommendation(db *unstructured.Unstructured, name string, spec map[string]interface{}) (*api.Recommendation, error) {
+	kind := db.GetKind()
+	ops := &unstructured.Unstructured{}
+	ops.SetGroupVersionKind(OpsRequestGVK(kind))
+	ops.SetNamespace(db.GetNamespace())
+	spec["databaseRef"] = map[string]interface{}{
+		"name": db.GetName(),
+	}
+	ops.Object["spec"] = spec
+	raw, err := json.Marshal(ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Recommendation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: db.GetNamespace(),
+		},
+		Spec: api.RecommendationSpec{
+			Target: core.TypedLocalObjectReference{
+				APIGroup: pointer.StringP(kubedbapi.SchemeGroupVersion.Group),
+				Kind:     kind,
+				Name:     db.GetName(),
+			},
+			Operation: runtime.RawExtension{
+				Raw: raw,
+			},
+			Rules: api.OperationPhaseRules{
+				Success:    `has(self.status) && has(self.status.phase) && self.status.phase == 'Successful'`,
+				InProgress: `has(self.status) && has(self.status.phase) && self.status.phase == 'Progressing'`,
+				Failed:     `has(self.status) && has(self.status.phase) && self.status.phase == 'Failed'`,
+			},
+		},
+	}, nil
+}
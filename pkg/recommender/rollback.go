@@ -0,0 +1,126 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recommender
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/target"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
+// RollbackRecommenderName is the name of the recommender of the Recommendations rolling back the failed upgrades.
+const RollbackRecommenderName = "rollback-recommender"
+
+// RollbackRecommendationName returns the deterministic name of the Recommendation rolling back the given Recommendation,
+// so that a failed upgrade is rolled back only once.
+func RollbackRecommendationName(rcmd *api.Recommendation) string {
+	return rcmd.Name + "-rollback"
+}
+
+// NewRollbackRecommendation returns the Critical Recommendation rolling the target of the failed Recommendation back
+// to its previous version. The operation of the failed Recommendation is reused with the recommended version replaced
+// by the previous version. The templated operations are resolved with the previous version as the recommended version.
+func NewRollbackRecommendation(rcmd *api.Recommendation) (*api.Recommendation, error) {
+	previousVersion := PreviousVersion(rcmd)
+	if rcmd.Spec.RecommendedVersion == "" || previousVersion == "" {
+		return nil, fmt.Errorf("version of Recommendation %s/%s before the execution is unknown", rcmd.Namespace, rcmd.Name)
+	}
+
+	operation := rcmd.Spec.Operation
+	if !bytes.Contains(operation.Raw, []byte("{{")) {
+		var obj map[string]interface{}
+		if err := json.Unmarshal(operation.Raw, &obj); err != nil {
+			return nil, err
+		}
+		if !replaceString(obj, rcmd.Spec.RecommendedVersion, previousVersion) {
+			return nil, fmt.Errorf("operation of Recommendation %s/%s doesn't refer to version %s", rcmd.Namespace, rcmd.Name, rcmd.Spec.RecommendedVersion)
+		}
+		raw, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		operation = runtime.RawExtension{Raw: raw}
+	}
+
+	spec := rcmd.Spec.DeepCopy()
+	spec.Operation = operation
+	spec.RecommendedVersion = previousVersion
+	spec.RollbackOf = &core.LocalObjectReference{Name: rcmd.Name}
+	spec.Recommender = kmapi.ObjectReference{Name: RollbackRecommenderName}
+	spec.Severity = api.SeverityCritical
+	spec.Description = fmt.Sprintf("Execution of Recommendation %s is failed and %s %s is degraded. Roll back the version from %s to %s",
+		rcmd.Name, rcmd.Spec.Target.Kind, rcmd.Spec.Target.Name, rcmd.Spec.RecommendedVersion, previousVersion)
+	spec.Impact = nil
+	spec.GroupRef = nil
+	spec.Distribution = nil
+
+	return &api.Recommendation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RollbackRecommendationName(rcmd),
+			Namespace: rcmd.Namespace,
+		},
+		Spec: *spec,
+	}, nil
+}
+
+// PreviousVersion returns the version of the target before the execution of the Recommendation.
+// The `spec.version` of the snapshot of the target is the source of truth, if the snapshot is taken.
+func PreviousVersion(rcmd *api.Recommendation) string {
+	if rcmd.Status.TargetSnapshot != nil {
+		if spec, err := target.SnapshotSpec(rcmd.Status.TargetSnapshot); err == nil {
+			if version, ok := spec["version"].(string); ok && version != "" {
+				return version
+			}
+		}
+	}
+	return rcmd.Status.PreviousVersion
+}
+
+// replaceString replaces every string value equal to old in the object with new.
+// It returns true if any value is replaced.
+func replaceString(obj interface{}, old, new string) bool {
+	var replaced bool
+	switch v := obj.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if s, ok := val.(string); ok && s == old {
+				v[key] = new
+				replaced = true
+			} else if replaceString(val, old, new) {
+				replaced = true
+			}
+		}
+	case []interface{}:
+		for i, val := range v {
+			if s, ok := val.(string); ok && s == old {
+				v[i] = new
+				replaced = true
+			} else if replaceString(val, old, new) {
+				replaced = true
+			}
+		}
+	}
+	return replaced
+}
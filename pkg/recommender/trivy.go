@@ -0,0 +1,105 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recommender
+
+import (
+	"fmt"
+	"strings"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
+const (
+	// labels set by the Trivy operator on the VulnerabilityReports referring to the scanned workload
+	TrivyResourceKindKey      = "trivy-operator.resource.kind"
+	TrivyResourceNameKey      = "trivy-operator.resource.name"
+	TrivyResourceNamespaceKey = "trivy-operator.resource.namespace"
+
+	SeverityCritical = "CRITICAL"
+)
+
+// VulnerabilityReportGVK is the GroupVersionKind of the VulnerabilityReports of the Trivy operator.
+var VulnerabilityReportGVK = schema.GroupVersionKind{
+	Group:   "aquasecurity.github.io",
+	Version: "v1alpha1",
+	Kind:    "VulnerabilityReport",
+}
+
+// CriticalVulnerabilities returns the critical vulnerabilities of the Trivy VulnerabilityReport.
+func CriticalVulnerabilities(report *unstructured.Unstructured) []api.Vulnerability {
+	items, _, _ := unstructured.NestedSlice(report.Object, "report", "vulnerabilities")
+	var vulnerabilities []api.Vulnerability
+	seen := map[string]bool{}
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		severity, _, _ := unstructured.NestedString(m, "severity")
+		id, _, _ := unstructured.NestedString(m, "vulnerabilityID")
+		if !strings.EqualFold(severity, SeverityCritical) || id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		link, _, _ := unstructured.NestedString(m, "primaryLink")
+		vulnerabilities = append(vulnerabilities, api.Vulnerability{
+			VulnerabilityID: id,
+			PrimaryURL:      link,
+			Severity:        SeverityCritical,
+		})
+	}
+	return vulnerabilities
+}
+
+// CVERecommendationName returns the deterministic name of the Recommendation upgrading the database to the target version
+// because of the critical CVEs of its image.
+func CVERecommendationName(db *unstructured.Unstructured, target string) string {
+	return strings.ToLower(fmt.Sprintf("%s-cve-update-version-%s", db.GetName(), target))
+}
+
+// NewCVERecommendation returns the Critical Recommendation upgrading the database from the current catalog version
+// to the target catalog version to fix the critical vulnerabilities of its image.
+// The Recommendation is labeled with the CVEDrivenKey to tell it apart from the other upgrade Recommendations.
+func NewCVERecommendation(db *unstructured.Unstructured, current, target string, vulnerabilities []api.Vulnerability) (*api.Recommendation, error) {
+	rcmd, err := newUpdateVersionRecommendation(db, CVERecommendationName(db, target), target)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(vulnerabilities))
+	for _, v := range vulnerabilities {
+		ids = append(ids, v.VulnerabilityID)
+	}
+	rcmd.Labels = map[string]string{
+		api.CVEDrivenKey: "true",
+	}
+	rcmd.Spec.Description = fmt.Sprintf("The image of %s %s running version %s has critical vulnerabilities %s. Update the version to %s",
+		db.GetKind(), db.GetName(), current, strings.Join(ids, ", "), target)
+	rcmd.Spec.Recommender = kmapi.ObjectReference{Name: KubeDBCVERecommenderName}
+	rcmd.Spec.Severity = api.SeverityCritical
+	rcmd.Spec.VulnerabilityReport = &api.VulnerabilityReport{
+		Status: api.ReportGenerationStatusSuccess,
+		Fixed: &api.CVEReport{
+			Count:           map[string]int{SeverityCritical: len(vulnerabilities)},
+			Vulnerabilities: vulnerabilities,
+		},
+	}
+	return rcmd, nil
+}
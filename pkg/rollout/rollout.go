@@ -0,0 +1,126 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"sort"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"gomodules.xyz/pointer"
+	cutil "kmodules.xyz/client-go/conditions"
+)
+
+// Unit is a member of a staggered rollout, e.g. a member Recommendation of a RecommendationGroup
+// or a managed cluster of a distributed Recommendation.
+type Unit struct {
+	Name string
+	// Started is true if the execution of the Unit is started.
+	Started bool
+	// Phase holds the phase of the Recommendation of the Unit.
+	Phase api.RecommendationPhase
+	// CompletionTime specifies when the Unit is completed.
+	CompletionTime time.Time
+}
+
+// MemberUnit returns the Unit of the given member Recommendation of a RecommendationGroup.
+// The member failed in an attempt is still retried, so it is in flight until it is terminally failed.
+func MemberUnit(rc *api.Recommendation) Unit {
+	u := Unit{
+		Name:    rc.Name,
+		Started: rc.Status.Phase == api.InProgress,
+		Phase:   rc.Status.Phase,
+	}
+	if rc.Status.Phase == api.Failed && !rc.IsTerminallyFailed() {
+		u.Started = true
+		u.Phase = api.InProgress
+	}
+	if _, cond := cutil.GetCondition(rc.Status.Conditions, api.SuccessfullyExecutedOperation); cond != nil {
+		u.CompletionTime = cond.LastTransitionTime.Time
+	}
+	return u
+}
+
+// IsCompleted returns true if the phase is a final phase of a Recommendation.
+func IsCompleted(phase api.RecommendationPhase) bool {
+	switch phase {
+	case api.Succeeded, api.Failed, api.Stalled, api.Skipped:
+		return true
+	default:
+		return false
+	}
+}
+
+// Allowed returns the names of the Units which are allowed to be executed by the RolloutStrategy.
+// The Units are sorted by name and split into waves. The Units of a wave are allowed once every Unit
+// of the previous waves is completed and the soak time of the previous wave is passed.
+// halted is true if the rollout is stopped due to a failed Unit. wait holds the remaining soak time, if any.
+func Allowed(units []Unit, strategy *api.RolloutStrategy, now time.Time) (allowed map[string]bool, halted bool, wait time.Duration) {
+	sorted := make([]Unit, len(units))
+	copy(sorted, units)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	size := int(strategy.WaveSize)
+	if size < 1 {
+		size = 1
+	}
+	allowed = map[string]bool{}
+	for start := 0; start < len(sorted); start += size {
+		wave := sorted[start:min(start+size, len(sorted))]
+
+		var inFlight int
+		for _, u := range wave {
+			if u.Started && !IsCompleted(u.Phase) {
+				inFlight++
+			}
+		}
+		completed, failed := true, false
+		var lastCompletion time.Time
+		for _, u := range wave {
+			switch {
+			case u.Started || IsCompleted(u.Phase):
+				allowed[u.Name] = true
+			case strategy.MaxUnavailable == nil || inFlight < int(pointer.Int32(strategy.MaxUnavailable)):
+				allowed[u.Name] = true
+				inFlight++
+			}
+			if !IsCompleted(u.Phase) {
+				completed = false
+			}
+			if u.Phase == api.Failed || u.Phase == api.Stalled {
+				failed = true
+			}
+			if u.CompletionTime.After(lastCompletion) {
+				lastCompletion = u.CompletionTime
+			}
+		}
+
+		if !completed {
+			return allowed, false, 0
+		}
+		if failed && strategy.HaltOnFailure {
+			return allowed, true, 0
+		}
+		if remaining := lastCompletion.Add(strategy.SoakTime.Duration).Sub(now); remaining > 0 {
+			return allowed, false, remaining
+		}
+	}
+	return allowed, false, 0
+}
@@ -0,0 +1,211 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"gomodules.xyz/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
+var now = time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+func pending(name string) Unit {
+	return Unit{Name: name}
+}
+
+func running(name string) Unit {
+	return Unit{Name: name, Started: true, Phase: api.InProgress}
+}
+
+func completed(name string, phase api.RecommendationPhase, ago time.Duration) Unit {
+	return Unit{Name: name, Phase: phase, CompletionTime: now.Add(-ago)}
+}
+
+func member(name string, phase api.RecommendationPhase, reason string) *api.Recommendation {
+	return &api.Recommendation{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: api.RecommendationStatus{
+			Phase:  phase,
+			Reason: reason,
+		},
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	cases := []struct {
+		name        string
+		units       []Unit
+		strategy    api.RolloutStrategy
+		wantAllowed []string
+		wantHalted  bool
+		wantWait    time.Duration
+	}{
+		{
+			name:        "only the first wave is allowed",
+			units:       []Unit{pending("d"), pending("c"), pending("b"), pending("a")},
+			strategy:    api.RolloutStrategy{WaveSize: 2},
+			wantAllowed: []string{"a", "b"},
+		},
+		{
+			name:        "next wave is allowed once the previous wave is completed",
+			units:       []Unit{completed("a", api.Succeeded, time.Hour), completed("b", api.Skipped, time.Hour), pending("c"), pending("d")},
+			strategy:    api.RolloutStrategy{WaveSize: 2},
+			wantAllowed: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:        "next wave waits while the previous wave is running",
+			units:       []Unit{completed("a", api.Succeeded, time.Hour), running("b"), pending("c")},
+			strategy:    api.RolloutStrategy{WaveSize: 2},
+			wantAllowed: []string{"a", "b"},
+		},
+		{
+			name:        "zero wave size is treated as one",
+			units:       []Unit{pending("a"), pending("b")},
+			strategy:    api.RolloutStrategy{},
+			wantAllowed: []string{"a"},
+		},
+		{
+			name:        "next wave waits for the soak time of the previous wave",
+			units:       []Unit{completed("a", api.Succeeded, 40*time.Minute), completed("b", api.Succeeded, 10*time.Minute), pending("c")},
+			strategy:    api.RolloutStrategy{WaveSize: 2, SoakTime: metav1.Duration{Duration: 30 * time.Minute}},
+			wantAllowed: []string{"a", "b"},
+			wantWait:    20 * time.Minute,
+		},
+		{
+			name:        "next wave is allowed once the soak time is passed",
+			units:       []Unit{completed("a", api.Succeeded, 40*time.Minute), completed("b", api.Succeeded, 35*time.Minute), pending("c")},
+			strategy:    api.RolloutStrategy{WaveSize: 2, SoakTime: metav1.Duration{Duration: 30 * time.Minute}},
+			wantAllowed: []string{"a", "b", "c"},
+		},
+		{
+			name:        "maxUnavailable limits the members of a wave executing at a time",
+			units:       []Unit{pending("a"), pending("b"), pending("c")},
+			strategy:    api.RolloutStrategy{WaveSize: 3, MaxUnavailable: pointer.Int32P(2)},
+			wantAllowed: []string{"a", "b"},
+		},
+		{
+			name:        "running member is counted against maxUnavailable",
+			units:       []Unit{pending("a"), running("b"), pending("c")},
+			strategy:    api.RolloutStrategy{WaveSize: 3, MaxUnavailable: pointer.Int32P(1)},
+			wantAllowed: []string{"b"},
+		},
+		{
+			name:        "completed member is not counted against maxUnavailable",
+			units:       []Unit{completed("a", api.Succeeded, time.Minute), pending("b"), pending("c")},
+			strategy:    api.RolloutStrategy{WaveSize: 3, MaxUnavailable: pointer.Int32P(1)},
+			wantAllowed: []string{"a", "b"},
+		},
+		{
+			name:        "retried failed member is counted against maxUnavailable",
+			units:       []Unit{MemberUnit(member("a", api.Failed, api.OperationFailed)), pending("b"), pending("c")},
+			strategy:    api.RolloutStrategy{WaveSize: 3, MaxUnavailable: pointer.Int32P(1)},
+			wantAllowed: []string{"a"},
+		},
+		{
+			name:        "retried failed member holds the next wave",
+			units:       []Unit{MemberUnit(member("a", api.Failed, api.OperationFailed)), pending("b")},
+			strategy:    api.RolloutStrategy{WaveSize: 1, HaltOnFailure: true},
+			wantAllowed: []string{"a"},
+		},
+		{
+			name:        "terminally failed member halts the rollout",
+			units:       []Unit{MemberUnit(member("a", api.Failed, api.BackoffLimitExceeded)), completed("b", api.Succeeded, time.Hour), pending("c")},
+			strategy:    api.RolloutStrategy{WaveSize: 2, HaltOnFailure: true},
+			wantAllowed: []string{"a", "b"},
+			wantHalted:  true,
+		},
+		{
+			name:        "terminally failed member doesn't halt the rollout without HaltOnFailure",
+			units:       []Unit{MemberUnit(member("a", api.Stalled, "")), completed("b", api.Succeeded, time.Hour), pending("c")},
+			strategy:    api.RolloutStrategy{WaveSize: 2},
+			wantAllowed: []string{"a", "b", "c"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			allowed, halted, wait := Allowed(tc.units, &tc.strategy, now)
+			want := map[string]bool{}
+			for _, name := range tc.wantAllowed {
+				want[name] = true
+			}
+			if !reflect.DeepEqual(allowed, want) {
+				t.Errorf("allowed = %v, want %v", allowed, want)
+			}
+			if halted != tc.wantHalted {
+				t.Errorf("halted = %v, want %v", halted, tc.wantHalted)
+			}
+			if wait != tc.wantWait {
+				t.Errorf("wait = %v, want %v", wait, tc.wantWait)
+			}
+		})
+	}
+}
+
+func TestMemberUnit(t *testing.T) {
+	succeeded := member("a", api.Succeeded, api.SuccessfullyExecutedOperation)
+	succeeded.Status.Conditions = []kmapi.Condition{{
+		Type:               api.SuccessfullyExecutedOperation,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(now),
+	}}
+
+	cases := []struct {
+		name string
+		rcmd *api.Recommendation
+		want Unit
+	}{
+		{
+			name: "waiting",
+			rcmd: member("a", api.Waiting, api.WaitingForRolloutWave),
+			want: Unit{Name: "a", Phase: api.Waiting},
+		},
+		{
+			name: "in progress",
+			rcmd: member("a", api.InProgress, api.StartedExecutingOperation),
+			want: Unit{Name: "a", Started: true, Phase: api.InProgress},
+		},
+		{
+			name: "failed and retried",
+			rcmd: member("a", api.Failed, api.OperationFailed),
+			want: Unit{Name: "a", Started: true, Phase: api.InProgress},
+		},
+		{
+			name: "failed terminally",
+			rcmd: member("a", api.Failed, api.BackoffLimitExceeded),
+			want: Unit{Name: "a", Phase: api.Failed},
+		},
+		{
+			name: "succeeded",
+			rcmd: succeeded,
+			want: Unit{Name: "a", Phase: api.Succeeded, CompletionTime: now},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := MemberUnit(tc.rcmd); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("MemberUnit() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
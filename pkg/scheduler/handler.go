@@ -0,0 +1,97 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/sharding"
+
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DebugPath is the path of the debug endpoint of the Scheduler on the metrics server.
+const DebugPath = "/debug/schedule"
+
+// Entry is a Recommendation in the computed ordering of the Scheduler.
+type Entry struct {
+	Position      int          `json:"position"`
+	Namespace     string       `json:"namespace"`
+	Name          string       `json:"name"`
+	PriorityClass api.Severity `json:"priorityClass"`
+	// Dispatchable is true if the Recommendation fits in the free capacity of the cluster.
+	Dispatchable bool `json:"dispatchable"`
+}
+
+// Schedule is the response of the debug endpoint.
+type Schedule struct {
+	Capacity   int     `json:"capacity"`
+	InProgress int     `json:"inProgress"`
+	Order      []Entry `json:"order"`
+}
+
+// Handler returns the debug endpoint serving the current ordering of the Recommendations waiting for
+// the cluster execution limit of the given capacity. If the reconciliation is sharded, only the Recommendations of
+// the namespaces owned by the given Shard are ordered, the same as the cluster execution limit enforced by the shard.
+func (s *Scheduler) Handler(kc client.Client, capacity int, shard sharding.Shard) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		inProgressList := &api.RecommendationList{}
+		if err := kc.List(req.Context(), inProgressList, client.MatchingFields{api.RecommendationPhaseIndex: string(api.InProgress)}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		inProgress := make([]api.Recommendation, 0, len(inProgressList.Items))
+		for _, rc := range inProgressList.Items {
+			if shard.OwnsNamespace(rc.Namespace) {
+				inProgress = append(inProgress, rc)
+			}
+		}
+		waitingList := &api.RecommendationList{}
+		if err := kc.List(req.Context(), waitingList, client.MatchingFields{api.RecommendationPhaseIndex: string(api.Waiting)}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		waiting := make([]api.Recommendation, 0, len(waitingList.Items))
+		for _, rc := range waitingList.Items {
+			if shard.OwnsNamespace(rc.Namespace) && IsWaitingForExecutionLimit(&rc) {
+				waiting = append(waiting, rc)
+			}
+		}
+
+		resp := Schedule{
+			Capacity:   capacity,
+			InProgress: len(inProgress),
+			Order:      make([]Entry, 0, len(waiting)),
+		}
+		for i, rc := range s.Order(inProgress, waiting) {
+			resp.Order = append(resp.Order, Entry{
+				Position:      i + 1,
+				Namespace:     rc.Namespace,
+				Name:          rc.Name,
+				PriorityClass: PriorityClass(&rc),
+				Dispatchable:  i < capacity-len(inProgress),
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			klog.Errorf("failed to write the schedule: %v", err)
+		}
+	})
+}
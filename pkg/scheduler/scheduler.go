@@ -0,0 +1,174 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+)
+
+// Scheduler orders the Recommendations waiting for the cluster execution limit by weighted fair queuing
+// of their priority classes, i.e. their severities. Each priority class is allocated a share of the capacity
+// proportional to its weight, after every class is guaranteed its minimum share. Within a priority class,
+// the namespaces are served round-robin and the Recommendations of a namespace in the order of their creation.
+type Scheduler struct {
+	weights   map[api.Severity]int
+	minShares map[api.Severity]int
+}
+
+// New returns the Scheduler with the given weights and minimum shares of the priority classes.
+// The priority classes without a weight are weighted one(1). Nil is returned if no weight is given,
+// which means the weighted scheduling is disabled.
+func New(weights, minShares map[api.Severity]int) *Scheduler {
+	if len(weights) == 0 {
+		return nil
+	}
+	return &Scheduler{
+		weights:   weights,
+		minShares: minShares,
+	}
+}
+
+// Enabled returns true if the weighted scheduling is enabled.
+func (s *Scheduler) Enabled() bool {
+	return s != nil
+}
+
+// Order returns the waiting Recommendations in the order of their dispatch, given the InProgress ones.
+// The InProgress Recommendations are counted as already served from their priority class and namespace.
+func (s *Scheduler) Order(inProgress, waiting []api.Recommendation) []api.Recommendation {
+	served := map[api.Severity]int{}
+	nsServed := map[api.Severity]map[string]int{}
+	serve := func(rc *api.Recommendation) {
+		class := PriorityClass(rc)
+		served[class]++
+		if nsServed[class] == nil {
+			nsServed[class] = map[string]int{}
+		}
+		nsServed[class][rc.Namespace]++
+	}
+	for i := range inProgress {
+		serve(&inProgress[i])
+	}
+
+	queues := map[api.Severity][]api.Recommendation{}
+	for _, rc := range waiting {
+		class := PriorityClass(&rc)
+		queues[class] = append(queues[class], rc)
+	}
+	for class := range queues {
+		q := queues[class]
+		sort.SliceStable(q, func(i, j int) bool {
+			return q[i].CreationTimestamp.Before(&q[j].CreationTimestamp)
+		})
+	}
+
+	order := make([]api.Recommendation, 0, len(waiting))
+	for len(order) < len(waiting) {
+		class := s.nextClass(queues, served)
+		q := queues[class]
+		next := 0
+		for i := range q {
+			if nsServed[class][q[i].Namespace] < nsServed[class][q[next].Namespace] {
+				next = i
+			}
+		}
+		rc := q[next]
+		queues[class] = append(q[:next], q[next+1:]...)
+		order = append(order, rc)
+		serve(&rc)
+	}
+	return order
+}
+
+// nextClass returns the priority class to serve next among the classes having waiting Recommendations.
+// The classes served less than their minimum share are preferred, then the one with the lowest served to weight ratio.
+// The ties are broken by the severity level.
+func (s *Scheduler) nextClass(queues map[api.Severity][]api.Recommendation, served map[api.Severity]int) api.Severity {
+	var best api.Severity
+	var bestBelowMin bool
+	var bestRatio float64
+	for class, q := range queues {
+		if len(q) == 0 {
+			continue
+		}
+		belowMin := served[class] < s.minShares[class]
+		ratio := float64(served[class]+1) / float64(s.weight(class))
+		switch {
+		case best == "":
+		case belowMin != bestBelowMin:
+			if !belowMin {
+				continue
+			}
+		case ratio > bestRatio:
+			continue
+		case ratio == bestRatio && class.Level() < best.Level():
+			continue
+		}
+		best, bestBelowMin, bestRatio = class, belowMin, ratio
+	}
+	return best
+}
+
+func (s *Scheduler) weight(class api.Severity) int {
+	if w := s.weights[class]; w > 0 {
+		return w
+	}
+	return 1
+}
+
+// PriorityClass returns the priority class of the Recommendation. Empty Severity is treated as Medium.
+func PriorityClass(rc *api.Recommendation) api.Severity {
+	if rc.Spec.Severity == "" {
+		return api.SeverityMedium
+	}
+	return rc.Spec.Severity
+}
+
+// IsWaitingForExecutionLimit returns true if the Recommendation is Approved and waiting for the execution limit.
+func IsWaitingForExecutionLimit(rc *api.Recommendation) bool {
+	return rc.Status.ApprovalStatus == api.ApprovalApproved && rc.Status.Phase == api.Waiting && rc.Status.Reason == api.WaitingForExecutionLimit
+}
+
+// ParseShares parses the comma separated `<priority class>=<value>` pairs, e.g. `Critical=8,High=4`.
+func ParseShares(s string) (map[api.Severity]int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	shares := map[api.Severity]int{}
+	for _, pair := range strings.Split(s, ",") {
+		class, value, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if !found {
+			return nil, fmt.Errorf("invalid share %q, expected <priority class>=<value>", pair)
+		}
+		switch sev := api.Severity(class); sev {
+		case api.SeverityCritical, api.SeverityHigh, api.SeverityMedium, api.SeverityLow:
+		default:
+			return nil, fmt.Errorf("unknown priority class %q, expected one of Critical, High, Medium or Low", class)
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid share %q of priority class %s", value, class)
+		}
+		shares[api.Severity(class)] = n
+	}
+	return shares, nil
+}
@@ -0,0 +1,207 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var created = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// newRecommendation returns a Recommendation of the given severity created the given minutes after `created`.
+func newRecommendation(namespace, name string, severity api.Severity, minutes int) api.Recommendation {
+	return api.Recommendation{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(created.Add(time.Duration(minutes) * time.Minute)),
+		},
+		Spec: api.RecommendationSpec{
+			Severity: severity,
+		},
+	}
+}
+
+func TestOrder(t *testing.T) {
+	cases := []struct {
+		name       string
+		weights    map[api.Severity]int
+		minShares  map[api.Severity]int
+		inProgress []api.Recommendation
+		waiting    []api.Recommendation
+		want       []string
+	}{
+		{
+			name:    "equal ratio is broken by the severity level",
+			weights: map[api.Severity]int{api.SeverityCritical: 1, api.SeverityLow: 1},
+			waiting: []api.Recommendation{
+				newRecommendation("demo", "low", api.SeverityLow, 0),
+				newRecommendation("demo", "critical", api.SeverityCritical, 1),
+			},
+			want: []string{"demo/critical", "demo/low"},
+		},
+		{
+			name:    "classes are served proportional to their weights",
+			weights: map[api.Severity]int{api.SeverityHigh: 2, api.SeverityLow: 1},
+			waiting: []api.Recommendation{
+				newRecommendation("demo", "high-1", api.SeverityHigh, 0),
+				newRecommendation("demo", "high-2", api.SeverityHigh, 1),
+				newRecommendation("demo", "high-3", api.SeverityHigh, 2),
+				newRecommendation("demo", "low-1", api.SeverityLow, 3),
+				newRecommendation("demo", "low-2", api.SeverityLow, 4),
+			},
+			want: []string{"demo/high-1", "demo/high-2", "demo/low-1", "demo/high-3", "demo/low-2"},
+		},
+		{
+			name:      "class below its minimum share is served first",
+			weights:   map[api.Severity]int{api.SeverityCritical: 4, api.SeverityLow: 1},
+			minShares: map[api.Severity]int{api.SeverityLow: 1},
+			waiting: []api.Recommendation{
+				newRecommendation("demo", "critical-1", api.SeverityCritical, 0),
+				newRecommendation("demo", "critical-2", api.SeverityCritical, 1),
+				newRecommendation("demo", "low", api.SeverityLow, 2),
+			},
+			want: []string{"demo/low", "demo/critical-1", "demo/critical-2"},
+		},
+		{
+			name:      "minimum share met by the InProgress Recommendations switches to the ratio",
+			weights:   map[api.Severity]int{api.SeverityCritical: 4, api.SeverityLow: 1},
+			minShares: map[api.Severity]int{api.SeverityLow: 1},
+			inProgress: []api.Recommendation{
+				newRecommendation("demo", "running", api.SeverityLow, 0),
+			},
+			waiting: []api.Recommendation{
+				newRecommendation("demo", "critical-1", api.SeverityCritical, 1),
+				newRecommendation("demo", "critical-2", api.SeverityCritical, 2),
+				newRecommendation("demo", "low", api.SeverityLow, 3),
+			},
+			want: []string{"demo/critical-1", "demo/critical-2", "demo/low"},
+		},
+		{
+			name:    "namespaces are served round-robin within a class",
+			weights: map[api.Severity]int{api.SeverityMedium: 1},
+			waiting: []api.Recommendation{
+				newRecommendation("a", "first", api.SeverityMedium, 0),
+				newRecommendation("a", "second", api.SeverityMedium, 1),
+				newRecommendation("a", "third", api.SeverityMedium, 2),
+				newRecommendation("b", "first", api.SeverityMedium, 3),
+				newRecommendation("b", "second", api.SeverityMedium, 4),
+			},
+			want: []string{"a/first", "b/first", "a/second", "b/second", "a/third"},
+		},
+		{
+			name:    "namespace with InProgress Recommendations of the class is served later",
+			weights: map[api.Severity]int{api.SeverityMedium: 1},
+			inProgress: []api.Recommendation{
+				newRecommendation("a", "running", api.SeverityMedium, 0),
+			},
+			waiting: []api.Recommendation{
+				newRecommendation("a", "first", api.SeverityMedium, 1),
+				newRecommendation("b", "first", api.SeverityMedium, 2),
+			},
+			want: []string{"b/first", "a/first"},
+		},
+		{
+			name:    "empty severity is ordered as Medium",
+			weights: map[api.Severity]int{api.SeverityMedium: 1, api.SeverityHigh: 1},
+			waiting: []api.Recommendation{
+				newRecommendation("demo", "unset", "", 0),
+				newRecommendation("demo", "medium", api.SeverityMedium, 1),
+				newRecommendation("demo", "high", api.SeverityHigh, 2),
+			},
+			want: []string{"demo/high", "demo/unset", "demo/medium"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := make([]string, 0, len(tc.waiting))
+			for _, rc := range New(tc.weights, tc.minShares).Order(tc.inProgress, tc.waiting) {
+				got = append(got, rc.Namespace+"/"+rc.Name)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Order() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseShares(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      string
+		want    map[api.Severity]int
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			in:   "",
+			want: nil,
+		},
+		{
+			name: "valid",
+			in:   "Critical=8, High=4,Low=0",
+			want: map[api.Severity]int{api.SeverityCritical: 8, api.SeverityHigh: 4, api.SeverityLow: 0},
+		},
+		{
+			name:    "missing value",
+			in:      "Critical",
+			wantErr: true,
+		},
+		{
+			name:    "unknown priority class",
+			in:      "Urgent=1",
+			wantErr: true,
+		},
+		{
+			name:    "lowercase priority class",
+			in:      "critical=1",
+			wantErr: true,
+		},
+		{
+			name:    "non-numeric value",
+			in:      "High=x",
+			wantErr: true,
+		},
+		{
+			name:    "negative value",
+			in:      "High=-1",
+			wantErr: true,
+		},
+		{
+			name:    "trailing comma",
+			in:      "High=1,",
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseShares(tc.in)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ParseShares(%q) error = %v, wantErr %v", tc.in, err, tc.wantErr)
+			}
+			if !tc.wantErr && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ParseShares(%q) = %v, want %v", tc.in, got, tc.want)
+			}
+		})
+	}
+}
@@ -17,20 +17,42 @@ limitations under the License.
 package server
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 	"sync"
 
 	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	apiv1beta1 "kubeops.dev/supervisor/apis/supervisor/v1beta1"
+	"kubeops.dev/supervisor/pkg/audit"
 	"kubeops.dev/supervisor/pkg/controllers"
 	supervisorcontrollers "kubeops.dev/supervisor/pkg/controllers/supervisor"
-
+	"kubeops.dev/supervisor/pkg/dashboard"
+	"kubeops.dev/supervisor/pkg/digest"
+	"kubeops.dev/supervisor/pkg/executor"
+	"kubeops.dev/supervisor/pkg/features"
+	"kubeops.dev/supervisor/pkg/gitops"
+	"kubeops.dev/supervisor/pkg/maintenance"
+	"kubeops.dev/supervisor/pkg/metrics"
+	"kubeops.dev/supervisor/pkg/notifier"
+	"kubeops.dev/supervisor/pkg/prometheus"
+	"kubeops.dev/supervisor/pkg/scheduler"
+	"kubeops.dev/supervisor/pkg/shared"
+	"kubeops.dev/supervisor/pkg/slack"
+	"kubeops.dev/supervisor/pkg/testclock"
+	"kubeops.dev/supervisor/pkg/tracing"
+
+	"gomodules.xyz/pointer"
 	admissionv1 "k8s.io/api/admission/v1"
 	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	core "k8s.io/api/core/v1"
+	crdv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	crd_cs "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -38,6 +60,7 @@ import (
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/apiserver/pkg/registry/rest"
 	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
 	"k8s.io/klog/v2/klogr"
@@ -50,8 +73,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
 )
 
+// ConversionWebhookPath is the path of the conversion webhook of the CRDs having multiple versions.
+const ConversionWebhookPath = "/convert"
+
 var (
 	// Scheme defines methods for serializing and deserializing API objects.
 	Scheme = runtime.NewScheme()
@@ -62,6 +89,7 @@ var (
 
 func init() {
 	utilruntime.Must(api.AddToScheme(Scheme))
+	utilruntime.Must(apiv1beta1.AddToScheme(Scheme))
 	utilruntime.Must(clientgoscheme.AddToScheme(Scheme))
 	utilruntime.Must(admissionv1.AddToScheme(Scheme))
 	utilruntime.Must(admissionv1beta1.AddToScheme(Scheme))
@@ -136,63 +164,132 @@ func (c completedConfig) New() (*SupervisorOperator, error) {
 		setupLog.Error(err, "failed to create crd client")
 		os.Exit(1)
 	}
-	err = controllers.EnsureCustomResourceDefinitions(crdClient)
+	crdConv, err := crdConversion(c.ExtraConfig.ConversionWebhookService, c.ExtraConfig.ConversionWebhookCAFile)
+	if err != nil {
+		setupLog.Error(err, "failed to configure conversion webhook")
+		os.Exit(1)
+	}
+	err = controllers.EnsureCustomResourceDefinitions(crdClient, crdConv)
 	if err != nil {
 		setupLog.Error(err, "failed to register crds")
 		os.Exit(1)
 	}
 
+	// The ordering of the Scheduler is served on the metrics server, as it is a debug endpoint.
+	// The extra handlers are registered by the metrics server when it is started, so the handler
+	// needing the manager client is added to the map after creating the manager.
+	sched := scheduler.New(c.ExtraConfig.PriorityWeights, c.ExtraConfig.PriorityMinShares)
+	extraHandlers := map[string]http.Handler{}
+
+	// The test clock must be shared before any controller is set up, as they capture the shared clock.
+	if c.ExtraConfig.EnableTestClock {
+		tc := testclock.New()
+		api.SetClock(tc)
+		extraHandlers[testclock.DebugPath] = tc.Handler()
+	}
+
 	mgr, err := manager.New(cfg, manager.Options{
 		Scheme:                 Scheme,
-		Metrics:                metricsserver.Options{BindAddress: ""},
+		Metrics:                metricsserver.Options{BindAddress: c.ExtraConfig.MetricsBindAddress, ExtraHandlers: extraHandlers},
 		HealthProbeBindAddress: "0",
 		LeaderElection:         false,
 		LeaderElectionID:       "3a57c480.supervisor.appscode.com",
 		Cache: cache.Options{
-			SyncPeriod: &c.ExtraConfig.ResyncPeriod,
+			SyncPeriod:        &c.ExtraConfig.ResyncPeriod,
+			DefaultNamespaces: cacheNamespaces(c.ExtraConfig.WatchNamespaces, c.ExtraConfig.ExcludeNamespaces),
+			ByObject:          cacheByObject(c.ExtraConfig.WatchNamespaces, c.ExtraConfig.ExcludeNamespaces),
 		},
-		NewClient: cu.NewClient,
+		NewClient:               cu.NewClient,
+		GracefulShutdownTimeout: &c.ExtraConfig.GracefulShutdownTimeout,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
+	if sched.Enabled() {
+		extraHandlers[scheduler.DebugPath] = sched.Handler(mgr.GetClient(), c.ExtraConfig.MaxClusterParallelOps, c.ExtraConfig.Shard)
+	}
 	api.SetupWebhookClient(mgr.GetClient())
+	api.SetDefaultTimezone(c.ExtraConfig.DefaultTimezone)
 
-	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &api.MaintenanceWindow{}, api.DefaultMaintenanceWindowKey, func(rawObj client.Object) []string {
-		app := rawObj.(*api.MaintenanceWindow)
-		if v, ok := app.Annotations[api.DefaultMaintenanceWindowKey]; ok && v == "true" {
-			return []string{"true"}
-		}
-		return nil
-	}); err != nil {
-		klog.Error(err, "unable to set up MaintenanceWindow Indexer", "field", api.DefaultMaintenanceWindowKey)
+	shutdownTracing, err := tracing.Setup(context.Background(), c.ExtraConfig.TracingEndpoint, c.ExtraConfig.TracingInsecure)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
 		os.Exit(1)
 	}
+	genericServer.AddPreShutdownHookOrDie("shutdown-tracing", func() error {
+		return shutdownTracing(context.Background())
+	})
 
-	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &api.ClusterMaintenanceWindow{}, api.DefaultClusterMaintenanceWindowKey, func(rawObj client.Object) []string {
-		app := rawObj.(*api.ClusterMaintenanceWindow)
-		if v, ok := app.Annotations[api.DefaultClusterMaintenanceWindowKey]; ok && v == "true" {
-			return []string{"true"}
-		}
-		return nil
-	}); err != nil {
-		klog.Error(err, "unable to set up ClusterMaintenanceWindow Indexer", "field", api.DefaultClusterMaintenanceWindowKey)
+	if err := metrics.RegisterStateCollector(mgr.GetClient(), api.GetClock()); err != nil {
+		setupLog.Error(err, "unable to register metrics collector")
+		os.Exit(1)
+	}
+
+	if err := maintenance.IndexDefaultMaintenanceWindows(context.Background(), mgr.GetFieldIndexer()); err != nil {
+		klog.Error(err, "unable to set up default MaintenanceWindow Indexers")
+		os.Exit(1)
+	}
+	if err := shared.IndexRecommendations(context.Background(), mgr.GetFieldIndexer()); err != nil {
+		klog.Error(err, "unable to set up Recommendation Indexers")
 		os.Exit(1)
 	}
+	if err := executor.IndexPods(context.Background(), mgr.GetFieldIndexer()); err != nil {
+		klog.Error(err, "unable to set up Pod Indexers")
+		os.Exit(1)
+	}
+
+	var prom *prometheus.Client
+	if c.ExtraConfig.PrometheusURL != "" {
+		if prom, err = prometheus.NewClient(c.ExtraConfig.PrometheusURL); err != nil {
+			setupLog.Error(err, "unable to create Prometheus client")
+			os.Exit(1)
+		}
+	}
+
+	var notifications *notifier.Queue
+	if features.Enabled(features.Notifications) {
+		notifications = notifier.NewQueue(mgr.GetClient(), 4, 256)
+		if err = mgr.Add(notifications); err != nil {
+			setupLog.Error(err, "unable to set up notification queue")
+			os.Exit(1)
+		}
+	}
 
 	recommendationControllerOpts := controller.Options{
 		MaxConcurrentReconciles: c.ExtraConfig.MaxConcurrentReconcile,
+		RateLimiter:             c.ExtraConfig.NewRateLimiter(),
 	}
 	if err = (&supervisorcontrollers.RecommendationReconciler{
-		Client:                 mgr.GetClient(),
-		Scheme:                 mgr.GetScheme(),
-		Mutex:                  &sync.Mutex{},
-		RequeueAfterDuration:   c.ExtraConfig.RequeueAfterDuration,
-		RetryAfterDuration:     c.ExtraConfig.RetryAfterDuration,
-		BeforeDeadlineDuration: c.ExtraConfig.BeforeDeadlineDuration,
-		Clock:                  api.GetClock(),
+		Client:                         mgr.GetClient(),
+		Scheme:                         mgr.GetScheme(),
+		Shard:                          c.ExtraConfig.Shard,
+		Mutex:                          &sync.Mutex{},
+		RequeueAfterDuration:           c.ExtraConfig.RequeueAfterDuration,
+		RetryAfterDuration:             c.ExtraConfig.RetryAfterDuration,
+		BeforeDeadlineDuration:         c.ExtraConfig.BeforeDeadlineDuration,
+		MaxQueueDuration:               c.ExtraConfig.MaxQueueDuration,
+		MaxClusterParallelOps:          c.ExtraConfig.MaxClusterParallelOps,
+		MaxNamespaceParallelOps:        c.ExtraConfig.MaxNamespaceParallelOps,
+		Scheduler:                      sched,
+		EnableTargetApprovalAnnotation: c.ExtraConfig.EnableTargetApprovalAnnotation,
+		ArgoCDNamespace:                c.ExtraConfig.ArgoCDNamespace,
+		EnableFluxSuspension:           c.ExtraConfig.EnableFluxSuspension,
+		OCMHubUsers:                    c.ExtraConfig.OCMHubUsers,
+		CircuitBreakerThreshold:        c.ExtraConfig.CircuitBreakerThreshold,
+		CircuitBreakerScope:            c.ExtraConfig.CircuitBreakerScope,
+		TargetDriftAction:              c.ExtraConfig.TargetDriftAction,
+		EnableAutoRollback:             c.ExtraConfig.EnableAutoRollback,
+		EnableExecuteNow:               c.ExtraConfig.EnableMutatingWebhook && c.ExtraConfig.EnableValidatingWebhook,
+		MinDurationSamples:             c.ExtraConfig.MinDurationSamples,
+		AnnotateMaintenanceMode:        c.ExtraConfig.AnnotateMaintenanceMode,
+		MaintenanceModeWebhookURL:      c.ExtraConfig.MaintenanceModeWebhookURL,
+		Preconditions:                  c.ExtraConfig.Preconditions,
+		Prometheus:                     prom,
+		Notifications:                  notifications,
+		Clock:                          api.GetClock(),
+		Recorder:                       mgr.GetEventRecorderFor("recommendation-controller"),
 	}).SetupWithManager(mgr, recommendationControllerOpts); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Recommendation")
 		os.Exit(1)
@@ -200,26 +297,187 @@ func (c completedConfig) New() (*SupervisorOperator, error) {
 	if err = (&supervisorcontrollers.MaintenanceWindowReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
+		Clock:  api.GetClock(),
+		Shard:  c.ExtraConfig.Shard,
+	}).SetupWithManager(mgr, controller.Options{
+		MaxConcurrentReconciles: c.ExtraConfig.MaintenanceWindowWorkers,
+		RateLimiter:             c.ExtraConfig.NewRateLimiter(),
+	}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "MaintenanceWindow")
 		os.Exit(1)
 	}
 	if err = (&supervisorcontrollers.ClusterMaintenanceWindowReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
+		Clock:  api.GetClock(),
+		Shard:  c.ExtraConfig.Shard,
+	}).SetupWithManager(mgr, controller.Options{
+		MaxConcurrentReconciles: c.ExtraConfig.MaintenanceWindowWorkers,
+		RateLimiter:             c.ExtraConfig.NewRateLimiter(),
+	}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ClusterMaintenanceWindow")
 		os.Exit(1)
 	}
 	if err = (&supervisorcontrollers.ApprovalPolicyReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
+		Shard:  c.ExtraConfig.Shard,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ApprovalPolicy")
 		os.Exit(1)
 	}
+	if err = (&supervisorcontrollers.RecommendationGroupReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		Clock:  api.GetClock(),
+		Shard:  c.ExtraConfig.Shard,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RecommendationGroup")
+		os.Exit(1)
+	}
+	if err = (&supervisorcontrollers.SupervisorReportReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		Shard:  c.ExtraConfig.Shard,
+		Clock:  api.GetClock(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SupervisorReport")
+		os.Exit(1)
+	}
+	if err = (&supervisorcontrollers.DowntimeBudgetReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		Shard:  c.ExtraConfig.Shard,
+		Clock:  api.GetClock(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DowntimeBudget")
+		os.Exit(1)
+	}
+	if c.ExtraConfig.EnableOCMHub {
+		if err = (&supervisorcontrollers.RecommendationDistributionReconciler{
+			Client:               mgr.GetClient(),
+			Scheme:               mgr.GetScheme(),
+			Shard:                c.ExtraConfig.Shard,
+			RequeueAfterDuration: c.ExtraConfig.RequeueAfterDuration,
+			Clock:                api.GetClock(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "RecommendationDistribution")
+			os.Exit(1)
+		}
+		if err = (&supervisorcontrollers.MaintenanceWindowDistributionReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+			Shard:  c.ExtraConfig.Shard,
+			Clock:  api.GetClock(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "MaintenanceWindowDistribution")
+			os.Exit(1)
+		}
+	}
+	for _, kind := range c.ExtraConfig.KubeDBRecommenderKinds {
+		if err = (&supervisorcontrollers.KubeDBVersionRecommender{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+			Shard:  c.ExtraConfig.Shard,
+			Clock:  api.GetClock(),
+			Kind:   strings.TrimSpace(kind),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "KubeDBVersionRecommender", "kind", kind)
+			os.Exit(1)
+		}
+	}
+	if c.ExtraConfig.EnableKubeDBCVERecommender {
+		if err = (&supervisorcontrollers.KubeDBCVERecommender{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+			Shard:  c.ExtraConfig.Shard,
+			Clock:  api.GetClock(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "KubeDBCVERecommender")
+			os.Exit(1)
+		}
+	}
+	if c.ExtraConfig.CertificateBeforeExpiry > 0 {
+		if err = (&supervisorcontrollers.KubeDBCertificateRecommender{
+			Client:       mgr.GetClient(),
+			Scheme:       mgr.GetScheme(),
+			Shard:        c.ExtraConfig.Shard,
+			Clock:        api.GetClock(),
+			BeforeExpiry: c.ExtraConfig.CertificateBeforeExpiry,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "KubeDBCertificateRecommender")
+			os.Exit(1)
+		}
+	}
+	if c.ExtraConfig.AuditSink != "" {
+		sink, err := audit.NewSink(c.ExtraConfig.AuditSink)
+		if err != nil {
+			setupLog.Error(err, "unable to create audit sink")
+			os.Exit(1)
+		}
+		if err = audit.NewAuditor(sink, c.ExtraConfig.Shard).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to set up auditor")
+			os.Exit(1)
+		}
+	}
+	if c.ExtraConfig.SlackCallbackBindAddress != "" {
+		signingSecret, err := os.ReadFile(c.ExtraConfig.SlackSigningSecretFile)
+		if err != nil {
+			setupLog.Error(err, "unable to read Slack signing secret")
+			os.Exit(1)
+		}
+		callbackServer := slack.NewCallbackServer(c.ExtraConfig.SlackCallbackBindAddress, mgr.GetClient(),
+			bytes.TrimSpace(signingSecret), c.ExtraConfig.SlackAllowedUsers, c.ExtraConfig.TwoPersonRuleNamespaceSelector)
+		if err = mgr.Add(callbackServer); err != nil {
+			setupLog.Error(err, "unable to set up Slack callback server")
+			os.Exit(1)
+		}
+	}
+	if c.ExtraConfig.DashboardBindAddress != "" {
+		kubeClient, err := kubernetes.NewForConfig(mgr.GetConfig())
+		if err != nil {
+			setupLog.Error(err, "unable to create kubernetes client for the dashboard")
+			os.Exit(1)
+		}
+		dashboardServer := dashboard.NewServer(c.ExtraConfig.DashboardBindAddress, c.ExtraConfig.DashboardTLSCertFile,
+			c.ExtraConfig.DashboardTLSKeyFile, mgr.GetClient(), kubeClient, api.GetClock(), c.ExtraConfig.TwoPersonRuleNamespaceSelector)
+		if err = mgr.Add(dashboardServer); err != nil {
+			setupLog.Error(err, "unable to set up dashboard server")
+			os.Exit(1)
+		}
+	}
+	// The digest covers every namespace, so it is only sent by the shard owning the cluster scoped objects.
+	if c.ExtraConfig.DigestSchedule != "" && c.ExtraConfig.Shard.OwnsNamespace("") {
+		schedule, err := digest.ParseSchedule(c.ExtraConfig.DigestSchedule)
+		if err != nil {
+			setupLog.Error(err, "unable to parse digest schedule")
+			os.Exit(1)
+		}
+		if err = mgr.Add(digest.NewDigester(mgr.GetClient(), schedule, api.GetClock())); err != nil {
+			setupLog.Error(err, "unable to set up digester")
+			os.Exit(1)
+		}
+	}
+	if c.ExtraConfig.GitOpsRepository != "" {
+		var token []byte
+		if c.ExtraConfig.GitOpsTokenFile != "" {
+			if token, err = os.ReadFile(c.ExtraConfig.GitOpsTokenFile); err != nil {
+				setupLog.Error(err, "unable to read GitOps token")
+				os.Exit(1)
+			}
+		}
+		repo, err := gitops.NewRepository(c.ExtraConfig.GitOpsAPIURL, c.ExtraConfig.GitOpsRepository,
+			c.ExtraConfig.GitOpsBranch, c.ExtraConfig.GitOpsPathTemplate, string(bytes.TrimSpace(token)))
+		if err != nil {
+			setupLog.Error(err, "unable to set up GitOps repository")
+			os.Exit(1)
+		}
+		executor.EnableGitOps(repo)
+	}
 	//+kubebuilder:scaffold:builder
 
+	genericServer.Handler.NonGoRestfulMux.Handle(ConversionWebhookPath, conversion.NewWebhookHandler(Scheme))
+
 	s := &SupervisorOperator{
 		GenericAPIServer: genericServer,
 		Manager:          mgr,
@@ -277,6 +535,36 @@ func (c completedConfig) New() (*SupervisorOperator, error) {
 	return s, nil
 }
 
+// crdConversion returns the webhook conversion of the CRDs served by the conversion webhook of the given service.
+// It returns nil if no service is given.
+func crdConversion(service, caFile string) (*crdv1.CustomResourceConversion, error) {
+	if service == "" {
+		return nil, nil
+	}
+	ns, name, _ := strings.Cut(service, "/")
+	var caBundle []byte
+	if caFile != "" {
+		var err error
+		if caBundle, err = os.ReadFile(caFile); err != nil {
+			return nil, err
+		}
+	}
+	return &crdv1.CustomResourceConversion{
+		Strategy: crdv1.WebhookConverter,
+		Webhook: &crdv1.WebhookConversion{
+			ClientConfig: &crdv1.WebhookClientConfig{
+				Service: &crdv1.ServiceReference{
+					Namespace: ns,
+					Name:      name,
+					Path:      pointer.StringP(ConversionWebhookPath),
+				},
+				CABundle: caBundle,
+			},
+			ConversionReviewVersions: []string{"v1"},
+		},
+	}, nil
+}
+
 func appendUniqueGroupVersion(slice []schema.GroupVersion, elems ...schema.GroupVersion) []schema.GroupVersion {
 	m := map[schema.GroupVersion]bool{}
 	for _, gv := range slice {
@@ -316,3 +604,41 @@ func admissionHooksByGroupThenVersion(admissionHooks ...hooks.AdmissionHook) map
 	}
 	return ret
 }
+
+// cacheNamespaces returns the namespaces cached by the manager, either the watched namespaces or
+// every namespace except the excluded ones. Nil means every namespace is cached.
+// The cluster scoped objects are cached regardless of the namespaces.
+func cacheNamespaces(watch, exclude []string) map[string]cache.Config {
+	if len(watch) > 0 {
+		namespaces := make(map[string]cache.Config, len(watch))
+		for _, ns := range watch {
+			namespaces[strings.TrimSpace(ns)] = cache.Config{}
+		}
+		return namespaces
+	}
+	if len(exclude) > 0 {
+		selectors := make([]fields.Selector, 0, len(exclude))
+		for _, ns := range exclude {
+			selectors = append(selectors, fields.OneTermNotEqualSelector("metadata.namespace", strings.TrimSpace(ns)))
+		}
+		return map[string]cache.Config{
+			cache.AllNamespaces: {FieldSelector: fields.AndSelectors(selectors...)},
+		}
+	}
+	return nil
+}
+
+// cacheByObject returns the cache settings of the objects cached regardless of the watched namespaces.
+// The Pods are cached in every namespace, as draining a Node evicts the pods of every namespace.
+func cacheByObject(watch, exclude []string) map[client.Object]cache.ByObject {
+	if len(watch) == 0 && len(exclude) == 0 {
+		return nil
+	}
+	return map[client.Object]cache.ByObject{
+		&core.Pod{}: {
+			Namespaces: map[string]cache.Config{
+				cache.AllNamespaces: {FieldSelector: fields.Everything()},
+			},
+		},
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharding
+
+import (
+	"context"
+	"hash/fnv"
+
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Shard identifies the subset of namespaces reconciled by a replica of the operator, when the reconciliation
+// is sharded among Count replicas. Each namespace is owned by exactly one shard, chosen by the hash of the
+// namespace name, and the cluster scoped objects are owned by the shard 0.
+// The zero value owns every object, i.e. the sharding is disabled.
+type Shard struct {
+	Index int
+	Count int
+}
+
+// Enabled returns true if the reconciliation is sharded among multiple replicas.
+func (s Shard) Enabled() bool {
+	return s.Count > 1
+}
+
+// OwnsNamespace returns true if the objects of the given namespace are reconciled by this shard.
+// Empty namespace stands for the cluster scoped objects.
+func (s Shard) OwnsNamespace(namespace string) bool {
+	if !s.Enabled() {
+		return true
+	}
+	if namespace == "" {
+		return s.Index == 0
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(namespace))
+	return int(h.Sum32()%uint32(s.Count)) == s.Index
+}
+
+// Reconciler wraps the given Reconciler to ignore the requests of the objects not owned by this shard.
+// The requests are filtered instead of the events, so that the requests mapped from the watches of
+// the other objects are also filtered.
+func (s Shard) Reconciler(r reconcile.Reconciler) reconcile.Reconciler {
+	if !s.Enabled() {
+		return r
+	}
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		if !s.OwnsNamespace(req.Namespace) {
+			return reconcile.Result{}, nil
+		}
+		return r.Reconcile(ctx, req)
+	})
+}
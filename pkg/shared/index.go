@@ -0,0 +1,54 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IndexRecommendations registers the field indexes of the Recommendations, so that the Recommendations of a target,
+// of a phase, of a window or depending on a Recommendation are listed from the cache without going through every Recommendation.
+func IndexRecommendations(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &api.Recommendation{}, api.RecommendationTargetIndex, func(rawObj client.Object) []string {
+		return []string{rawObj.(*api.Recommendation).TargetIndexKey()}
+	}); err != nil {
+		return err
+	}
+
+	if err := indexer.IndexField(ctx, &api.Recommendation{}, api.RecommendationPhaseIndex, func(rawObj client.Object) []string {
+		return []string{string(rawObj.(*api.Recommendation).Status.Phase)}
+	}); err != nil {
+		return err
+	}
+
+	if err := indexer.IndexField(ctx, &api.Recommendation{}, api.RecommendationWindowIndex, func(rawObj client.Object) []string {
+		if key := rawObj.(*api.Recommendation).ApprovedWindowIndexKey(); key != "" {
+			return []string{key}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return indexer.IndexField(ctx, &api.Recommendation{}, api.RecommendationDependencyIndex, func(rawObj client.Object) []string {
+		return rawObj.(*api.Recommendation).DependencyIndexKeys()
+	})
+}
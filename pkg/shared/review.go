@@ -0,0 +1,68 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kmc "kmodules.xyz/client-go/client"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Review records the approval decision of the reviewer into the status of the Recommendation.
+// It is used by the integrations reviewing on behalf of their users, where the requester of the admission request
+// is the operator itself. The mutating webhook keeps the reviewer recorded here for the requests of the operator.
+// The approval of the reviewer is added to the approvals, so that it counts towards the required approvals.
+// rejectionReason is only recorded if the Recommendation is Rejected.
+func Review(ctx context.Context, kc client.Client, rcmd *api.Recommendation, status api.ApprovalStatus, reviewer api.Approval, comments string, rejectionReason api.RejectionReason) error {
+	_, err := kmc.PatchStatus(ctx, kc, rcmd, func(obj client.Object) client.Object {
+		in := obj.(*api.Recommendation)
+		now := metav1.NewTime(api.GetClock().Now())
+		in.Status.ApprovalStatus = status
+		in.Status.Reviewer = &api.Subject{
+			Kind:     rbac.UserKind,
+			APIGroup: rbac.GroupName,
+			Name:     reviewer.Username,
+		}
+		in.Status.ReviewTimestamp = &now
+		in.Status.Comments = comments
+		if status == api.ApprovalApproved {
+			reviewer.Timestamp = now
+			in.Status.ApprovedBy = &reviewer
+			if !hasApproval(in.Status.Approvals, reviewer.Username) {
+				in.Status.Approvals = append(in.Status.Approvals, reviewer)
+			}
+		} else {
+			in.Status.RejectionReason = rejectionReason
+		}
+		return in
+	})
+	return err
+}
+
+func hasApproval(approvals []api.Approval, username string) bool {
+	for _, a := range approvals {
+		if a.Username == username {
+			return true
+		}
+	}
+	return false
+}
@@ -32,6 +32,16 @@ func GetGVK(obj runtime.RawExtension) (schema.GroupVersionKind, error) {
 	return unObj.GetObjectKind().GroupVersionKind(), nil
 }
 
+// GetOperationType returns the `.spec.type` of the operation, e.g. `UpdateVersion` for KubeDB OpsRequests.
+func GetOperationType(obj runtime.RawExtension) (string, error) {
+	unObj, err := GetUnstructuredObj(obj)
+	if err != nil {
+		return "", err
+	}
+	opsType, _, err := unstructured.NestedString(unObj.Object, "spec", "type")
+	return opsType, err
+}
+
 func GetUnstructuredObj(obj runtime.RawExtension) (*unstructured.Unstructured, error) {
 	unObj := &unstructured.Unstructured{}
 	if err := json.Unmarshal(obj.Raw, unObj); err != nil {
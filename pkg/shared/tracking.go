@@ -0,0 +1,78 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// SetTrackingMetadata labels the operation object with the name of its Recommendation and annotates it
+// with the approver and the approved window, so that the operations of a Recommendation can be listed
+// with `kubectl get <kind> -l supervisor.appscode.com/recommendation=<name>`.
+func SetTrackingMetadata(obj metav1.Object, rcmd *api.Recommendation) {
+	// The names longer than a label value are left out of the label.
+	if len(validation.IsValidLabelValue(rcmd.Name)) == 0 {
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[api.RecommendationKey] = rcmd.Name
+		obj.SetLabels(labels)
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if approver := Approver(rcmd); approver != "" {
+		annotations[api.ApproverKey] = approver
+	}
+	if window := ApprovedWindow(rcmd); window != "" {
+		annotations[api.ApprovedWindowKey] = window
+	}
+	obj.SetAnnotations(annotations)
+}
+
+// Approver returns the username who approved the Recommendation, if known.
+func Approver(rcmd *api.Recommendation) string {
+	if rcmd.Status.ApprovedBy != nil {
+		return rcmd.Status.ApprovedBy.Username
+	}
+	if rcmd.Status.Reviewer != nil {
+		return rcmd.Status.Reviewer.Name
+	}
+	return ""
+}
+
+// ApprovedWindow returns the `Kind/[namespace/]name` of the approved MaintenanceWindow of the Recommendation,
+// or the approved window type if the Recommendation isn't approved for a MaintenanceWindow.
+func ApprovedWindow(rcmd *api.Recommendation) string {
+	aw := rcmd.Status.ApprovedWindow
+	if aw == nil {
+		return ""
+	}
+	if ref := aw.MaintenanceWindow; ref != nil {
+		if ref.Namespace != "" {
+			return ref.Kind + "/" + ref.Namespace + "/" + ref.Name
+		}
+		return ref.Kind + "/" + ref.Name
+	}
+	return string(aw.Window)
+}
@@ -0,0 +1,243 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slack
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/notifier"
+	"kubeops.dev/supervisor/pkg/shared"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// maxRequestAge is the maximum age of the Slack requests to prevent the replay attacks.
+	maxRequestAge = 5 * time.Minute
+	// usernamePrefix is prepended to the Slack usernames recorded as the reviewers.
+	usernamePrefix = "slack:"
+)
+
+// CallbackServer handles the Approve and Reject button actions of the interactive Slack messages.
+// The requests are verified with the signing secret of the Slack app.
+type CallbackServer struct {
+	addr          string
+	kc            client.Client
+	signingSecret []byte
+	allowedUsers  map[string]bool
+	// twoPersonRuleSelector selects the namespaces where the Recommendations can't be approved from Slack,
+	// as the Slack users can't be matched with the creators of the Recommendations.
+	twoPersonRuleSelector labels.Selector
+}
+
+var _ manager.Runnable = &CallbackServer{}
+
+// NewCallbackServer returns the CallbackServer listening on the given address.
+// If allowedUsers is not empty, only the listed Slack user ids or usernames can approve or reject.
+// The Recommendations of the namespaces matching the twoPersonRuleSelector can only be rejected from Slack.
+func NewCallbackServer(addr string, kc client.Client, signingSecret []byte, allowedUsers []string, twoPersonRuleSelector labels.Selector) *CallbackServer {
+	s := &CallbackServer{
+		addr:                  addr,
+		kc:                    kc,
+		signingSecret:         signingSecret,
+		allowedUsers:          map[string]bool{},
+		twoPersonRuleSelector: twoPersonRuleSelector,
+	}
+	for _, u := range allowedUsers {
+		s.allowedUsers[u] = true
+	}
+	return s
+}
+
+// Start runs the http server until the context is done.
+func (s *CallbackServer) Start(ctx context.Context) error {
+	srv := &http.Server{
+		Addr:              s.addr,
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Shutdown(context.Background())
+	}()
+	klog.Infof("starting Slack callback server on %s", s.addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+type interactionPayload struct {
+	Type string `json:"type"`
+	User struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Actions []struct {
+		ActionID string `json:"action_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+	ResponseURL string `json:"response_url"`
+}
+
+func (s *CallbackServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(req.Body, 1<<20))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := s.verify(req.Header, body); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	payload := interactionPayload{}
+	if err := json.Unmarshal([]byte(req.PostFormValue("payload")), &payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if payload.Type != "block_actions" || len(payload.Actions) == 0 {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	text, err := s.handleAction(req.Context(), &payload)
+	if err != nil {
+		klog.Errorf("failed to handle the Slack action of user %s: %v", payload.User.Username, err)
+		text = fmt.Sprintf("Failed to handle the action: %v", err)
+	}
+	w.WriteHeader(http.StatusOK)
+	if payload.ResponseURL != "" {
+		s.respond(payload.ResponseURL, text)
+	}
+}
+
+// verify checks the signature of the request as described in https://api.slack.com/authentication/verifying-requests-from-slack
+func (s *CallbackServer) verify(header http.Header, body []byte) error {
+	ts, err := strconv.ParseInt(header.Get("X-Slack-Request-Timestamp"), 10, 64)
+	if err != nil {
+		return errors.New("invalid request timestamp")
+	}
+	if age := time.Since(time.Unix(ts, 0)); age > maxRequestAge || age < -maxRequestAge {
+		return errors.New("request is too old")
+	}
+	mac := hmac.New(sha256.New, s.signingSecret)
+	_, _ = fmt.Fprintf(mac, "v0:%d:%s", ts, body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(header.Get("X-Slack-Signature"))) {
+		return errors.New("invalid request signature")
+	}
+	return nil
+}
+
+func (s *CallbackServer) handleAction(ctx context.Context, payload *interactionPayload) (string, error) {
+	if len(s.allowedUsers) > 0 && !s.allowedUsers[payload.User.ID] && !s.allowedUsers[payload.User.Username] {
+		return "", fmt.Errorf("slack user %s is not allowed to review Recommendations", payload.User.Username)
+	}
+
+	action := payload.Actions[0]
+	var status api.ApprovalStatus
+	switch action.ActionID {
+	case notifier.SlackActionApprove:
+		status = api.ApprovalApproved
+	case notifier.SlackActionReject:
+		status = api.ApprovalRejected
+	default:
+		return "", fmt.Errorf("unknown action %q", action.ActionID)
+	}
+	ns, name, found := strings.Cut(action.Value, "/")
+	if !found {
+		return "", fmt.Errorf("invalid Recommendation reference %q", action.Value)
+	}
+
+	rcmd := &api.Recommendation{}
+	if err := s.kc.Get(ctx, client.ObjectKey{Namespace: ns, Name: name}, rcmd); err != nil {
+		return "", err
+	}
+	if rcmd.Status.ApprovalStatus != api.ApprovalPending && rcmd.Status.ApprovalStatus != "" {
+		return fmt.Sprintf("Recommendation %s/%s is already %s", ns, name, rcmd.Status.ApprovalStatus), nil
+	}
+	if status == api.ApprovalApproved {
+		enforced, err := s.isTwoPersonRuleEnforced(ctx, ns)
+		if err != nil {
+			return "", err
+		}
+		if enforced {
+			return fmt.Sprintf("Recommendation %s/%s is subject to the two-person rule and can't be approved from Slack", ns, name), nil
+		}
+	}
+
+	username := usernamePrefix + payload.User.Username
+	comments := fmt.Sprintf("%s from Slack by %s (%s)", status, payload.User.Username, payload.User.ID)
+	err := shared.Review(ctx, s.kc, rcmd, status, api.Approval{Username: username}, comments, api.RejectionReasonOther)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Recommendation %s/%s is %s by <@%s>", ns, name, status, payload.User.ID), nil
+}
+
+// isTwoPersonRuleEnforced returns true if the two-person rule is enforced in the given namespace.
+func (s *CallbackServer) isTwoPersonRuleEnforced(ctx context.Context, namespace string) (bool, error) {
+	if s.twoPersonRuleSelector == nil || s.twoPersonRuleSelector.Empty() {
+		return false, nil
+	}
+	ns := &core.Namespace{}
+	if err := s.kc.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		return false, err
+	}
+	return s.twoPersonRuleSelector.Matches(labels.Set(ns.Labels)), nil
+}
+
+// respond replaces the interactive message with the result of the action.
+func (s *CallbackServer) respond(url, text string) {
+	data, err := json.Marshal(map[string]any{
+		"replace_original": true,
+		"text":             text,
+	})
+	if err != nil {
+		return
+	}
+	c := &http.Client{Timeout: 10 * time.Second}
+	resp, err := c.Post(url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		klog.Errorf("failed to respond to the Slack action: %v", err)
+		return
+	}
+	_ = resp.Body.Close()
+}
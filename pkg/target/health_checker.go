@@ -0,0 +1,54 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package target
+
+import (
+	"context"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/evaluator"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type HealthChecker struct {
+	ctx  context.Context
+	kc   client.Client
+	rcmd *api.Recommendation
+}
+
+func NewHealthChecker(ctx context.Context, kc client.Client, rcmd *api.Recommendation) *HealthChecker {
+	return &HealthChecker{
+		ctx:  ctx,
+		kc:   kc,
+		rcmd: rcmd,
+	}
+}
+
+// IsHealthy returns true if the target satisfies the TargetReadinessRule of the Recommendation.
+// DefaultTargetReadinessRule is used if no rule is provided.
+func (h *HealthChecker) IsHealthy() (bool, error) {
+	obj, err := GetTarget(h.ctx, h.kc, h.rcmd)
+	if err != nil {
+		return false, err
+	}
+	rule := h.rcmd.Spec.TargetReadinessRule
+	if rule == "" {
+		rule = api.DefaultTargetReadinessRule
+	}
+	return evaluator.New(obj, h.rcmd.Spec.Rules).EvaluateRule(rule)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package target
+
+import (
+	"context"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	meta_util "kmodules.xyz/client-go/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type PDBChecker struct {
+	ctx  context.Context
+	kc   client.Client
+	rcmd *api.Recommendation
+}
+
+func NewPDBChecker(ctx context.Context, kc client.Client, rcmd *api.Recommendation) *PDBChecker {
+	return &PDBChecker{
+		ctx:  ctx,
+		kc:   kc,
+		rcmd: rcmd,
+	}
+}
+
+// BlockingPDBs returns the PodDisruptionBudgets covering the pods of the target which currently allow
+// no disruption. As the operations restart the pods of the target, executing the Recommendation
+// would violate them.
+func (c *PDBChecker) BlockingPDBs() ([]policy.PodDisruptionBudget, error) {
+	pdbList := &policy.PodDisruptionBudgetList{}
+	if err := c.kc.List(c.ctx, pdbList, client.InNamespace(c.rcmd.Namespace)); err != nil {
+		return nil, err
+	}
+	if len(pdbList.Items) == 0 {
+		return nil, nil
+	}
+
+	pods, err := c.targetPods()
+	if err != nil {
+		return nil, err
+	}
+	var blocking []policy.PodDisruptionBudget
+	for _, pdb := range pdbList.Items {
+		if pdb.Status.DisruptionsAllowed > 0 {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			return nil, err
+		}
+		if selector.Empty() {
+			continue
+		}
+		for _, pod := range pods {
+			if selector.Matches(labels.Set(pod.Labels)) {
+				blocking = append(blocking, pdb)
+				break
+			}
+		}
+	}
+	return blocking, nil
+}
+
+// targetPods returns the pods selected by the `spec.selector` of the target, if any.
+// Otherwise, the pods labeled with the name of the target as the instance, e.g. the pods of a KubeDB database, are returned.
+func (c *PDBChecker) targetPods() ([]core.Pod, error) {
+	obj, err := GetTarget(c.ctx, c.kc, c.rcmd)
+	if err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{meta_util.InstanceLabelKey: obj.GetName()})
+	if m, found, err := unstructured.NestedMap(obj.Object, "spec", "selector"); err == nil && found {
+		ls := &metav1.LabelSelector{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, ls); err == nil {
+			if s, err := metav1.LabelSelectorAsSelector(ls); err == nil && !s.Empty() {
+				selector = s
+			}
+		}
+	}
+
+	podList := &core.PodList{}
+	if err := c.kc.List(c.ctx, podList, client.InNamespace(c.rcmd.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	return podList.Items, nil
+}
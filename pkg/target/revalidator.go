@@ -0,0 +1,108 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package target
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DriftAction specifies how the Recommendations are handled if their target is drifted after the approval.
+type DriftAction string
+
+const (
+	// DriftActionSkip marks the drifted Recommendations as Outdated and skips them.
+	DriftActionSkip DriftAction = "Skip"
+	// DriftActionReapprove sends the drifted Recommendations back for approval.
+	DriftActionReapprove DriftAction = "Reapprove"
+)
+
+type Revalidator struct {
+	ctx  context.Context
+	kc   client.Client
+	rcmd *api.Recommendation
+}
+
+func NewRevalidator(ctx context.Context, kc client.Client, rcmd *api.Recommendation) *Revalidator {
+	return &Revalidator{
+		ctx:  ctx,
+		kc:   kc,
+		rcmd: rcmd,
+	}
+}
+
+// CurrentGeneration returns the current generation of the target object.
+func (v *Revalidator) CurrentGeneration() (int64, error) {
+	obj, err := GetTarget(v.ctx, v.kc, v.rcmd)
+	if err != nil {
+		return 0, err
+	}
+	return obj.GetGeneration(), nil
+}
+
+// IsTargetChanged returns true if the spec of the target object is changed after the Recommendation is observed,
+// or the target object doesn't exist anymore.
+// The check is skipped for the retries, as a failed attempt of the operation itself may change the target.
+func (v *Revalidator) IsTargetChanged() (bool, error) {
+	if v.rcmd.Status.ObservedTargetGeneration == 0 || v.rcmd.Status.FailedAttempt > 0 {
+		return false, nil
+	}
+	generation, err := v.CurrentGeneration()
+	if kerr.IsNotFound(err) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+	return generation != v.rcmd.Status.ObservedTargetGeneration, nil
+}
+
+// CurrentSpecHash returns the hash of the spec of the target object.
+func (v *Revalidator) CurrentSpecHash() (string, error) {
+	obj, err := GetTarget(v.ctx, v.kc, v.rcmd)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(obj.Object["spec"])
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// IsTargetDrifted returns true if the spec of the target object is changed after the Recommendation is approved,
+// or the target object doesn't exist anymore. Changes of the metadata and status of the target are ignored.
+// Like IsTargetChanged, the check is skipped for the retries.
+func (v *Revalidator) IsTargetDrifted() (bool, error) {
+	if v.rcmd.Status.ApprovedTargetSpecHash == "" || v.rcmd.Status.FailedAttempt > 0 {
+		return false, nil
+	}
+	hash, err := v.CurrentSpecHash()
+	if kerr.IsNotFound(err) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+	return hash != v.rcmd.Status.ApprovedTargetSpecHash, nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package target
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TakeSnapshot returns the gzip compressed snapshot of the spec of the target object of the Recommendation.
+func TakeSnapshot(ctx context.Context, kc client.Client, rcmd *api.Recommendation, now time.Time) (*api.TargetSnapshot, error) {
+	obj, err := GetTarget(ctx, kc, rcmd)
+	if err != nil {
+		return nil, err
+	}
+	spec, _, err := unstructured.NestedFieldNoCopy(obj.Object, "spec")
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return &api.TargetSnapshot{
+		Timestamp:  metav1.NewTime(now),
+		Generation: obj.GetGeneration(),
+		Spec:       buf.Bytes(),
+	}, nil
+}
+
+// SnapshotSpec decompresses the spec of the target object stored in the snapshot.
+func SnapshotSpec(snapshot *api.TargetSnapshot) (map[string]interface{}, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(snapshot.Spec))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close() // nolint:errcheck
+
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+	spec := map[string]interface{}{}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
@@ -0,0 +1,52 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package target
+
+import (
+	"context"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CurrentVersion returns the `spec.version` of the target object of the Recommendation, if any.
+func CurrentVersion(ctx context.Context, kc client.Client, rcmd *api.Recommendation) (string, error) {
+	obj, err := GetTarget(ctx, kc, rcmd)
+	if err != nil {
+		return "", err
+	}
+	version, _, err := unstructured.NestedString(obj.Object, "spec", "version")
+	return version, err
+}
+
+// GetTarget returns the target object of the Recommendation.
+func GetTarget(ctx context.Context, kc client.Client, rcmd *api.Recommendation) (*unstructured.Unstructured, error) {
+	mapping, err := kc.RESTMapper().RESTMapping(rcmd.TargetGroupKind())
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(mapping.GroupVersionKind)
+	key := client.ObjectKey{Namespace: rcmd.Namespace, Name: rcmd.Spec.Target.Name}
+	if err := kc.Get(ctx, key, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
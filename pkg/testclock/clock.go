@@ -0,0 +1,116 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testclock
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+)
+
+// DebugPath is the path of the endpoint of the Clock on the metrics server.
+const DebugPath = "/debug/clock"
+
+// Clock is a clock running at the speed of the wall clock, whose current time can be shifted at runtime.
+// It is only used by the e2e tests, so that the behavior depending on the time of the day, e.g. the
+// maintenance windows opening tomorrow at 02:00, is verified deterministically against a running operator.
+// Shifting the time doesn't fire the pending timers, so the shifted time is observed by the next reconcile.
+type Clock struct {
+	clockwork.Clock
+
+	mu     sync.RWMutex
+	offset time.Duration
+}
+
+var _ clockwork.Clock = &Clock{}
+
+func New() *Clock {
+	return &Clock{Clock: clockwork.NewRealClock()}
+}
+
+func (c *Clock) Now() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Clock.Now().Add(c.offset)
+}
+
+func (c *Clock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+// Shift moves the current time of the clock by the given duration.
+func (c *Clock) Shift(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.offset += d
+}
+
+// Set moves the current time of the clock to the given time.
+func (c *Clock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.offset = t.Sub(c.Clock.Now())
+}
+
+// Request is the request of the endpoint. Either the time is set to Now, or it is shifted by Shift.
+type Request struct {
+	Now   *time.Time `json:"now,omitempty"`
+	Shift string     `json:"shift,omitempty"`
+}
+
+// Response is the response of the endpoint holding the current time of the clock.
+type Response struct {
+	Now time.Time `json:"now"`
+}
+
+// Handler returns the endpoint serving the current time of the clock on GET,
+// and setting or shifting it on POST.
+func (c *Clock) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			var in Request
+			if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if in.Now != nil {
+				c.Set(*in.Now)
+			}
+			if in.Shift != "" {
+				d, err := time.ParseDuration(in.Shift)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				c.Shift(d)
+			}
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Response{Now: c.Now().UTC()}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
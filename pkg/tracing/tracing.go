@@ -0,0 +1,127 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	tracerName    = "kubeops.dev/supervisor"
+	traceParent   = "traceparent"
+	serviceName   = "supervisor"
+	stageApproval = "approval"
+	stageWindow   = "window-wait"
+	stageExecute  = "execution"
+	stageVerify   = "verification"
+)
+
+var propagator = propagation.TraceContext{}
+
+// Setup configures the global TracerProvider to export the spans to the given OTLP gRPC endpoint.
+// Tracing is disabled if the endpoint is empty. The returned function flushes and stops the exporter.
+func Setup(ctx context.Context, endpoint string, insecure bool) (func(context.Context) error, error) {
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagator)
+	return tp.Shutdown, nil
+}
+
+// StartRecommendationSpan starts a span for the current lifecycle stage of the Recommendation.
+// The span is a child of the trace context stored in the Recommendation annotations, if any,
+// so that all the reconciliations of the Recommendation belong to the same trace.
+func StartRecommendationSpan(ctx context.Context, rcmd *api.Recommendation) (context.Context, trace.Span) {
+	if val, ok := rcmd.Annotations[api.TraceParentKey]; ok {
+		ctx = propagator.Extract(ctx, propagation.MapCarrier{traceParent: val})
+	}
+	return otel.Tracer(tracerName).Start(ctx, "Recommendation/"+stage(rcmd),
+		trace.WithAttributes(
+			attribute.String("recommendation.namespace", rcmd.Namespace),
+			attribute.String("recommendation.name", rcmd.Name),
+			attribute.String("recommendation.phase", string(rcmd.Status.Phase)),
+			attribute.String("recommendation.reason", rcmd.Status.Reason),
+			attribute.String("target.kind", rcmd.Spec.Target.Kind),
+			attribute.String("target.name", rcmd.Spec.Target.Name),
+		))
+}
+
+// TraceParent returns the W3C traceparent of the span in the context.
+// Empty string is returned if the context doesn't have a sampled span.
+func TraceParent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier[traceParent]
+}
+
+// SetAnnotations sets the trace id and the traceparent of the span in the context
+// as the annotations of the given object.
+func SetAnnotations(ctx context.Context, obj metav1.Object) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[api.TraceIDKey] = sc.TraceID().String()
+	annotations[api.TraceParentKey] = TraceParent(ctx)
+	obj.SetAnnotations(annotations)
+}
+
+func stage(rcmd *api.Recommendation) string {
+	switch rcmd.Status.Phase {
+	case "", api.Pending:
+		return stageApproval
+	case api.Waiting:
+		return stageWindow
+	case api.InProgress:
+		if rcmd.Status.Reason == api.VerifyingOperation {
+			return stageVerify
+		}
+		return stageExecute
+	default:
+		return string(rcmd.Status.Phase)
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/jobrunner"
+
+	"gomodules.xyz/pointer"
+	core "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	jobSuffix          = "verification"
+	maxOutputLength    = 1024
+	defaultHTTPTimeout = 10 * time.Second
+)
+
+type Verifier struct {
+	ctx  context.Context
+	kc   client.Client
+	rcmd *api.Recommendation
+}
+
+func NewVerifier(ctx context.Context, kc client.Client, rcmd *api.Recommendation) *Verifier {
+	return &Verifier{
+		ctx:  ctx,
+		kc:   kc,
+		rcmd: rcmd,
+	}
+}
+
+// Verify runs the verification of the Recommendation after the operation is succeeded.
+// It returns nil result while the verification is still running. The output holds the details of the verification.
+func (v *Verifier) Verify() (result *bool, output string, err error) {
+	verification := v.rcmd.Spec.Verification
+	if verification == nil {
+		return pointer.BoolP(true), "", nil
+	}
+	if verification.Job != nil {
+		return jobrunner.NewJobRunner(v.ctx, v.kc, v.rcmd).Run(jobSuffix, verification.Job)
+	}
+	if verification.HTTPGet != nil {
+		return v.probe(verification.HTTPGet, verification.TimeoutSeconds)
+	}
+	return nil, "", errors.New("either job or httpGet must be provided for verification")
+}
+
+func (v *Verifier) probe(action *core.HTTPGetAction, timeoutSeconds int32) (*bool, string, error) {
+	if action.Host == "" {
+		return nil, "", errors.New("host must be provided for httpGet verification")
+	}
+	if action.Port.IntValue() == 0 {
+		return nil, "", errors.New("numeric port must be provided for httpGet verification")
+	}
+	scheme := strings.ToLower(string(action.Scheme))
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{
+		Scheme: scheme,
+		Host:   net.JoinHostPort(action.Host, action.Port.String()),
+		Path:   action.Path,
+	}
+
+	req, err := http.NewRequestWithContext(v.ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, h := range action.HTTPHeaders {
+		req.Header.Add(h.Name, h.Value)
+	}
+
+	timeout := defaultHTTPTimeout
+	if timeoutSeconds > 0 {
+		timeout = time.Duration(timeoutSeconds) * time.Second
+	}
+	resp, err := (&http.Client{Timeout: timeout}).Do(req)
+	if err != nil {
+		return pointer.BoolP(false), err.Error(), nil
+	}
+	defer resp.Body.Close() // nolint:errcheck
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxOutputLength))
+	output := fmt.Sprintf("GET %s returned %s: %s", u.String(), resp.Status, string(body))
+	return pointer.BoolP(resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusBadRequest), output, nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	meta_util "kmodules.xyz/client-go/meta"
+)
+
+// operatorUsername returns the username of the operator itself, which reviews the Recommendations
+// on behalf of the users of the integrations, e.g. Slack & the dashboard.
+func operatorUsername(config *rest.Config) (string, error) {
+	kc, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return "", err
+	}
+	review, err := kc.AuthenticationV1().SelfSubjectReviews().Create(context.TODO(), &authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err == nil {
+		return review.Status.UserInfo.Username, nil
+	}
+	if sa := meta_util.PodServiceAccount(); sa != "" {
+		return serviceaccount.MakeUsername(meta_util.PodNamespace(), sa), nil
+	}
+	return "", err
+}
+
+// reviewerOf returns the user reviewing the Recommendation by the admission request.
+// The requests of the operator carry the identity of the integration user in the Reviewer and the ApprovedBy
+// of the Recommendation status, which is trusted. Otherwise, the requester is the reviewer.
+func reviewerOf(operator string, user authenticationv1.UserInfo, oldObj, obj *api.Recommendation) authenticationv1.UserInfo {
+	if operator == "" || user.Username != operator || obj.Status.Reviewer == nil || obj.Status.Reviewer.Name == "" {
+		return user
+	}
+	if oldObj != nil && oldObj.Status.Reviewer != nil && *oldObj.Status.Reviewer == *obj.Status.Reviewer {
+		return user
+	}
+
+	reviewer := authenticationv1.UserInfo{Username: obj.Status.Reviewer.Name}
+	if obj.Status.ApprovedBy != nil && obj.Status.ApprovedBy.Username == reviewer.Username {
+		reviewer.Groups = obj.Status.ApprovedBy.Groups
+	}
+	return reviewer
+}
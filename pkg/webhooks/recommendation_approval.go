@@ -0,0 +1,269 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/group"
+
+	admission "k8s.io/api/admission/v1"
+	authorization "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	hooks "kmodules.xyz/webhook-runtime/admission/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ApproveVerb is the RBAC verb on the recommendations resource which is required
+// to change the ApprovalStatus of a Recommendation.
+const ApproveVerb = "approve"
+
+// ExecuteNowVerb is the RBAC verb on the recommendations resource which is required to request
+// the execution of a Recommendation bypassing the MaintenanceWindow using the execute-now annotation.
+const ExecuteNowVerb = "execute-now"
+
+// RecommendationApprovalValidator allows only the users having the `approve` verb on the recommendations resource
+// to approve or reject a Recommendation. So, the users can be allowed to patch the Recommendation status without
+// being allowed to approve it. Similarly, only the users having the `execute-now` verb can request the execution
+// bypassing the MaintenanceWindow.
+// In the namespaces matching the twoPersonRuleSelector, the creator of a Recommendation can't approve it.
+// Reviewing a RecommendationGroup reviews its Pending members, so the reviewer of the group must be allowed
+// to review every one of them.
+type RecommendationApprovalValidator struct {
+	kc                    kubernetes.Interface
+	client                client.Client
+	twoPersonRuleSelector labels.Selector
+	operator              string
+}
+
+var _ hooks.AdmissionHook = &RecommendationApprovalValidator{}
+
+func NewRecommendationApprovalValidator(twoPersonRuleSelector labels.Selector) *RecommendationApprovalValidator {
+	return &RecommendationApprovalValidator{
+		twoPersonRuleSelector: twoPersonRuleSelector,
+	}
+}
+
+func (v *RecommendationApprovalValidator) Resource() (plural schema.GroupVersionResource, singular string) {
+	return schema.GroupVersionResource{
+		Group:    "validators." + api.GroupVersion.Group,
+		Version:  "v1alpha1",
+		Resource: "recommendationapprovalwebhooks",
+	}, "recommendationapprovalwebhook"
+}
+
+func (v *RecommendationApprovalValidator) Initialize(config *rest.Config, _ <-chan struct{}) error {
+	var err error
+	if v.kc, err = kubernetes.NewForConfig(config); err != nil {
+		return err
+	}
+	scheme := runtime.NewScheme()
+	if err = api.AddToScheme(scheme); err != nil {
+		return err
+	}
+	if v.client, err = client.New(config, client.Options{Scheme: scheme}); err != nil {
+		return err
+	}
+	if v.operator, err = operatorUsername(config); err != nil {
+		klog.Warningf("failed to identify the operator, the two-person rule is checked against the operator for the integration users: %v", err)
+	}
+	return nil
+}
+
+func (v *RecommendationApprovalValidator) Admit(req *admission.AdmissionRequest) *admission.AdmissionResponse {
+	status := &admission.AdmissionResponse{
+		UID:     req.UID,
+		Allowed: true,
+	}
+	if req.Kind.Group != api.GroupVersion.Group {
+		return status
+	}
+	if req.Operation != admission.Create && req.Operation != admission.Update {
+		return status
+	}
+	if v.kc == nil || v.client == nil {
+		return hooks.StatusUninitialized()
+	}
+	switch req.Kind.Kind {
+	case api.ResourceKindRecommendation:
+		return v.admitRecommendation(req, status)
+	case api.ResourceKindRecommendationGroup:
+		return v.admitRecommendationGroup(req, status)
+	}
+	return status
+}
+
+func (v *RecommendationApprovalValidator) admitRecommendation(req *admission.AdmissionRequest, status *admission.AdmissionResponse) *admission.AdmissionResponse {
+	obj := &api.Recommendation{}
+	if err := json.Unmarshal(req.Object.Raw, obj); err != nil {
+		return hooks.StatusBadRequest(err)
+	}
+	oldStatus := api.ApprovalPending
+	var oldObj *api.Recommendation
+	if req.Operation == admission.Update {
+		oldObj = &api.Recommendation{}
+		if err := json.Unmarshal(req.OldObject.Raw, oldObj); err != nil {
+			return hooks.StatusBadRequest(err)
+		}
+		if oldObj.Status.ApprovalStatus != "" {
+			oldStatus = oldObj.Status.ApprovalStatus
+		}
+	}
+
+	if obj.IsExecuteNowRequested() && (oldObj == nil || !oldObj.IsExecuteNowRequested()) {
+		allowed, err := v.isAllowed(req, req.Name, ExecuteNowVerb)
+		if err != nil {
+			return hooks.StatusInternalServerError(err)
+		}
+		if !allowed {
+			return hooks.StatusForbidden(fmt.Errorf("user %q is not allowed to %s recommendations in namespace %q",
+				req.UserInfo.Username, ExecuteNowVerb, req.Namespace))
+		}
+	}
+
+	if obj.Status.ApprovalStatus == "" || obj.Status.ApprovalStatus == oldStatus {
+		return status
+	}
+
+	allowed, err := v.isAllowed(req, req.Name, ApproveVerb)
+	if err != nil {
+		return hooks.StatusInternalServerError(err)
+	}
+	if !allowed {
+		return hooks.StatusForbidden(fmt.Errorf("user %q is not allowed to %s recommendations in namespace %q",
+			req.UserInfo.Username, ApproveVerb, req.Namespace))
+	}
+
+	if obj.Status.ApprovalStatus == api.ApprovalApproved {
+		reviewer := reviewerOf(v.operator, req.UserInfo, oldObj, obj)
+		selfApproval, err := v.isSelfApprovalForbidden(obj, reviewer.Username)
+		if err != nil {
+			return hooks.StatusInternalServerError(err)
+		}
+		if selfApproval {
+			return hooks.StatusForbidden(fmt.Errorf("user %q is not allowed to approve the Recommendation created by themselves in namespace %q",
+				reviewer.Username, req.Namespace))
+		}
+	}
+	return status
+}
+
+// admitRecommendationGroup allows the change of the ApprovalStatus of a RecommendationGroup only if the user is
+// allowed to review every Pending member, as the decision is propagated to them on behalf of the user.
+func (v *RecommendationApprovalValidator) admitRecommendationGroup(req *admission.AdmissionRequest, status *admission.AdmissionResponse) *admission.AdmissionResponse {
+	obj := &api.RecommendationGroup{}
+	if err := json.Unmarshal(req.Object.Raw, obj); err != nil {
+		return hooks.StatusBadRequest(err)
+	}
+	oldStatus := api.ApprovalPending
+	if req.Operation == admission.Update {
+		oldObj := &api.RecommendationGroup{}
+		if err := json.Unmarshal(req.OldObject.Raw, oldObj); err != nil {
+			return hooks.StatusBadRequest(err)
+		}
+		if oldObj.Status.ApprovalStatus != "" {
+			oldStatus = oldObj.Status.ApprovalStatus
+		}
+	}
+	if obj.Status.ApprovalStatus == "" || obj.Status.ApprovalStatus == oldStatus {
+		return status
+	}
+
+	members, err := group.ListMembers(context.TODO(), v.client, obj.Namespace, obj.Name)
+	if err != nil {
+		return hooks.StatusInternalServerError(err)
+	}
+	for i := range members {
+		rcmd := &members[i]
+		if rcmd.Status.ApprovalStatus != api.ApprovalPending && rcmd.Status.ApprovalStatus != "" {
+			continue
+		}
+		allowed, err := v.isAllowed(req, rcmd.Name, ApproveVerb)
+		if err != nil {
+			return hooks.StatusInternalServerError(err)
+		}
+		if !allowed {
+			return hooks.StatusForbidden(fmt.Errorf("user %q is not allowed to %s the recommendation %q of the RecommendationGroup in namespace %q",
+				req.UserInfo.Username, ApproveVerb, rcmd.Name, req.Namespace))
+		}
+		if obj.Status.ApprovalStatus != api.ApprovalApproved {
+			continue
+		}
+		selfApproval, err := v.isSelfApprovalForbidden(rcmd, req.UserInfo.Username)
+		if err != nil {
+			return hooks.StatusInternalServerError(err)
+		}
+		if selfApproval {
+			return hooks.StatusForbidden(fmt.Errorf("user %q is not allowed to approve the RecommendationGroup including the Recommendation %q created by themselves in namespace %q",
+				req.UserInfo.Username, rcmd.Name, req.Namespace))
+		}
+	}
+	return status
+}
+
+// isSelfApprovalForbidden returns true if the user is the creator of the Recommendation
+// and the namespace of the Recommendation is subject to the two-person rule.
+func (v *RecommendationApprovalValidator) isSelfApprovalForbidden(obj *api.Recommendation, username string) (bool, error) {
+	if v.twoPersonRuleSelector == nil {
+		return false, nil
+	}
+	if creator, ok := obj.Annotations[api.CreatedByKey]; !ok || creator != username {
+		return false, nil
+	}
+	ns, err := v.kc.CoreV1().Namespaces().Get(context.TODO(), obj.Namespace, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	return v.twoPersonRuleSelector.Matches(labels.Set(ns.Labels)), nil
+}
+
+// isAllowed returns true if the user of the admission request is allowed to perform the given verb on the named Recommendation.
+func (v *RecommendationApprovalValidator) isAllowed(req *admission.AdmissionRequest, name, verb string) (bool, error) {
+	extra := make(map[string]authorization.ExtraValue, len(req.UserInfo.Extra))
+	for k, val := range req.UserInfo.Extra {
+		extra[k] = authorization.ExtraValue(val)
+	}
+	sar := &authorization.SubjectAccessReview{
+		Spec: authorization.SubjectAccessReviewSpec{
+			ResourceAttributes: &authorization.ResourceAttributes{
+				Namespace: req.Namespace,
+				Verb:      verb,
+				Group:     api.GroupVersion.Group,
+				Resource:  api.ResourceRecommendations,
+				Name:      name,
+			},
+			User:   req.UserInfo.Username,
+			Groups: req.UserInfo.Groups,
+			Extra:  extra,
+			UID:    req.UserInfo.UID,
+		},
+	}
+	result, err := v.kc.AuthorizationV1().SubjectAccessReviews().Create(context.TODO(), sar, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return result.Status.Allowed, nil
+}
@@ -0,0 +1,299 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"encoding/json"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	"gomodules.xyz/jsonpatch/v2"
+	admission "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	hooks "kmodules.xyz/webhook-runtime/admission/v1"
+)
+
+// RecommendationIdentityMutator records the identity of the authenticated user of the admission request
+// into the Recommendation, as the builder based webhooks don't have access to the admission request.
+// The reviews done by the operator on behalf of the integration users keep the identity of those users.
+// The reviewer of a RecommendationGroup is recorded too, so that it is propagated to the member Recommendations.
+type RecommendationIdentityMutator struct {
+	operator string
+}
+
+var _ hooks.AdmissionHook = &RecommendationIdentityMutator{}
+
+func NewRecommendationIdentityMutator() *RecommendationIdentityMutator {
+	return &RecommendationIdentityMutator{}
+}
+
+func (m *RecommendationIdentityMutator) Resource() (plural schema.GroupVersionResource, singular string) {
+	return schema.GroupVersionResource{
+		Group:    "mutators." + api.GroupVersion.Group,
+		Version:  "v1alpha1",
+		Resource: "recommendationidentitywebhooks",
+	}, "recommendationidentitywebhook"
+}
+
+func (m *RecommendationIdentityMutator) Initialize(config *rest.Config, _ <-chan struct{}) error {
+	var err error
+	if m.operator, err = operatorUsername(config); err != nil {
+		klog.Warningf("failed to identify the operator, the reviews of the integration users are recorded as the reviews of the operator: %v", err)
+	}
+	return nil
+}
+
+func (m *RecommendationIdentityMutator) Admit(req *admission.AdmissionRequest) *admission.AdmissionResponse {
+	status := &admission.AdmissionResponse{
+		UID:     req.UID,
+		Allowed: true,
+	}
+	if req.Kind.Group != api.GroupVersion.Group {
+		return status
+	}
+	if req.Operation != admission.Create && req.Operation != admission.Update {
+		return status
+	}
+	switch req.Kind.Kind {
+	case api.ResourceKindRecommendation:
+		return m.admitRecommendation(req, status)
+	case api.ResourceKindRecommendationGroup:
+		return m.admitRecommendationGroup(req, status)
+	}
+	return status
+}
+
+func (m *RecommendationIdentityMutator) admitRecommendation(req *admission.AdmissionRequest, status *admission.AdmissionResponse) *admission.AdmissionResponse {
+	obj := &api.Recommendation{}
+	if err := json.Unmarshal(req.Object.Raw, obj); err != nil {
+		return hooks.StatusBadRequest(err)
+	}
+	var oldObj *api.Recommendation
+	if req.Operation == admission.Update {
+		oldObj = &api.Recommendation{}
+		if err := json.Unmarshal(req.OldObject.Raw, oldObj); err != nil {
+			return hooks.StatusBadRequest(err)
+		}
+	}
+
+	mod := obj.DeepCopy()
+	reviewer := reviewerOf(m.operator, req.UserInfo, oldObj, mod)
+	setCreator(oldObj, mod, req.UserInfo)
+	setExecuteNowRequester(oldObj, mod, req.UserInfo)
+	setReviewer(oldObj, mod, reviewer)
+	if !isApprovalReset(m.operator, req.UserInfo, oldObj, mod) {
+		setApprovedBy(oldObj, mod, reviewer)
+		addApproval(oldObj, mod, reviewer)
+	}
+	return patchResponse(req, status, mod)
+}
+
+func (m *RecommendationIdentityMutator) admitRecommendationGroup(req *admission.AdmissionRequest, status *admission.AdmissionResponse) *admission.AdmissionResponse {
+	obj := &api.RecommendationGroup{}
+	if err := json.Unmarshal(req.Object.Raw, obj); err != nil {
+		return hooks.StatusBadRequest(err)
+	}
+	var oldObj *api.RecommendationGroup
+	if req.Operation == admission.Update {
+		oldObj = &api.RecommendationGroup{}
+		if err := json.Unmarshal(req.OldObject.Raw, oldObj); err != nil {
+			return hooks.StatusBadRequest(err)
+		}
+	}
+
+	mod := obj.DeepCopy()
+	setGroupReviewer(oldObj, mod, req.UserInfo)
+	return patchResponse(req, status, mod)
+}
+
+// patchResponse returns the admission response patching the object of the request into mod.
+func patchResponse(req *admission.AdmissionRequest, status *admission.AdmissionResponse, mod any) *admission.AdmissionResponse {
+	modRaw, err := json.Marshal(mod)
+	if err != nil {
+		return hooks.StatusInternalServerError(err)
+	}
+	patch, err := jsonpatch.CreatePatch(req.Object.Raw, modRaw)
+	if err != nil {
+		return hooks.StatusInternalServerError(err)
+	}
+	if len(patch) == 0 {
+		return status
+	}
+	status.Patch, err = json.Marshal(patch)
+	if err != nil {
+		return hooks.StatusInternalServerError(err)
+	}
+	patchType := admission.PatchTypeJSONPatch
+	status.PatchType = &patchType
+	return status
+}
+
+// setExecuteNowRequester sets the requester of the execute-now annotation to the user who added it.
+// The requester can't be modified by the users as long as the annotation is unchanged.
+func setExecuteNowRequester(oldObj, obj *api.Recommendation, user authenticationv1.UserInfo) {
+	if !obj.IsExecuteNowRequested() {
+		delete(obj.Annotations, api.ExecuteNowRequestedByKey)
+		return
+	}
+	if oldObj != nil && oldObj.IsExecuteNowRequested() {
+		if requester, ok := oldObj.Annotations[api.ExecuteNowRequestedByKey]; ok {
+			obj.Annotations[api.ExecuteNowRequestedByKey] = requester
+			return
+		}
+	}
+	obj.Annotations[api.ExecuteNowRequestedByKey] = user.Username
+}
+
+// setCreator records the user who creates the Recommendation.
+// The creator can't be modified by the users afterwards.
+func setCreator(oldObj, obj *api.Recommendation, user authenticationv1.UserInfo) {
+	if oldObj != nil {
+		if creator, ok := oldObj.Annotations[api.CreatedByKey]; ok {
+			setAnnotation(obj, api.CreatedByKey, creator)
+		} else {
+			delete(obj.Annotations, api.CreatedByKey)
+		}
+		return
+	}
+	setAnnotation(obj, api.CreatedByKey, user.Username)
+}
+
+func setAnnotation(obj *api.Recommendation, key, value string) {
+	if obj.Annotations == nil {
+		obj.Annotations = map[string]string{}
+	}
+	obj.Annotations[key] = value
+}
+
+// setReviewer records the user who changes the ApprovalStatus as the reviewer of the Recommendation.
+func setReviewer(oldObj, obj *api.Recommendation, user authenticationv1.UserInfo) {
+	oldStatus := api.ApprovalPending
+	if oldObj != nil && oldObj.Status.ApprovalStatus != "" {
+		oldStatus = oldObj.Status.ApprovalStatus
+	}
+	if obj.Status.ApprovalStatus == "" || obj.Status.ApprovalStatus == oldStatus {
+		obj.Status.Reviewer = nil
+		if oldObj != nil {
+			obj.Status.Reviewer = oldObj.Status.Reviewer
+		}
+		return
+	}
+
+	obj.Status.Reviewer = &api.Subject{
+		Kind:     rbac.UserKind,
+		APIGroup: rbac.GroupName,
+		Name:     user.Username,
+	}
+	if obj.Status.ReviewTimestamp == nil || (oldObj != nil && obj.Status.ReviewTimestamp.Equal(oldObj.Status.ReviewTimestamp)) {
+		obj.Status.ReviewTimestamp = &metav1.Time{Time: api.GetClock().Now().UTC()}
+	}
+}
+
+// setApprovedBy records the user who changes the ApprovalStatus to Approved.
+func setApprovedBy(oldObj, obj *api.Recommendation, user authenticationv1.UserInfo) {
+	obj.Status.ApprovedBy = nil
+	if oldObj != nil {
+		obj.Status.ApprovedBy = oldObj.Status.ApprovedBy
+	}
+	if !isApprovedNow(oldObj, obj) {
+		return
+	}
+	obj.Status.ApprovedBy = &api.Approval{
+		Username:  user.Username,
+		Groups:    user.Groups,
+		Timestamp: metav1.Time{Time: api.GetClock().Now().UTC()},
+	}
+}
+
+// addApproval records the approval of the user when the ApprovalStatus is changed to Approved.
+// The recorded approvals can't be modified by the users.
+func addApproval(oldObj, obj *api.Recommendation, user authenticationv1.UserInfo) {
+	obj.Status.Approvals = nil
+	if oldObj != nil {
+		obj.Status.Approvals = oldObj.Status.Approvals
+	}
+	if !isApprovedNow(oldObj, obj) {
+		return
+	}
+	for _, a := range obj.Status.Approvals {
+		if a.Username == user.Username {
+			return
+		}
+	}
+	obj.Status.Approvals = append(obj.Status.Approvals, api.Approval{
+		Username:  user.Username,
+		Groups:    user.Groups,
+		Timestamp: metav1.Time{Time: api.GetClock().Now().UTC()},
+	})
+}
+
+// setGroupReviewer records the user who changes the ApprovalStatus of the RecommendationGroup as its reviewer,
+// and as the approver if it is changed to Approved. The recorded reviewer can't be modified by the users.
+func setGroupReviewer(oldObj, obj *api.RecommendationGroup, user authenticationv1.UserInfo) {
+	oldStatus := api.ApprovalPending
+	obj.Status.Reviewer, obj.Status.ReviewTimestamp, obj.Status.ApprovedBy = nil, nil, nil
+	if oldObj != nil {
+		if oldObj.Status.ApprovalStatus != "" {
+			oldStatus = oldObj.Status.ApprovalStatus
+		}
+		obj.Status.Reviewer = oldObj.Status.Reviewer
+		obj.Status.ReviewTimestamp = oldObj.Status.ReviewTimestamp
+		obj.Status.ApprovedBy = oldObj.Status.ApprovedBy
+	}
+	if obj.Status.ApprovalStatus == "" || obj.Status.ApprovalStatus == oldStatus {
+		return
+	}
+
+	now := metav1.Time{Time: api.GetClock().Now().UTC()}
+	obj.Status.Reviewer = &api.Subject{
+		Kind:     rbac.UserKind,
+		APIGroup: rbac.GroupName,
+		Name:     user.Username,
+	}
+	obj.Status.ReviewTimestamp = &now
+	if obj.Status.ApprovalStatus == api.ApprovalApproved {
+		obj.Status.ApprovedBy = &api.Approval{
+			Username:  user.Username,
+			Groups:    user.Groups,
+			Timestamp: now,
+		}
+	}
+}
+
+// isApprovalReset returns true if the operator withdraws the approval of the Recommendation and clears the recorded
+// approvals, e.g. as its target is drifted after the approval and a fresh approval is required.
+// The operator moving the Recommendation back to Pending to gather the approval quorum keeps the recorded approvals.
+func isApprovalReset(operator string, user authenticationv1.UserInfo, oldObj, obj *api.Recommendation) bool {
+	if operator == "" || user.Username != operator || oldObj == nil {
+		return false
+	}
+	return oldObj.Status.ApprovalStatus == api.ApprovalApproved && obj.Status.ApprovalStatus == api.ApprovalPending &&
+		obj.Status.ApprovedBy == nil && len(obj.Status.Approvals) == 0
+}
+
+// isApprovedNow returns true if the ApprovalStatus is changed to Approved by the request.
+func isApprovedNow(oldObj, obj *api.Recommendation) bool {
+	if obj.Status.ApprovalStatus != api.ApprovalApproved {
+		return false
+	}
+	return oldObj == nil || oldObj.Status.ApprovalStatus != api.ApprovalApproved
+}
@@ -0,0 +1,201 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	admission "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const testOperator = "system:serviceaccount:kubeops:supervisor"
+
+var approvedAt = metav1.NewTime(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+func approvedRecommendation() *api.Recommendation {
+	return &api.Recommendation{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: api.GroupVersion.String(),
+			Kind:       api.ResourceKindRecommendation,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "demo",
+			Name:        "upgrade",
+			Annotations: map[string]string{api.CreatedByKey: "creator"},
+		},
+		Status: api.RecommendationStatus{
+			ApprovalStatus: api.ApprovalApproved,
+			Phase:          api.Waiting,
+			ApprovedBy:     &api.Approval{Username: "alice", Timestamp: approvedAt},
+			Approvals: []api.Approval{
+				{Username: "alice", Timestamp: approvedAt},
+				{Username: "bob", Timestamp: approvedAt},
+			},
+		},
+	}
+}
+
+// admitIdentity sends the update of oldObj into obj by the given user through the RecommendationIdentityMutator,
+// and returns the Recommendation patched by the mutator.
+func admitIdentity(t *testing.T, user string, oldObj, obj *api.Recommendation) *api.Recommendation {
+	t.Helper()
+	oldRaw, err := json.Marshal(oldObj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &RecommendationIdentityMutator{operator: testOperator}
+	resp := m.Admit(&admission.AdmissionRequest{
+		Kind:        metav1.GroupVersionKind{Group: api.GroupVersion.Group, Version: api.GroupVersion.Version, Kind: api.ResourceKindRecommendation},
+		Operation:   admission.Update,
+		UserInfo:    authenticationv1.UserInfo{Username: user},
+		Object:      runtime.RawExtension{Raw: raw},
+		OldObject:   runtime.RawExtension{Raw: oldRaw},
+		SubResource: "status",
+	})
+	if !resp.Allowed {
+		t.Fatalf("update is rejected: %v", resp.Result)
+	}
+	if len(resp.Patch) > 0 {
+		patch, err := jsonpatch.DecodePatch(resp.Patch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if raw, err = patch.Apply(raw); err != nil {
+			t.Fatal(err)
+		}
+	}
+	out := &api.Recommendation{}
+	if err := json.Unmarshal(raw, out); err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func TestIdentityMutatorApprovalReset(t *testing.T) {
+	cases := []struct {
+		name           string
+		user           string
+		update         func(in *api.Recommendation)
+		wantApprovedBy string
+		wantApprovals  []string
+	}{
+		{
+			name: "drift reset by the operator clears the approvals",
+			user: testOperator,
+			update: func(in *api.Recommendation) {
+				in.Status.ApprovalStatus = api.ApprovalPending
+				in.Status.Phase = api.Pending
+				in.Status.Reason = api.WaitingForApproval
+				in.Status.ApprovedBy = nil
+				in.Status.Approvals = nil
+			},
+		},
+		{
+			name: "quorum reset by the operator keeps the approvals",
+			user: testOperator,
+			update: func(in *api.Recommendation) {
+				in.Status.ApprovalStatus = api.ApprovalPending
+				in.Status.Phase = api.Pending
+				in.Status.Reason = api.WaitingForApprovals
+			},
+			wantApprovedBy: "alice",
+			wantApprovals:  []string{"alice", "bob"},
+		},
+		{
+			name: "approvals can't be cleared by the users",
+			user: "mallory",
+			update: func(in *api.Recommendation) {
+				in.Status.ApprovalStatus = api.ApprovalPending
+				in.Status.ApprovedBy = nil
+				in.Status.Approvals = nil
+			},
+			wantApprovedBy: "alice",
+			wantApprovals:  []string{"alice", "bob"},
+		},
+		{
+			name: "approvals can't be cleared by the operator without withdrawing the approval",
+			user: testOperator,
+			update: func(in *api.Recommendation) {
+				in.Status.ApprovedBy = nil
+				in.Status.Approvals = nil
+			},
+			wantApprovedBy: "alice",
+			wantApprovals:  []string{"alice", "bob"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			oldObj := approvedRecommendation()
+			obj := oldObj.DeepCopy()
+			tc.update(obj)
+
+			out := admitIdentity(t, tc.user, oldObj, obj)
+			var approvedBy string
+			if out.Status.ApprovedBy != nil {
+				approvedBy = out.Status.ApprovedBy.Username
+			}
+			if approvedBy != tc.wantApprovedBy {
+				t.Errorf("approvedBy = %q, want %q", approvedBy, tc.wantApprovedBy)
+			}
+			if got := approvers(out.Status.Approvals); !reflect.DeepEqual(got, tc.wantApprovals) {
+				t.Errorf("approvals = %v, want %v", got, tc.wantApprovals)
+			}
+		})
+	}
+}
+
+func TestIdentityMutatorApprovalAfterDriftReset(t *testing.T) {
+	oldObj := approvedRecommendation()
+	reset := oldObj.DeepCopy()
+	reset.Status.ApprovalStatus = api.ApprovalPending
+	reset.Status.ApprovedBy = nil
+	reset.Status.Approvals = nil
+	reset = admitIdentity(t, testOperator, oldObj, reset)
+
+	approved := reset.DeepCopy()
+	approved.Status.ApprovalStatus = api.ApprovalApproved
+	approved = admitIdentity(t, "carol", reset, approved)
+
+	if approved.Status.ApprovedBy == nil || approved.Status.ApprovedBy.Username != "carol" {
+		t.Errorf("approvedBy = %+v, want carol", approved.Status.ApprovedBy)
+	}
+	if got := approvers(approved.Status.Approvals); !reflect.DeepEqual(got, []string{"carol"}) {
+		t.Errorf("approvals = %v, want only the fresh approval of carol", got)
+	}
+}
+
+func approvers(approvals []api.Approval) []string {
+	var names []string
+	for _, a := range approvals {
+		names = append(names, a.Username)
+	}
+	return names
+}
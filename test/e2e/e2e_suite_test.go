@@ -18,6 +18,7 @@ package e2e_test
 
 import (
 	"context"
+	"flag"
 	"os"
 	"path/filepath"
 	"testing"
@@ -53,6 +54,8 @@ func init() {
 	utilruntime.Must(opsapi.AddToScheme(scheme))
 	utilruntime.Must(kubedbapi.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
+
+	framework.AddGoFlags(flag.CommandLine)
 }
 
 // These tests use Ginkgo (BDD-style Go testing framework). Refer to
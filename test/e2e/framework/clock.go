@@ -0,0 +1,77 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"net"
+	"time"
+
+	"kubeops.dev/supervisor/pkg/testclock"
+
+	"github.com/jonboulle/clockwork"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Clock returns the clock used by the framework to build the time windows.
+// It follows the time of the operator once the time is set or shifted by the framework.
+func (f *Framework) Clock() clockwork.Clock {
+	return f.clock
+}
+
+// SetClock sets the time of the operator and of the framework to the given time.
+func (f *Framework) SetClock(t time.Time) error {
+	return f.updateClock(testclock.Request{Now: &t})
+}
+
+// ShiftClock shifts the time of the operator and of the framework by the given duration.
+// The shifted time is observed by the next reconcile of the objects, the pending requeues are not fired.
+func (f *Framework) ShiftClock(d time.Duration) error {
+	return f.updateClock(testclock.Request{Shift: d.String()})
+}
+
+// updateClock sends the request to the test clock of the operator through the service proxy of the API server,
+// and aligns the clock of the framework with the time responded by the operator.
+func (f *Framework) updateClock(in testclock.Request) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	kc, err := kubernetes.NewForConfig(f.restConfig)
+	if err != nil {
+		return err
+	}
+	data, err := kc.CoreV1().RESTClient().Post().
+		Namespace(OperatorNamespace).
+		Resource("services").
+		Name(net.JoinHostPort(OperatorService, OperatorMetricsPort)).
+		SubResource("proxy").
+		Suffix(testclock.DebugPath).
+		SetHeader("Content-Type", "application/json").
+		Body(body).
+		DoRaw(f.ctx)
+	if err != nil {
+		return err
+	}
+
+	var out testclock.Response
+	if err = json.Unmarshal(data, &out); err != nil {
+		return err
+	}
+	f.clock.Set(out.Now)
+	return nil
+}
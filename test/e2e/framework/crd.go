@@ -42,6 +42,34 @@ func (f *Framework) EnsureCRD() GomegaAsyncAssertion {
 			return fmt.Errorf("CRD ApprovalPolicy is not ready, Reason: %v", err)
 		}
 
+		if err := f.kc.List(f.ctx, &api.ClusterApprovalPolicyList{}); err != nil {
+			return fmt.Errorf("CRD ClusterApprovalPolicy is not ready, Reason: %v", err)
+		}
+
+		if err := f.kc.List(f.ctx, &api.RecommendationGroupList{}); err != nil {
+			return fmt.Errorf("CRD RecommendationGroup is not ready, Reason: %v", err)
+		}
+
+		if err := f.kc.List(f.ctx, &api.MaintenanceExecutionList{}); err != nil {
+			return fmt.Errorf("CRD MaintenanceExecution is not ready, Reason: %v", err)
+		}
+
+		if err := f.kc.List(f.ctx, &api.NotifierList{}); err != nil {
+			return fmt.Errorf("CRD Notifier is not ready, Reason: %v", err)
+		}
+
+		if err := f.kc.List(f.ctx, &api.SupervisorReportList{}); err != nil {
+			return fmt.Errorf("CRD SupervisorReport is not ready, Reason: %v", err)
+		}
+
+		if err := f.kc.List(f.ctx, &api.MaintenanceFreezeList{}); err != nil {
+			return fmt.Errorf("CRD MaintenanceFreeze is not ready, Reason: %v", err)
+		}
+
+		if err := f.kc.List(f.ctx, &api.DowntimeBudgetList{}); err != nil {
+			return fmt.Errorf("CRD DowntimeBudget is not ready, Reason: %v", err)
+		}
+
 		return nil
 	},
 		time.Minute*2,
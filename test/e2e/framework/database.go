@@ -42,17 +42,9 @@ func (f *Framework) newMongoDBStandaloneDatabase() *kubedbapi.MongoDB {
 			Namespace: f.getDatabaseNamespace(),
 		},
 		Spec: kubedbapi.MongoDBSpec{
-			Version:     "4.2.3",
-			StorageType: kubedbapi.StorageTypeDurable,
-			Storage: &core.PersistentVolumeClaimSpec{
-				AccessModes: []core.PersistentVolumeAccessMode{core.ReadWriteOnce},
-				Resources: core.VolumeResourceRequirements{
-					Requests: core.ResourceList{
-						core.ResourceStorage: resource.MustParse("1Gi"),
-					},
-				},
-				StorageClassName: pointer.StringP("standard"),
-			},
+			Version:           DBVersions[kubedbapi.ResourceKindMongoDB],
+			StorageType:       kubedbapi.StorageTypeDurable,
+			Storage:           f.newDatabaseStorage(),
 			TerminationPolicy: "WipeOut",
 		},
 	}
@@ -65,22 +57,14 @@ func (f *Framework) newPostgresStandaloneDatabase(customAuthName string) *kubedb
 			Namespace: f.getDatabaseNamespace(),
 		},
 		Spec: kubedbapi.PostgresSpec{
-			Version:     "13.2",
+			Version:     DBVersions[kubedbapi.ResourceKindPostgres],
 			StorageType: kubedbapi.StorageTypeDurable,
 			AuthSecret: &kubedbapi.SecretReference{
 				LocalObjectReference: core.LocalObjectReference{
 					Name: customAuthName,
 				},
 			},
-			Storage: &core.PersistentVolumeClaimSpec{
-				AccessModes: []core.PersistentVolumeAccessMode{core.ReadWriteOnce},
-				Resources: core.VolumeResourceRequirements{
-					Requests: core.ResourceList{
-						core.ResourceStorage: resource.MustParse("1Gi"),
-					},
-				},
-				StorageClassName: pointer.StringP("standard"),
-			},
+			Storage:           f.newDatabaseStorage(),
 			TerminationPolicy: "WipeOut",
 		},
 	}
@@ -110,6 +94,68 @@ func (f *Framework) CreateNewStandaloneMongoDB() (*kubedbapi.MongoDB, error) {
 	return mongoDB, nil
 }
 
+func (f *Framework) newMongoDBDatabase() *kubedbapi.MongoDB {
+	return &kubedbapi.MongoDB{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rand.WithUniqSuffix("supervisor"),
+			Namespace: f.getDatabaseNamespace(),
+		},
+		Spec: kubedbapi.MongoDBSpec{
+			Version:           DBVersions[kubedbapi.ResourceKindMongoDB],
+			StorageType:       kubedbapi.StorageTypeDurable,
+			TerminationPolicy: kubedbapi.TerminationPolicyWipeOut,
+		},
+	}
+}
+
+func (f *Framework) createMongoDB(mg *kubedbapi.MongoDB) (*kubedbapi.MongoDB, error) {
+	if err := f.kc.Create(f.ctx, mg); err != nil {
+		return nil, err
+	}
+	if err := f.waitForDatabaseReady(mg, func() kubedbapi.DatabasePhase { return mg.Status.Phase }); err != nil {
+		return nil, err
+	}
+	return mg, nil
+}
+
+// CreateNewMongoDBReplicaSet creates a MongoDB ReplicaSet with the given number of members.
+func (f *Framework) CreateNewMongoDBReplicaSet(replicas int32) (*kubedbapi.MongoDB, error) {
+	mg := f.newMongoDBDatabase()
+	mg.Spec.Replicas = pointer.Int32P(replicas)
+	mg.Spec.ReplicaSet = &kubedbapi.MongoDBReplicaSet{
+		Name: "rs0",
+	}
+	mg.Spec.Storage = f.newDatabaseStorage()
+	return f.createMongoDB(mg)
+}
+
+// CreateNewShardedMongoDB creates a sharded MongoDB with the given number of shards, members per shard,
+// config server members and mongos nodes.
+func (f *Framework) CreateNewShardedMongoDB(shards, shardReplicas, configServerReplicas, mongosReplicas int32) (*kubedbapi.MongoDB, error) {
+	mg := f.newMongoDBDatabase()
+	mg.Spec.ShardTopology = &kubedbapi.MongoDBShardingTopology{
+		Shard: kubedbapi.MongoDBShardNode{
+			Shards: shards,
+			MongoDBNode: kubedbapi.MongoDBNode{
+				Replicas: shardReplicas,
+			},
+			Storage: f.newDatabaseStorage(),
+		},
+		ConfigServer: kubedbapi.MongoDBConfigNode{
+			MongoDBNode: kubedbapi.MongoDBNode{
+				Replicas: configServerReplicas,
+			},
+			Storage: f.newDatabaseStorage(),
+		},
+		Mongos: kubedbapi.MongoDBMongosNode{
+			MongoDBNode: kubedbapi.MongoDBNode{
+				Replicas: mongosReplicas,
+			},
+		},
+	}
+	return f.createMongoDB(mg)
+}
+
 func (f *Framework) CreateNewStandalonePostgres() (*kubedbapi.Postgres, error) {
 	pgAuth, err := f.createPostgresCustomAuthSecret()
 	if err != nil {
@@ -196,3 +242,260 @@ func (f *Framework) createPostgresCustomAuthSecret() (*core.Secret, error) {
 func (f *Framework) postgresAuthNamespace() string {
 	return f.namespace
 }
+
+func (f *Framework) newDatabaseStorage() *core.PersistentVolumeClaimSpec {
+	return &core.PersistentVolumeClaimSpec{
+		AccessModes: []core.PersistentVolumeAccessMode{core.ReadWriteOnce},
+		Resources: core.VolumeResourceRequirements{
+			Requests: core.ResourceList{
+				core.ResourceStorage: resource.MustParse("1Gi"),
+			},
+		},
+		StorageClassName: pointer.StringP(StorageClass),
+	}
+}
+
+// waitForDatabaseReady waits until the given database is Ready. The database is refreshed on every poll,
+// so that phase returns the latest phase of the database.
+func (f *Framework) waitForDatabaseReady(db client.Object, phase func() kubedbapi.DatabasePhase) error {
+	return wait.PollUntilContextTimeout(context.Background(), time.Second, time.Minute*10, true, func(ctx context.Context) (bool, error) {
+		if err := f.kc.Get(f.ctx, client.ObjectKeyFromObject(db), db); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		return phase() == kubedbapi.DatabaseReady, nil
+	})
+}
+
+func (f *Framework) newMySQLDatabase(replicas int32, topology *kubedbapi.MySQLTopology) *kubedbapi.MySQL {
+	return &kubedbapi.MySQL{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rand.WithUniqSuffix("supervisor"),
+			Namespace: f.getDatabaseNamespace(),
+		},
+		Spec: kubedbapi.MySQLSpec{
+			Version:           DBVersions[kubedbapi.ResourceKindMySQL],
+			Replicas:          pointer.Int32P(replicas),
+			Topology:          topology,
+			StorageType:       kubedbapi.StorageTypeDurable,
+			Storage:           f.newDatabaseStorage(),
+			TerminationPolicy: kubedbapi.TerminationPolicyWipeOut,
+		},
+	}
+}
+
+func (f *Framework) createMySQL(my *kubedbapi.MySQL) (*kubedbapi.MySQL, error) {
+	if err := f.kc.Create(f.ctx, my); err != nil {
+		return nil, err
+	}
+	if err := f.waitForDatabaseReady(my, func() kubedbapi.DatabasePhase { return my.Status.Phase }); err != nil {
+		return nil, err
+	}
+	return my, nil
+}
+
+func (f *Framework) CreateNewStandaloneMySQL() (*kubedbapi.MySQL, error) {
+	return f.createMySQL(f.newMySQLDatabase(1, nil))
+}
+
+// CreateNewMySQLGroupReplication creates a Single-Primary MySQL group replication with the given number of members.
+func (f *Framework) CreateNewMySQLGroupReplication(replicas int32) (*kubedbapi.MySQL, error) {
+	mode := kubedbapi.MySQLModeGroupReplication
+	groupMode := kubedbapi.MySQLGroupModeSinglePrimary
+	return f.createMySQL(f.newMySQLDatabase(replicas, &kubedbapi.MySQLTopology{
+		Mode: &mode,
+		Group: &kubedbapi.MySQLGroupSpec{
+			Mode: &groupMode,
+		},
+	}))
+}
+
+func (f *Framework) newMariaDBDatabase(replicas int32) *kubedbapi.MariaDB {
+	return &kubedbapi.MariaDB{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rand.WithUniqSuffix("supervisor"),
+			Namespace: f.getDatabaseNamespace(),
+		},
+		Spec: kubedbapi.MariaDBSpec{
+			Version:           DBVersions[kubedbapi.ResourceKindMariaDB],
+			Replicas:          pointer.Int32P(replicas),
+			StorageType:       kubedbapi.StorageTypeDurable,
+			Storage:           f.newDatabaseStorage(),
+			TerminationPolicy: kubedbapi.TerminationPolicyWipeOut,
+		},
+	}
+}
+
+func (f *Framework) createMariaDB(md *kubedbapi.MariaDB) (*kubedbapi.MariaDB, error) {
+	if err := f.kc.Create(f.ctx, md); err != nil {
+		return nil, err
+	}
+	if err := f.waitForDatabaseReady(md, func() kubedbapi.DatabasePhase { return md.Status.Phase }); err != nil {
+		return nil, err
+	}
+	return md, nil
+}
+
+func (f *Framework) CreateNewStandaloneMariaDB() (*kubedbapi.MariaDB, error) {
+	return f.createMariaDB(f.newMariaDBDatabase(1))
+}
+
+// CreateNewMariaDBCluster creates a MariaDB Galera cluster with the given number of members.
+func (f *Framework) CreateNewMariaDBCluster(replicas int32) (*kubedbapi.MariaDB, error) {
+	return f.createMariaDB(f.newMariaDBDatabase(replicas))
+}
+
+func (f *Framework) DeleteMySQL(key client.ObjectKey) error {
+	my := &kubedbapi.MySQL{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+	}
+
+	return f.kc.Delete(f.ctx, my)
+}
+
+func (f *Framework) DeleteMariaDB(key client.ObjectKey) error {
+	md := &kubedbapi.MariaDB{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+	}
+
+	return f.kc.Delete(f.ctx, md)
+}
+
+func (f *Framework) newRedisDatabase(mode kubedbapi.RedisMode, cluster *kubedbapi.RedisClusterSpec) *kubedbapi.Redis {
+	return &kubedbapi.Redis{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rand.WithUniqSuffix("supervisor"),
+			Namespace: f.getDatabaseNamespace(),
+		},
+		Spec: kubedbapi.RedisSpec{
+			Version:           DBVersions[kubedbapi.ResourceKindRedis],
+			Mode:              mode,
+			Cluster:           cluster,
+			StorageType:       kubedbapi.StorageTypeDurable,
+			Storage:           f.newDatabaseStorage(),
+			TerminationPolicy: kubedbapi.TerminationPolicyWipeOut,
+		},
+	}
+}
+
+func (f *Framework) createRedis(rd *kubedbapi.Redis) (*kubedbapi.Redis, error) {
+	if err := f.kc.Create(f.ctx, rd); err != nil {
+		return nil, err
+	}
+	return f.WaitForRedisReady(client.ObjectKeyFromObject(rd))
+}
+
+func (f *Framework) CreateNewStandaloneRedis() (*kubedbapi.Redis, error) {
+	return f.createRedis(f.newRedisDatabase(kubedbapi.RedisModeStandalone, nil))
+}
+
+// CreateNewRedisCluster creates a Redis cluster with the given number of masters and replicas per master.
+func (f *Framework) CreateNewRedisCluster(masters, replicas int32) (*kubedbapi.Redis, error) {
+	return f.createRedis(f.newRedisDatabase(kubedbapi.RedisModeCluster, &kubedbapi.RedisClusterSpec{
+		Master:   pointer.Int32P(masters),
+		Replicas: pointer.Int32P(replicas),
+	}))
+}
+
+// WaitForRedisReady waits until the Redis is Ready and returns the latest Redis.
+func (f *Framework) WaitForRedisReady(key client.ObjectKey) (*kubedbapi.Redis, error) {
+	rd := &kubedbapi.Redis{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+	}
+	if err := f.waitForDatabaseReady(rd, func() kubedbapi.DatabasePhase { return rd.Status.Phase }); err != nil {
+		return nil, err
+	}
+	return rd, nil
+}
+
+func (f *Framework) newElasticsearchDatabase(replicas *int32, topology *kubedbapi.ElasticsearchClusterTopology) *kubedbapi.Elasticsearch {
+	es := &kubedbapi.Elasticsearch{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rand.WithUniqSuffix("supervisor"),
+			Namespace: f.getDatabaseNamespace(),
+		},
+		Spec: kubedbapi.ElasticsearchSpec{
+			Version:           DBVersions[kubedbapi.ResourceKindElasticsearch],
+			Replicas:          replicas,
+			Topology:          topology,
+			StorageType:       kubedbapi.StorageTypeDurable,
+			TerminationPolicy: kubedbapi.TerminationPolicyWipeOut,
+		},
+	}
+	if topology == nil {
+		es.Spec.Storage = f.newDatabaseStorage()
+	}
+	return es
+}
+
+func (f *Framework) newElasticsearchNode(replicas int32) *kubedbapi.ElasticsearchNode {
+	return &kubedbapi.ElasticsearchNode{
+		Replicas: pointer.Int32P(replicas),
+		Storage:  f.newDatabaseStorage(),
+	}
+}
+
+func (f *Framework) createElasticsearch(es *kubedbapi.Elasticsearch) (*kubedbapi.Elasticsearch, error) {
+	if err := f.kc.Create(f.ctx, es); err != nil {
+		return nil, err
+	}
+	return f.WaitForElasticsearchReady(client.ObjectKeyFromObject(es))
+}
+
+// CreateNewCombinedElasticsearch creates an Elasticsearch cluster with the given number of nodes having all the roles.
+func (f *Framework) CreateNewCombinedElasticsearch(replicas int32) (*kubedbapi.Elasticsearch, error) {
+	return f.createElasticsearch(f.newElasticsearchDatabase(pointer.Int32P(replicas), nil))
+}
+
+// CreateNewDedicatedElasticsearch creates an Elasticsearch cluster with the given number of dedicated master, ingest & data nodes.
+func (f *Framework) CreateNewDedicatedElasticsearch(masters, ingests, data int32) (*kubedbapi.Elasticsearch, error) {
+	return f.createElasticsearch(f.newElasticsearchDatabase(nil, &kubedbapi.ElasticsearchClusterTopology{
+		Master: *f.newElasticsearchNode(masters),
+		Ingest: *f.newElasticsearchNode(ingests),
+		Data:   f.newElasticsearchNode(data),
+	}))
+}
+
+// WaitForElasticsearchReady waits until the Elasticsearch is Ready and returns the latest Elasticsearch.
+func (f *Framework) WaitForElasticsearchReady(key client.ObjectKey) (*kubedbapi.Elasticsearch, error) {
+	es := &kubedbapi.Elasticsearch{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+	}
+	if err := f.waitForDatabaseReady(es, func() kubedbapi.DatabasePhase { return es.Status.Phase }); err != nil {
+		return nil, err
+	}
+	return es, nil
+}
+
+func (f *Framework) DeleteRedis(key client.ObjectKey) error {
+	rd := &kubedbapi.Redis{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+	}
+
+	return f.kc.Delete(f.ctx, rd)
+}
+
+func (f *Framework) DeleteElasticsearch(key client.ObjectKey) error {
+	es := &kubedbapi.Elasticsearch{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+	}
+
+	return f.kc.Delete(f.ctx, es)
+}
@@ -20,9 +20,8 @@ import (
 	"context"
 	"os"
 
-	"github.com/jonboulle/clockwork"
-
 	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
+	"kubeops.dev/supervisor/pkg/testclock"
 
 	"gomodules.xyz/x/crypto/rand"
 	"k8s.io/client-go/rest"
@@ -36,7 +35,7 @@ type Framework struct {
 	namespace     string
 	name          string
 	clusterMWName string
-	clock         clockwork.Clock
+	clock         *testclock.Clock
 }
 
 type Invocation struct {
@@ -52,7 +51,7 @@ func New(ctx context.Context, restConfig *rest.Config, kc client.Client) *Framew
 		namespace:     rand.WithUniqSuffix("supervisor-test-ns"),
 		name:          rand.WithUniqSuffix("supervisor"),
 		clusterMWName: rand.WithUniqSuffix("cluster-mw"),
-		clock:         api.GetClock(),
+		clock:         testclock.New(),
 	}
 }
 
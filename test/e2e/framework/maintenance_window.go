@@ -24,10 +24,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	kmapi "kmodules.xyz/client-go/api/v1"
+	kmc "kmodules.xyz/client-go/client"
 	api "kubeops.dev/supervisor/apis/supervisor/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// touchedAtKey is the annotation updated by the framework to trigger the reconciliation of the waiting Recommendations.
+const touchedAtKey = "e2e.supervisor.appscode.com/touched-at"
+
 func (f *Framework) CreateDefaultMaintenanceWindow() error {
 	mw := &api.MaintenanceWindow{
 		ObjectMeta: metav1.ObjectMeta{
@@ -177,6 +181,37 @@ func (f *Framework) GetDateWindowsAfter(after time.Duration, duration time.Durat
 	}
 }
 
+// GetDateWindowsAt returns the date window of the given duration starting at the given time of the day,
+// the given number of days after the current day of the framework clock.
+func (f *Framework) GetDateWindowsAt(days int, hour, minute int, duration time.Duration) []api.DateWindow {
+	now := f.clock.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), now.Day()+days, hour, minute, 0, 0, time.UTC)
+	return []api.DateWindow{
+		{
+			Start: metav1.Time{Time: start},
+			End:   metav1.Time{Time: start.Add(duration)},
+		},
+	}
+}
+
+// TouchMaintenanceWindow updates an annotation of the MaintenanceWindow, so that the Recommendations
+// waiting for it are reconciled again, e.g. after the clock is shifted.
+func (f *Framework) TouchMaintenanceWindow(key client.ObjectKey) error {
+	mw, err := f.GetMaintenanceWindow(key)
+	if err != nil {
+		return err
+	}
+	_, err = kmc.CreateOrPatch(f.ctx, f.kc, mw, func(obj client.Object, createOp bool) client.Object {
+		in := obj.(*api.MaintenanceWindow)
+		if in.Annotations == nil {
+			in.Annotations = map[string]string{}
+		}
+		in.Annotations[touchedAtKey] = f.clock.Now().UTC().Format(time.RFC3339Nano)
+		return in
+	})
+	return err
+}
+
 func (f *Framework) GetMaintenanceWindow(key client.ObjectKey) (*api.MaintenanceWindow, error) {
 	mw := &api.MaintenanceWindow{}
 	err := f.kc.Get(f.ctx, key, mw)
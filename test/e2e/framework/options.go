@@ -0,0 +1,101 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	kubedbapi "kubedb.dev/apimachinery/apis/kubedb/v1alpha2"
+)
+
+var (
+	// DBVersions holds the versions of the test databases keyed by the database kind.
+	// The defaults are overridden by the E2E_DB_VERSION env, which is overridden by the --db-version flag.
+	DBVersions = dbVersions{
+		kubedbapi.ResourceKindMongoDB:       "4.2.3",
+		kubedbapi.ResourceKindPostgres:      "13.2",
+		kubedbapi.ResourceKindMySQL:         "8.0.35",
+		kubedbapi.ResourceKindMariaDB:       "10.11.2",
+		kubedbapi.ResourceKindRedis:         "7.2.3",
+		kubedbapi.ResourceKindElasticsearch: "xpack-8.11.1",
+	}
+
+	// StorageClass is the storage class of the volumes of the test databases.
+	// The default is overridden by the E2E_STORAGE_CLASS env, which is overridden by the --storage-class flag.
+	StorageClass = "standard"
+
+	// OperatorNamespace, OperatorService and OperatorMetricsPort locate the metrics server of the operator,
+	// whose test clock is set and shifted by the framework through the service proxy of the API server.
+	// The operator must be run with the --enable-test-clock flag.
+	OperatorNamespace   = "kubeops"
+	OperatorService     = "supervisor"
+	OperatorMetricsPort = "8080"
+)
+
+func init() {
+	if v, ok := os.LookupEnv("E2E_DB_VERSION"); ok {
+		if err := DBVersions.Set(v); err != nil {
+			panic(fmt.Sprintf("invalid E2E_DB_VERSION: %v", err))
+		}
+	}
+	if v, ok := os.LookupEnv("E2E_STORAGE_CLASS"); ok {
+		StorageClass = v
+	}
+}
+
+// AddGoFlags adds the flags configuring the test databases and locating the operator to the given FlagSet.
+func AddGoFlags(fs *flag.FlagSet) {
+	fs.Var(DBVersions, "db-version", "Comma separated versions of the test databases keyed by the database kind, e.g. MongoDB=4.4.26,Postgres=16.1")
+	fs.StringVar(&StorageClass, "storage-class", StorageClass, "Storage class of the volumes of the test databases")
+	fs.StringVar(&OperatorNamespace, "operator-namespace", OperatorNamespace, "Namespace of the Service of the operator")
+	fs.StringVar(&OperatorService, "operator-service", OperatorService, "Name of the Service of the operator")
+	fs.StringVar(&OperatorMetricsPort, "operator-metrics-port", OperatorMetricsPort, "Name or number of the port of the metrics server on the Service of the operator")
+}
+
+// dbVersions implements flag.Value for the `<kind>=<version>` pairs.
+// The given pairs are merged into the existing versions.
+type dbVersions map[string]string
+
+var _ flag.Value = dbVersions{}
+
+func (v dbVersions) String() string {
+	pairs := make([]string, 0, len(v))
+	for kind, version := range v {
+		pairs = append(pairs, kind+"="+version)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func (v dbVersions) Set(s string) error {
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		kind, version, ok := strings.Cut(pair, "=")
+		kind, version = strings.TrimSpace(kind), strings.TrimSpace(version)
+		if !ok || kind == "" || version == "" {
+			return fmt.Errorf("%q is not in the form of <kind>=<version>", pair)
+		}
+		v[kind] = version
+	}
+	return nil
+}
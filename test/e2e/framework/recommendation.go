@@ -143,6 +143,112 @@ func (f *Framework) newPostgresRecommendation(dbKey client.ObjectKey, deadline *
 	}, nil
 }
 
+func (f *Framework) getMySQLRestartOpsRequest(dbKey client.ObjectKey) *opsapi.MySQLOpsRequest {
+	return &opsapi.MySQLOpsRequest{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       opsapi.ResourceKindMySQLOpsRequest,
+			APIVersion: opsapi.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: dbKey.Namespace,
+		},
+		Spec: opsapi.MySQLOpsRequestSpec{
+			DatabaseRef: core.LocalObjectReference{
+				Name: dbKey.Name,
+			},
+			Type: opsapi.MySQLOpsRequestTypeRestart,
+		},
+	}
+}
+
+func (f *Framework) getMariaDBRestartOpsRequest(dbKey client.ObjectKey) *opsapi.MariaDBOpsRequest {
+	return &opsapi.MariaDBOpsRequest{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       opsapi.ResourceKindMariaDBOpsRequest,
+			APIVersion: opsapi.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: dbKey.Namespace,
+		},
+		Spec: opsapi.MariaDBOpsRequestSpec{
+			DatabaseRef: core.LocalObjectReference{
+				Name: dbKey.Name,
+			},
+			Type: opsapi.MariaDBOpsRequestTypeRestart,
+		},
+	}
+}
+
+func (f *Framework) getRedisRestartOpsRequest(dbKey client.ObjectKey) *opsapi.RedisOpsRequest {
+	return &opsapi.RedisOpsRequest{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       opsapi.ResourceKindRedisOpsRequest,
+			APIVersion: opsapi.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: dbKey.Namespace,
+		},
+		Spec: opsapi.RedisOpsRequestSpec{
+			DatabaseRef: core.LocalObjectReference{
+				Name: dbKey.Name,
+			},
+			Type: opsapi.RedisOpsRequestTypeRestart,
+		},
+	}
+}
+
+func (f *Framework) getElasticsearchRestartOpsRequest(dbKey client.ObjectKey) *opsapi.ElasticsearchOpsRequest {
+	return &opsapi.ElasticsearchOpsRequest{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       opsapi.ResourceKindElasticsearchOpsRequest,
+			APIVersion: opsapi.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: dbKey.Namespace,
+		},
+		Spec: opsapi.ElasticsearchOpsRequestSpec{
+			DatabaseRef: core.LocalObjectReference{
+				Name: dbKey.Name,
+			},
+			Type: opsapi.ElasticsearchOpsRequestTypeRestart,
+		},
+	}
+}
+
+// newOpsRequestRecommendation returns a Recommendation executing the given KubeDB OpsRequest on the database of the given kind.
+func (f *Framework) newOpsRequestRecommendation(kind, description string, dbKey client.ObjectKey, opsReq any, deadline *metav1.Time) (*api.Recommendation, error) {
+	byteData, err := json.Marshal(opsReq)
+	if err != nil {
+		return nil, err
+	}
+	return &api.Recommendation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rand.WithUniqSuffix("supervisor"),
+			Namespace: f.getRecommendationNamespace(),
+		},
+		Spec: api.RecommendationSpec{
+			Description: description,
+			Target: core.TypedLocalObjectReference{
+				APIGroup: pointer.StringP(kubedbapi.SchemeGroupVersion.Group),
+				Kind:     kind,
+				Name:     dbKey.Name,
+			},
+			Operation: runtime.RawExtension{
+				Raw: byteData,
+			},
+			Recommender: kmapi.ObjectReference{
+				Name: "kubedb-ops-manager",
+			},
+			Deadline: deadline,
+			Rules: api.OperationPhaseRules{
+				Success:    `self.status.phase == 'Successful'`,
+				InProgress: `self.status.phase == 'Progressing'`,
+				Failed:     `self.status.phase == 'Failed'`,
+			},
+		},
+	}, nil
+}
+
 func (f *Framework) createRecommendation(rcmd *api.Recommendation) (*api.Recommendation, error) {
 	if err := f.kc.Create(f.ctx, rcmd); err != nil {
 		return nil, err
@@ -189,6 +295,38 @@ func (f *Framework) CreateNewPostgresRecommendation(dbKey client.ObjectKey) (*ap
 	return f.createNewPostgresRecommendation(dbKey, nil)
 }
 
+func (f *Framework) CreateNewMySQLRecommendation(dbKey client.ObjectKey) (*api.Recommendation, error) {
+	rcmd, err := f.newOpsRequestRecommendation(kubedbapi.ResourceKindMySQL, "MySQL Database Restart", dbKey, f.getMySQLRestartOpsRequest(dbKey), nil)
+	if err != nil {
+		return nil, err
+	}
+	return f.createRecommendation(rcmd)
+}
+
+func (f *Framework) CreateNewMariaDBRecommendation(dbKey client.ObjectKey) (*api.Recommendation, error) {
+	rcmd, err := f.newOpsRequestRecommendation(kubedbapi.ResourceKindMariaDB, "MariaDB Database Restart", dbKey, f.getMariaDBRestartOpsRequest(dbKey), nil)
+	if err != nil {
+		return nil, err
+	}
+	return f.createRecommendation(rcmd)
+}
+
+func (f *Framework) CreateNewRedisRecommendation(dbKey client.ObjectKey) (*api.Recommendation, error) {
+	rcmd, err := f.newOpsRequestRecommendation(kubedbapi.ResourceKindRedis, "Redis Database Restart", dbKey, f.getRedisRestartOpsRequest(dbKey), nil)
+	if err != nil {
+		return nil, err
+	}
+	return f.createRecommendation(rcmd)
+}
+
+func (f *Framework) CreateNewElasticsearchRecommendation(dbKey client.ObjectKey) (*api.Recommendation, error) {
+	rcmd, err := f.newOpsRequestRecommendation(kubedbapi.ResourceKindElasticsearch, "Elasticsearch Database Restart", dbKey, f.getElasticsearchRestartOpsRequest(dbKey), nil)
+	if err != nil {
+		return nil, err
+	}
+	return f.createRecommendation(rcmd)
+}
+
 func (f *Framework) CreateNewRecommendationWithDeadline(dbKey client.ObjectKey, deadline *metav1.Time) (*api.Recommendation, error) {
 	return f.createNewMongoDBRecommendation(dbKey, deadline)
 }
@@ -214,6 +352,16 @@ func (f *Framework) WaitForRecommendationToBeSucceeded(key client.ObjectKey) err
 	})
 }
 
+func (f *Framework) WaitForRecommendationToBeWaitingForWindow(key client.ObjectKey) error {
+	return wait.PollUntilContextTimeout(context.Background(), time.Second, time.Minute*5, true, func(ctx context.Context) (bool, error) {
+		rcmd := &api.Recommendation{}
+		if err := f.kc.Get(f.ctx, key, rcmd); err != nil {
+			return false, err
+		}
+		return rcmd.Status.Phase == api.Waiting && rcmd.Status.Reason == api.WaitingForMaintenanceWindow, nil
+	})
+}
+
 func (f *Framework) ApproveRecommendation(key client.ObjectKey) error {
 	rcmd := &api.Recommendation{}
 	if err := f.kc.Get(f.ctx, key, rcmd); err != nil {
@@ -165,6 +165,18 @@ var _ = Describe("Supervisor E2E Testing", func() {
 			By("Updating Recommendation Parallelism to " + string(par))
 			Expect(f.UpdateRecommendationParallelism(key, par)).Should(Succeed())
 		}
+		waitingForRecommendationToWaitForWindow = func(key client.ObjectKey) {
+			By("Waiting for Recommendation to wait for the MaintenanceWindow")
+			Expect(f.WaitForRecommendationToBeWaitingForWindow(key)).Should(Succeed())
+		}
+		setClock = func(t time.Time) {
+			By("Setting the clock of the operator to " + t.UTC().Format(time.RFC3339))
+			Expect(f.SetClock(t)).Should(Succeed())
+		}
+		touchMaintenanceWindow = func(key client.ObjectKey) {
+			By("Touching MaintenanceWindow " + key.String())
+			Expect(f.TouchMaintenanceWindow(key)).Should(Succeed())
+		}
 		cleanupRecommendation = func(key client.ObjectKey) {
 			By("Deleting Recommendation")
 			Expect(f.DeleteRecommendation(key)).Should(Succeed())
@@ -259,6 +271,39 @@ var _ = Describe("Supervisor E2E Testing", func() {
 				waitingForRecommendationToBeSucceeded(key)
 			})
 
+			It("Should execute the operation once the clock reaches the maintenance window opening tomorrow at 02:00", func() {
+				setClock(time.Now())
+				defer setClock(time.Now())
+
+				mg := createNewStandaloneMongoDB()
+				mgKey := client.ObjectKey{Name: mg.Name, Namespace: mg.Namespace}
+				defer cleanupMongoDB(mgKey)
+
+				dates := f.GetDateWindowsAt(1, 2, 0, time.Hour)
+				mw := createMaintenanceWindow(nil, dates)
+				mwKey := client.ObjectKey{Name: mw.Name, Namespace: mw.Namespace}
+				defer cleanupMaintenanceWindow(mwKey)
+
+				rcmd := createMongoDBRecommendation(mgKey)
+				key := client.ObjectKey{Name: rcmd.Name, Namespace: rcmd.Namespace}
+				defer cleanupRecommendation(key)
+
+				aw := &api.ApprovedWindow{
+					MaintenanceWindow: &kmapi.TypedObjectReference{
+						Name:      mw.Name,
+						Namespace: mw.Namespace,
+					},
+				}
+				updateRecommendationApprovedWindow(key, aw)
+
+				approveRecommendation(key)
+				waitingForRecommendationToWaitForWindow(key)
+
+				setClock(dates[0].Start.Add(time.Minute))
+				touchMaintenanceWindow(mwKey)
+				waitingForRecommendationToBeSucceeded(key)
+			})
+
 			It("Should execute the operation successfully with ApproveWindow type Immediate", func() {
 				mg := createNewStandaloneMongoDB()
 				mgKey := client.ObjectKey{Name: mg.Name, Namespace: mg.Namespace}